@@ -0,0 +1,16 @@
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestEmbeddedSchemaUpToDate(t *testing.T) {
+	schema, err := ioutil.ReadFile("../../schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(pulumiSchema) != string(schema) {
+		t.Error("schema.go is out of date with schema.json, run `make generate`")
+	}
+}
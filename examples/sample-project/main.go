@@ -27,7 +27,7 @@ func createProjects(ctx *pulumi.Context) error {
 			Name:             pulumi.String(getenvWithDefault("PROJ_NAME", "Sample Project")),
 			Slug:             pulumi.String(getenvWithDefault("PROJ_SLUG", "sample-project")),
 			OrganizationSlug: pulumi.String(orgSlug),
-			TeamSlug:         pulumi.String(getenvWithDefault("TEAM_SLUG", "test-team")),
+			TeamSlugs:        pulumi.StringArray{pulumi.String(getenvWithDefault("TEAM_SLUG", "test-team"))},
 		})
 		if err != nil {
 			return err
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var clientKeyProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		// A key can't be moved between organizations.
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"isActive": true,
+		"name":     true,
+		// The key's ID is based on its project's ID (see
+		// buildProjectResourceID), so a renamed project keeps its keys and
+		// their DSNs.  Moving a key to another project fails in Update.
+		"projectSlug":     true,
+		"rateLimitCount":  true,
+		"rateLimitWindow": true,
+	},
+	outputs: map[string]bool{
+		"dsnCSP":      true,
+		"dsnPublic":   true,
+		"dsnSecret":   true,
+		"dsnSecurity": true,
+	},
+}
+
+func (k *sentryProvider) clientKeyCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalBool(&failures, news, "isActive")
+	checkNonEmptyString(&failures, news, "name")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "projectSlug")
+	checkOptionalPositiveInteger(&failures, news, "rateLimitCount")
+	checkOptionalPositiveInteger(&failures, news, "rateLimitWindow")
+	checkBothOrNeither(&failures, news, "rateLimitCount", "rateLimitWindow")
+
+	// Sentry creates keys active, fill that in so that Read does not report
+	// a difference against inputs that skip isActive.
+	if news["isActive"].IsNull() {
+		news["isActive"] = resource.NewBoolProperty(true)
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) clientKeyDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return clientKeyProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) clientKeyCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	projectSlug := inputs["projectSlug"].StringValue()
+	org := sentry.Organization{Slug: &organizationSlug}
+	project, err := k.sentryClient.GetProject(org, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProject %v: %w", projectSlug, err)
+	}
+
+	wanted := clientKeyFromInputs(inputs)
+	created, err := k.sentryClient.CreateClientKey(org, project, wanted.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateClientKey %v: %v", wanted.Name, err)
+	}
+
+	// Creating a key only takes its name, the rest of settings requires an
+	// update.
+	wanted.ID = created.ID
+	key, err := k.sentryClient.UpdateClientKeyDetails(org, project, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateClientKeyDetails %v: %v", wanted.Name, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		clientKeyPropertyMap(organizationSlug, projectSlug, key),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildProjectResourceID(organizationSlug, project, key.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) clientKeyUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed clientKeyUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed clientKeyUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := clientKeyProperties.checkUpdatable("clientKeyUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	organizationSlug, project, parts, err := k.parseUpdatedProjectResourceID(req.GetId(), news, 1)
+	if err != nil {
+		return nil, err
+	}
+	wanted := clientKeyFromInputs(news)
+	wanted.ID = parts[0]
+	key, err := k.sentryClient.UpdateClientKeyDetails(sentry.Organization{Slug: &organizationSlug}, project, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateClientKeyDetails %v: %v", wanted.ID, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		clientKeyPropertyMap(organizationSlug, *project.Slug, key),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) clientKeyRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.properties", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed clientKeyRead because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, parts, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 1)
+	var key clientKey
+	if err == nil {
+		key, err = k.sentryClient.GetClientKeyDetails(sentry.Organization{Slug: &organizationSlug}, project, parts[0])
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// The key or its project is not there, delete it from stack
+			// state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, err
+	}
+	properties := clientKeyPropertyMap(organizationSlug, *project.Slug, key)
+	newState, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(clientKeyProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		// This also migrates legacy <orgSlug>/<projectSlug>/<keyID> IDs.
+		Id:         buildProjectResourceID(organizationSlug, project, key.ID),
+		Properties: newState,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) clientKeyDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{})
+	if err != nil {
+		return &pbempty.Empty{}, fmt.Errorf("failed clientKeyDelete because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, parts, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 1)
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteClientKey(sentry.Organization{Slug: &organizationSlug}, project, sentry.Key{ID: parts[0]})
+	return &pbempty.Empty{}, err
+}
+
+func clientKeyFromInputs(inputs resource.PropertyMap) clientKey {
+	key := clientKey{
+		Name:     inputs["name"].StringValue(),
+		IsActive: inputs["isActive"].IsNull() || inputs["isActive"].BoolValue(),
+	}
+	if !inputs["rateLimitWindow"].IsNull() && !inputs["rateLimitCount"].IsNull() {
+		key.RateLimit = &clientKeyRateLimit{
+			Window: int(inputs["rateLimitWindow"].NumberValue()),
+			Count:  int(inputs["rateLimitCount"].NumberValue()),
+		}
+	}
+	return key
+}
+
+func clientKeyPropertyMap(organizationSlug, projectSlug string, key clientKey) resource.PropertyMap {
+	properties := map[string]interface{}{
+		"dsnCSP":           key.DSN.CSP,
+		"dsnPublic":        key.DSN.Public,
+		"dsnSecret":        key.DSN.Secret,
+		"dsnSecurity":      key.DSN.Security,
+		"isActive":         key.IsActive,
+		"name":             key.Name,
+		"organizationSlug": organizationSlug,
+		"projectSlug":      projectSlug,
+	}
+	if key.RateLimit != nil {
+		properties["rateLimitCount"] = key.RateLimit.Count
+		properties["rateLimitWindow"] = key.RateLimit.Window
+	}
+	return resource.NewPropertyMapFromMap(properties)
+}
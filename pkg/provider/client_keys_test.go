@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestClientKeyCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"isActive": resource.NewPropertyValue(true),
+			},
+		},
+		"wrong type": {
+			news: resource.PropertyMap{
+				"isActive":         resource.NewPropertyValue("yes"),
+				"name":             resource.NewPropertyValue(1),
+				"organizationSlug": resource.NewPropertyValue(1),
+				"projectSlug":      resource.NewPropertyValue(1),
+				"rateLimitCount":   resource.NewPropertyValue(0.5),
+				"rateLimitWindow":  resource.NewPropertyValue(-60),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "isActive", Reason: "this input must be a boolean"},
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+				{Property: "rateLimitCount", Reason: "this input must be a positive integer"},
+				{Property: "rateLimitWindow", Reason: "this input must be a positive integer"},
+			},
+			wantInputs: resource.PropertyMap{
+				"isActive":         resource.NewPropertyValue("yes"),
+				"name":             resource.NewPropertyValue(1),
+				"organizationSlug": resource.NewPropertyValue(1),
+				"projectSlug":      resource.NewPropertyValue(1),
+				"rateLimitCount":   resource.NewPropertyValue(0.5),
+				"rateLimitWindow":  resource.NewPropertyValue(-60),
+			},
+		},
+		"rate limit window without count": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"rateLimitWindow":  resource.NewPropertyValue(60),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "rateLimitCount", Reason: "rateLimitCount and rateLimitWindow must be set together"},
+				{Property: "rateLimitWindow", Reason: "rateLimitCount and rateLimitWindow must be set together"},
+			},
+			wantInputs: resource.PropertyMap{
+				"isActive":         resource.NewPropertyValue(true),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"rateLimitWindow":  resource.NewPropertyValue(60),
+			},
+		},
+		"correct full": {
+			news: resource.PropertyMap{
+				"isActive":         resource.NewPropertyValue(false),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"rateLimitCount":   resource.NewPropertyValue(1000),
+				"rateLimitWindow":  resource.NewPropertyValue(60),
+			},
+			wantFailures: nil,
+			wantInputs: resource.PropertyMap{
+				"isActive":         resource.NewPropertyValue(false),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"rateLimitCount":   resource.NewPropertyValue(1000),
+				"rateLimitWindow":  resource.NewPropertyValue(60),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.clientKeyCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestClientKeyDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"dsnCSP":           resource.NewPropertyValue("csp-dsn"),
+		"dsnPublic":        resource.NewPropertyValue("public-dsn"),
+		"dsnSecret":        resource.NewPropertyValue("secret-dsn"),
+		"dsnSecurity":      resource.NewPropertyValue("security-dsn"),
+		"isActive":         resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("base name"),
+		"organizationSlug": resource.NewPropertyValue("base-org-slug"),
+		"projectSlug":      resource.NewPropertyValue("base-proj-slug"),
+	}
+	baseNews := resource.PropertyMap{
+		"isActive":         resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("base name"),
+		"organizationSlug": resource.NewPropertyValue("base-org-slug"),
+		"projectSlug":      resource.NewPropertyValue("base-proj-slug"),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{Changes: rpc.DiffResponse_DIFF_NONE},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"isActive":        resource.NewPropertyValue(false),
+				"name":            resource.NewPropertyValue("new name"),
+				"rateLimitCount":  resource.NewPropertyValue(100),
+				"rateLimitWindow": resource.NewPropertyValue(60),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"isActive", "name", "rateLimitCount", "rateLimitWindow"},
+			},
+		},
+		"project renamed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"projectSlug": resource.NewPropertyValue("new-proj-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"projectSlug"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("new-org-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.clientKeyDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestClientKeyCreate(t *testing.T) {
+	ctx := context.Background()
+	createCalled := false
+	updateCalled := false
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			createClientKey: func(org sentry.Organization, proj sentry.Project, name string) (sentry.Key, error) {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, *proj.Slug, "the-proj")
+				assert.Equal(t, name, "a name")
+				createCalled = true
+				return sentry.Key{ID: "key-id", Label: name}, nil
+			},
+			updateClientKeyDetails: func(org sentry.Organization, proj sentry.Project, key clientKey) (clientKey, error) {
+				assert.True(t, createCalled)
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, *proj.Slug, "the-proj")
+				assert.Equal(t, key, clientKey{
+					ID:        "key-id",
+					Name:      "a name",
+					IsActive:  false,
+					RateLimit: &clientKeyRateLimit{Window: 60, Count: 1000},
+				})
+				updateCalled = true
+				key.DSN = clientKeyDSN{
+					Public:   "public-dsn",
+					Secret:   "secret-dsn",
+					CSP:      "csp-dsn",
+					Security: "security-dsn",
+				}
+				return key, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("the-proj")}),
+	}
+	inputs := resource.PropertyMap{
+		"isActive":         resource.NewPropertyValue(false),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("the-org"),
+		"projectSlug":      resource.NewPropertyValue("the-proj"),
+		"rateLimitCount":   resource.NewPropertyValue(1000),
+		"rateLimitWindow":  resource.NewPropertyValue(60),
+	}
+	resp, err := prov.clientKeyCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.True(t, updateCalled)
+	assert.Equal(t, resp.GetId(), "the-org/42/key-id")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"dsnCSP":           resource.NewPropertyValue("csp-dsn"),
+		"dsnPublic":        resource.NewPropertyValue("public-dsn"),
+		"dsnSecret":        resource.NewPropertyValue("secret-dsn"),
+		"dsnSecurity":      resource.NewPropertyValue("security-dsn"),
+		"isActive":         resource.NewPropertyValue(false),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("the-org"),
+		"projectSlug":      resource.NewPropertyValue("the-proj"),
+		"rateLimitCount":   resource.NewPropertyValue(1000),
+		"rateLimitWindow":  resource.NewPropertyValue(60),
+	})
+}
+
+func TestClientKeyRead(t *testing.T) {
+	tests := map[string]struct {
+		id, stateProjectSlug string
+	}{
+		"by ID": {
+			id:               "org-slug/42/key-id",
+			stateProjectSlug: "proj-slug",
+		},
+		"legacy ID on import": {
+			id: "org-slug/proj-slug/key-id",
+		},
+		"project renamed outside of Pulumi": {
+			id:               "org-slug/42/key-id",
+			stateProjectSlug: "old-proj-slug",
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{
+				sentryClient: withProjects(&sentryClientMock{
+					getClientKeyDetails: func(org sentry.Organization, proj sentry.Project, id string) (clientKey, error) {
+						assert.Equal(t, *org.Slug, "org-slug")
+						assert.Equal(t, *proj.Slug, "proj-slug")
+						assert.Equal(t, id, "key-id")
+						return clientKey{
+							ID:       "key-id",
+							Name:     "name-from-read",
+							IsActive: true,
+							DSN:      clientKeyDSN{Public: "public-dsn"},
+						}, nil
+					},
+				}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+			}
+			state := resource.PropertyMap{}
+			if tc.stateProjectSlug != "" {
+				state["projectSlug"] = resource.NewPropertyValue(tc.stateProjectSlug)
+			}
+			resp, err := prov.clientKeyRead(ctx, &rpc.ReadRequest{Id: tc.id, Properties: mustMarshalProperties(state)})
+			assert.Nil(t, err)
+			assert.Equal(t, resp.GetId(), "org-slug/42/key-id")
+			assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+				"dsnCSP":           resource.NewPropertyValue(""),
+				"dsnPublic":        resource.NewPropertyValue("public-dsn"),
+				"dsnSecret":        resource.NewPropertyValue(""),
+				"dsnSecurity":      resource.NewPropertyValue(""),
+				"isActive":         resource.NewPropertyValue(true),
+				"name":             resource.NewPropertyValue("name-from-read"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			})
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), resource.PropertyMap{
+				"isActive":         resource.NewPropertyValue(true),
+				"name":             resource.NewPropertyValue("name-from-read"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			})
+		})
+	}
+}
+
+func TestClientKeyRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getClientKeyDetails: func(org sentry.Organization, proj sentry.Project, id string) (clientKey, error) {
+				return clientKey{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.clientKeyRead(ctx, &rpc.ReadRequest{Id: "org-slug/42/key-id"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+
+	// The project is gone, and its keys with it.
+	resp, err = prov.clientKeyRead(ctx, &rpc.ReadRequest{Id: "org-slug/43/key-id"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestClientKeyDelete(t *testing.T) {
+	ctx := context.Background()
+	deleteCalled := false
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			deleteClientKey: func(org sentry.Organization, proj sentry.Project, key sentry.Key) error {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, *proj.Slug, "the-proj")
+				assert.Equal(t, key.ID, "key-id")
+				deleteCalled = true
+				return nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("the-proj")}),
+	}
+	_, err := prov.clientKeyDelete(ctx, &rpc.DeleteRequest{
+		Id:         "the-org/42/key-id",
+		Properties: mustMarshalProperties(resource.PropertyMap{"projectSlug": resource.NewPropertyValue("the-proj")}),
+	})
+	assert.Nil(t, err)
+	assert.True(t, deleteCalled)
+}
+
+func TestClientKeyUpdate(t *testing.T) {
+	ctx := context.Background()
+	updateCalled := false
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateClientKeyDetails: func(org sentry.Organization, proj sentry.Project, key clientKey) (clientKey, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				assert.Equal(t, key, clientKey{
+					ID:       "key-id",
+					Name:     "new name",
+					IsActive: false,
+				})
+				updateCalled = true
+				key.DSN = clientKeyDSN{Public: "public-dsn"}
+				return key, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	olds := resource.PropertyMap{
+		"dsnPublic":        resource.NewPropertyValue("public-dsn"),
+		"isActive":         resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"rateLimitCount":   resource.NewPropertyValue(1000),
+		"rateLimitWindow":  resource.NewPropertyValue(60),
+	}
+	news := resource.PropertyMap{
+		"isActive":         resource.NewPropertyValue(false),
+		"name":             resource.NewPropertyValue("new name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	}
+	resp, err := prov.clientKeyUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42/key-id",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.True(t, updateCalled)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"dsnCSP":           resource.NewPropertyValue(""),
+		"dsnPublic":        resource.NewPropertyValue("public-dsn"),
+		"dsnSecret":        resource.NewPropertyValue(""),
+		"dsnSecurity":      resource.NewPropertyValue(""),
+		"isActive":         resource.NewPropertyValue(false),
+		"name":             resource.NewPropertyValue("new name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	})
+}
+
+func TestClientKeyUpdateProjectRenamed(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateClientKeyDetails: func(org sentry.Organization, proj sentry.Project, key clientKey) (clientKey, error) {
+				assert.Equal(t, *proj.Slug, "new-proj-slug")
+				assert.Equal(t, key.ID, "key-id")
+				key.DSN = clientKeyDSN{Public: "public-dsn"}
+				return key, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("new-proj-slug")}),
+	}
+	olds := resource.PropertyMap{
+		"dsnPublic":        resource.NewPropertyValue("public-dsn"),
+		"isActive":         resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"projectSlug": resource.NewPropertyValue("new-proj-slug"),
+	})
+	delete(news, "dsnPublic")
+	resp, err := prov.clientKeyUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42/key-id",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties())["projectSlug"], resource.NewPropertyValue("new-proj-slug"))
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties())["dsnPublic"], resource.NewPropertyValue("public-dsn"))
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+// Environment variables read when the corresponding provider configuration
+// is not set; they are the same ones sentry-cli uses.
+const (
+	authTokenEnvVar    = "SENTRY_AUTH_TOKEN"
+	urlEnvVar          = "SENTRY_URL"
+	organizationEnvVar = "SENTRY_ORG"
+)
+
+// configOrEnv returns value if it's set, and fallback otherwise.
+func configOrEnv(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
+
+// apiURLFromSentryURL returns the API URL of a Sentry server, accepting
+// both the server's URL (as in SENTRY_URL) and the API URL itself.
+func apiURLFromSentryURL(sentryURL string) string {
+	if sentryURL == "" || strings.HasSuffix(strings.TrimSuffix(sentryURL, "/"), "/api/0") {
+		return sentryURL
+	}
+	return strings.TrimSuffix(sentryURL, "/") + "/api/0/"
+}
+
+// fillDefaultOrganization sets organizationSlug in resource inputs or
+// function arguments from the sentry:organization config, if they don't set
+// it themselves.  Doing it in Check means that a filled in organization and an
+// explicit identical one are the same inputs for Diff.
+func (k *sentryProvider) fillDefaultOrganization(props resource.PropertyMap) {
+	if props["organizationSlug"].IsNull() && k.defaultOrganization != "" {
+		props["organizationSlug"] = resource.NewStringProperty(k.defaultOrganization)
+	}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func TestAPIURLFromSentryURL(t *testing.T) {
+	tests := map[string]string{
+		"":                                  "",
+		"https://sentry.io":                 "https://sentry.io/api/0/",
+		"https://sentry.io/":                "https://sentry.io/api/0/",
+		"https://sentry.example.com/api/0/": "https://sentry.example.com/api/0/",
+		"https://sentry.example.com/api/0":  "https://sentry.example.com/api/0",
+		"https://example.com/sentry/":       "https://example.com/sentry/api/0/",
+	}
+	for sentryURL, want := range tests {
+		assert.Equal(t, apiURLFromSentryURL(sentryURL), want, sentryURL)
+	}
+}
+
+func TestConfigOrEnv(t *testing.T) {
+	assert.Equal(t, configOrEnv("config", "env"), "config")
+	assert.Equal(t, configOrEnv("", "env"), "env")
+	assert.Equal(t, configOrEnv("", ""), "")
+}
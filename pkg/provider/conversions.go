@@ -1,6 +1,11 @@
 package provider
 
-import "github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+import (
+	"sort"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
 
 func stringPtrFromPropertyValue(val resource.PropertyValue) *string {
 	if val.IsNull() {
@@ -9,3 +14,118 @@ func stringPtrFromPropertyValue(val resource.PropertyValue) *string {
 	v := val.StringValue()
 	return &v
 }
+
+func boolPtrFromPropertyValue(val resource.PropertyValue) *bool {
+	if !val.IsBool() {
+		return nil
+	}
+	v := val.BoolValue()
+	return &v
+}
+
+func intPtrFromPropertyValue(val resource.PropertyValue) *int {
+	if !val.IsNumber() {
+		return nil
+	}
+	v := int(val.NumberValue())
+	return &v
+}
+
+func stringFromPropertyValue(val resource.PropertyValue) string {
+	if !val.IsString() {
+		return ""
+	}
+	return val.StringValue()
+}
+
+func boolFromPropertyValue(val resource.PropertyValue) bool {
+	return val.IsBool() && val.BoolValue()
+}
+
+// stringsFromPropertyValue converts a list of strings, skipping anything
+// else.  The result is never nil, so that it's sent as an empty list rather
+// than null.
+func stringsFromPropertyValue(val resource.PropertyValue) []string {
+	ret := []string{}
+	if !val.IsArray() {
+		return ret
+	}
+	for _, element := range val.ArrayValue() {
+		if element.IsString() {
+			ret = append(ret, element.StringValue())
+		}
+	}
+	return ret
+}
+
+func stringsPtrFromPropertyValue(val resource.PropertyValue) *[]string {
+	if !val.IsArray() {
+		return nil
+	}
+	v := stringsFromPropertyValue(val)
+	return &v
+}
+
+// sortedStrings sorts a list of strings which Sentry keeps as a set, leaving
+// anything else, e.g. unknowns, as it is.
+func sortedStrings(val resource.PropertyValue) resource.PropertyValue {
+	if !val.IsArray() || val.ContainsUnknowns() {
+		return val
+	}
+	sorted := append([]resource.PropertyValue{}, val.ArrayValue()...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].IsString() && sorted[j].IsString() && sorted[i].StringValue() < sorted[j].StringValue()
+	})
+	return resource.NewArrayProperty(sorted)
+}
+
+// timePtrFromPropertyValue parses a date and time in RFC 3339 format, nil
+// when it's unset or invalid.
+func timePtrFromPropertyValue(val resource.PropertyValue) *time.Time {
+	if !val.IsString() {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, val.StringValue())
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// formatTime formats dates and times the same way whether they come from
+// inputs or from Sentry, which adds microseconds, so that they compare equal.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// normalizedTime formats a date and time input with formatTime, leaving
+// anything else, e.g. unknowns, as it is.
+func normalizedTime(val resource.PropertyValue) resource.PropertyValue {
+	if t := timePtrFromPropertyValue(val); t != nil {
+		return resource.NewStringProperty(formatTime(*t))
+	}
+	return val
+}
+
+func numberFromPropertyValue(val resource.PropertyValue) float64 {
+	if !val.IsNumber() {
+		return 0
+	}
+	return val.NumberValue()
+}
+
+// objectsFromPropertyValue converts a list of objects to their plain Go
+// values, ready to be sent as JSON.  The result is never nil, so that it's
+// sent as an empty list rather than null.
+func objectsFromPropertyValue(val resource.PropertyValue) []map[string]interface{} {
+	ret := []map[string]interface{}{}
+	if !val.IsArray() {
+		return ret
+	}
+	for _, element := range val.ArrayValue() {
+		if element.IsObject() {
+			ret = append(ret, element.ObjectValue().Mappable())
+		}
+	}
+	return ret
+}
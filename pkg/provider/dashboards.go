@@ -0,0 +1,477 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var dashboardProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		// A dashboard can't be moved between organizations.
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"title":   true,
+		"widgets": true,
+	},
+	outputs: map[string]bool{},
+	nested: map[string]resourceProperties{
+		"widgets": {
+			changedByUpdate: map[string]bool{
+				"displayType": true,
+				"layout":      true,
+				"queries":     true,
+				"title":       true,
+			},
+			outputs: map[string]bool{
+				"id": true,
+			},
+			nested: map[string]resourceProperties{
+				"queries": {
+					changedByUpdate: map[string]bool{
+						"conditions": true,
+						"fields":     true,
+						"name":       true,
+						"orderby":    true,
+					},
+					outputs: map[string]bool{
+						"id": true,
+					},
+				},
+			},
+		},
+	},
+}
+
+// dashboardWidgetDisplayTypes are the ways widgets can show the results of
+// their queries.
+var dashboardWidgetDisplayTypes = []string{"line", "area", "stacked_area", "bar", "table", "big_number", "top_n", "world_map"}
+
+func (k *sentryProvider) dashboardCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "title")
+	checkDashboardWidgets(&failures, news)
+
+	// Sentry returns dashboards without widgets with an empty list of them.
+	if news["widgets"].IsNull() {
+		news["widgets"] = resource.NewArrayProperty([]resource.PropertyValue{})
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+// checkDashboardWidgets checks the widgets of a dashboard.  Failures in a
+// widget are reported under its path, e.g. widgets[0].queries[1].fields.
+func checkDashboardWidgets(failures *[]*rpc.CheckFailure, props resource.PropertyMap) {
+	value := props["widgets"]
+	if value.IsNull() || value.IsComputed() {
+		return
+	}
+	if !value.IsArray() {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "widgets",
+			Reason:   "this input must be a list of widgets",
+		})
+		return
+	}
+
+	for i, element := range value.ArrayValue() {
+		if element.IsComputed() {
+			continue
+		}
+		path := fmt.Sprintf("widgets[%d]", i)
+		if !element.IsObject() {
+			*failures = append(*failures, &rpc.CheckFailure{Property: path, Reason: "this input must be an object"})
+			continue
+		}
+		widget := element.ObjectValue()
+
+		var widgetFailures []*rpc.CheckFailure
+		checkOneOf(&widgetFailures, widget, "displayType", dashboardWidgetDisplayTypes...)
+		checkDashboardWidgetLayout(&widgetFailures, widget)
+		checkDashboardWidgetQueries(&widgetFailures, widget)
+		checkNonEmptyString(&widgetFailures, widget, "title")
+		for _, failure := range widgetFailures {
+			failure.Property = path + "." + failure.Property
+		}
+		*failures = append(*failures, widgetFailures...)
+	}
+}
+
+func checkDashboardWidgetQueries(failures *[]*rpc.CheckFailure, widget resource.PropertyMap) {
+	value := widget["queries"]
+	if value.IsComputed() {
+		return
+	}
+	if !value.IsArray() || len(value.ArrayValue()) == 0 {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "queries",
+			Reason:   "this input must be a non-empty list of queries",
+		})
+		return
+	}
+
+	for i, element := range value.ArrayValue() {
+		if element.IsComputed() {
+			continue
+		}
+		path := fmt.Sprintf("queries[%d]", i)
+		if !element.IsObject() {
+			*failures = append(*failures, &rpc.CheckFailure{Property: path, Reason: "this input must be an object"})
+			continue
+		}
+		query := element.ObjectValue()
+
+		var queryFailures []*rpc.CheckFailure
+		checkDashboardWidgetQueryConditions(&queryFailures, query)
+		if fields := query["fields"]; !fields.ContainsUnknowns() && (!fields.IsArray() || len(fields.ArrayValue()) == 0) {
+			queryFailures = append(queryFailures, &rpc.CheckFailure{
+				Property: "fields",
+				Reason:   "this input must be a non-empty list of non-empty strings",
+			})
+		} else {
+			checkOptionalStringArray(&queryFailures, query, "fields")
+		}
+		checkOptionalString(&queryFailures, query, "name")
+		checkOptionalString(&queryFailures, query, "orderby")
+		for _, failure := range queryFailures {
+			failure.Property = path + "." + failure.Property
+		}
+		*failures = append(*failures, queryFailures...)
+	}
+}
+
+// checkDashboardWidgetQueryConditions checks the conditions of a query, which
+// are a search query like the ones of saved searches.  Unlike those, they can
+// be left empty to match all events.
+func checkDashboardWidgetQueryConditions(failures *[]*rpc.CheckFailure, query resource.PropertyMap) {
+	value := query["conditions"]
+	if value.IsNull() || value.ContainsUnknowns() {
+		return
+	}
+	if !value.IsString() {
+		*failures = append(*failures, &rpc.CheckFailure{Property: "conditions", Reason: "this input must be a string"})
+		return
+	}
+	if problem := searchQueryProblem(value.StringValue()); problem != "" {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "conditions",
+			Reason:   "this input must be a valid search query: " + problem,
+		})
+	}
+}
+
+func checkDashboardWidgetLayout(failures *[]*rpc.CheckFailure, widget resource.PropertyMap) {
+	value := widget["layout"]
+	if value.IsNull() || value.IsComputed() {
+		return
+	}
+	if !value.IsObject() {
+		*failures = append(*failures, &rpc.CheckFailure{Property: "layout", Reason: "this input must be an object"})
+		return
+	}
+	layout := value.ObjectValue()
+
+	var layoutFailures []*rpc.CheckFailure
+	checkPositiveInteger(&layoutFailures, layout, "h")
+	checkPositiveInteger(&layoutFailures, layout, "w")
+	if layout["x"].IsNull() {
+		layoutFailures = append(layoutFailures, &rpc.CheckFailure{Property: "x", Reason: "this input must be a non-negative integer"})
+	}
+	checkOptionalNonNegativeInteger(&layoutFailures, layout, "x")
+	if layout["y"].IsNull() {
+		layoutFailures = append(layoutFailures, &rpc.CheckFailure{Property: "y", Reason: "this input must be a non-negative integer"})
+	}
+	checkOptionalNonNegativeInteger(&layoutFailures, layout, "y")
+	for _, failure := range layoutFailures {
+		failure.Property = "layout." + failure.Property
+	}
+	*failures = append(*failures, layoutFailures...)
+}
+
+// dashboardDiff reports changes of widgets one by one, so that the preview of
+// an edit of a single widget shows that widget rather than the whole list.
+func (k *sentryProvider) dashboardDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	resp, err := dashboardProperties.diff(olds, news)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Changes == rpc.DiffResponse_DIFF_SOME {
+		resp.DetailedDiff = dashboardProperties.detailedDiff(olds, news)
+		resp.HasDetailedDiff = true
+	}
+	return resp, nil
+}
+
+func (k *sentryProvider) dashboardCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+
+	wanted := dashboardFromInputs(inputs)
+	created, err := k.sentryClient.CreateDashboard(sentry.Organization{Slug: &organizationSlug}, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateDashboard %v: %w", wanted.Title, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		dashboardPropertyMap(organizationSlug, created),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildID(organizationSlug, created.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) dashboardUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, id, err := parseDashboardID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed dashboardUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed dashboardUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := dashboardProperties.checkUpdatable("dashboardUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	wanted := dashboardFromInputs(news)
+	wanted.ID = id
+	keepDashboardWidgetIDs(&wanted, dashboardFromInputs(olds))
+	updated, err := k.sentryClient.UpdateDashboard(sentry.Organization{Slug: &organizationSlug}, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateDashboard %v: %w", id, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		dashboardPropertyMap(organizationSlug, updated),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) dashboardRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, id, err := parseDashboardID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	d, err := k.sentryClient.GetDashboard(sentry.Organization{Slug: &organizationSlug}, id)
+	if err != nil {
+		if isNotFound(err) {
+			// The dashboard is not there, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetDashboard %v: %w", req.GetId(), err)
+	}
+	properties := dashboardPropertyMap(organizationSlug, d)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(dashboardProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         buildID(organizationSlug, d.ID),
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) dashboardDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, id, err := parseDashboardID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteDashboard(sentry.Organization{Slug: &organizationSlug}, id)
+	if isNotFound(err) {
+		// The dashboard is already gone.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+// parseDashboardID parses IDs of dashboards: <orgSlug>/<dashboardID>.
+func parseDashboardID(id string) (organizationSlug, dashboardID string, err error) {
+	parts, err := parseID(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// dashboardFromInputs converts inputs to a dashboard.  The IDs of widgets and
+// queries are only set when converting state, which has them.
+func dashboardFromInputs(inputs resource.PropertyMap) dashboard {
+	d := dashboard{
+		Title:   stringFromPropertyValue(inputs["title"]),
+		Widgets: []dashboardWidget{},
+	}
+	if !inputs["widgets"].IsArray() {
+		return d
+	}
+	for _, element := range inputs["widgets"].ArrayValue() {
+		if !element.IsObject() {
+			continue
+		}
+		widgetInputs := element.ObjectValue()
+		widget := dashboardWidget{
+			ID:          stringFromPropertyValue(widgetInputs["id"]),
+			Title:       stringFromPropertyValue(widgetInputs["title"]),
+			DisplayType: stringFromPropertyValue(widgetInputs["displayType"]),
+			Queries:     []dashboardWidgetQuery{},
+		}
+		if widgetInputs["queries"].IsArray() {
+			for _, queryElement := range widgetInputs["queries"].ArrayValue() {
+				if !queryElement.IsObject() {
+					continue
+				}
+				queryInputs := queryElement.ObjectValue()
+				widget.Queries = append(widget.Queries, dashboardWidgetQuery{
+					ID:         stringFromPropertyValue(queryInputs["id"]),
+					Name:       stringFromPropertyValue(queryInputs["name"]),
+					Fields:     stringsFromPropertyValue(queryInputs["fields"]),
+					Conditions: stringFromPropertyValue(queryInputs["conditions"]),
+					OrderBy:    stringFromPropertyValue(queryInputs["orderby"]),
+				})
+			}
+		}
+		if layout := widgetInputs["layout"]; layout.IsObject() {
+			widget.Layout = &dashboardWidgetLayout{
+				X: int(numberFromPropertyValue(layout.ObjectValue()["x"])),
+				Y: int(numberFromPropertyValue(layout.ObjectValue()["y"])),
+				W: int(numberFromPropertyValue(layout.ObjectValue()["w"])),
+				H: int(numberFromPropertyValue(layout.ObjectValue()["h"])),
+			}
+		}
+		d.Widgets = append(d.Widgets, widget)
+	}
+	return d
+}
+
+// keepDashboardWidgetIDs copies the IDs of the widgets and queries of the
+// current dashboard to the wanted one, so that Sentry updates them rather than
+// deleting and creating them again.  Both are matched by their position, the
+// way dashboardDiff reports their changes.
+func keepDashboardWidgetIDs(wanted *dashboard, current dashboard) {
+	for i := range wanted.Widgets {
+		if i >= len(current.Widgets) {
+			break
+		}
+		widget, currentWidget := &wanted.Widgets[i], current.Widgets[i]
+		widget.ID = currentWidget.ID
+		for j := range widget.Queries {
+			if j < len(currentWidget.Queries) {
+				widget.Queries[j].ID = currentWidget.Queries[j].ID
+			}
+		}
+	}
+}
+
+func dashboardPropertyMap(organizationSlug string, d dashboard) resource.PropertyMap {
+	widgets := make([]interface{}, 0, len(d.Widgets))
+	for _, widget := range d.Widgets {
+		queries := make([]interface{}, 0, len(widget.Queries))
+		for _, query := range widget.Queries {
+			properties := map[string]interface{}{
+				"fields": query.Fields,
+				"id":     query.ID,
+			}
+			// Sentry returns empty strings for what was left out.
+			if query.Conditions != "" {
+				properties["conditions"] = query.Conditions
+			}
+			if query.Name != "" {
+				properties["name"] = query.Name
+			}
+			if query.OrderBy != "" {
+				properties["orderby"] = query.OrderBy
+			}
+			queries = append(queries, properties)
+		}
+		properties := map[string]interface{}{
+			"displayType": widget.DisplayType,
+			"id":          widget.ID,
+			"queries":     queries,
+			"title":       widget.Title,
+		}
+		if widget.Layout != nil {
+			properties["layout"] = map[string]interface{}{
+				"h": widget.Layout.H,
+				"w": widget.Layout.W,
+				"x": widget.Layout.X,
+				"y": widget.Layout.Y,
+			}
+		}
+		widgets = append(widgets, properties)
+	}
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"organizationSlug": organizationSlug,
+		"title":            d.Title,
+		"widgets":          widgets,
+	})
+}
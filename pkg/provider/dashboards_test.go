@@ -0,0 +1,459 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestDashboardCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "title", Reason: "this input must be a non-empty string"},
+			},
+		},
+		"wrong widgets": {
+			news: resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"title":            resource.NewPropertyValue("Checkout"),
+				"widgets": resource.NewPropertyValue([]interface{}{
+					map[string]interface{}{},
+					"not a widget",
+					map[string]interface{}{
+						"displayType": "pie",
+						"layout":      map[string]interface{}{"x": -1, "y": 0, "w": 0},
+						"queries": []interface{}{
+							map[string]interface{}{"fields": []interface{}{}},
+							map[string]interface{}{"fields": []interface{}{"count()"}, "conditions": "transaction:/checkout level:"},
+						},
+						"title": "Errors",
+					},
+				}),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "widgets[0].displayType", Reason: "this input must be one of: line, area, stacked_area, bar, table, big_number, top_n, world_map"},
+				{Property: "widgets[0].queries", Reason: "this input must be a non-empty list of queries"},
+				{Property: "widgets[0].title", Reason: "this input must be a non-empty string"},
+				{Property: "widgets[1]", Reason: "this input must be an object"},
+				{Property: "widgets[2].displayType", Reason: "this input must be one of: line, area, stacked_area, bar, table, big_number, top_n, world_map"},
+				{Property: "widgets[2].layout.h", Reason: "this input must be a positive integer"},
+				{Property: "widgets[2].layout.w", Reason: "this input must be a positive integer"},
+				{Property: "widgets[2].layout.x", Reason: "this input must be a non-negative integer"},
+				{Property: "widgets[2].queries[0].fields", Reason: "this input must be a non-empty list of non-empty strings"},
+				{Property: "widgets[2].queries[1].conditions", Reason: `this input must be a valid search query: the filter "level:" has no value`},
+			},
+		},
+		"correct full": {
+			news: resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"title":            resource.NewPropertyValue("Checkout"),
+				"widgets": resource.NewPropertyValue([]interface{}{
+					map[string]interface{}{
+						"displayType": "line",
+						"layout":      map[string]interface{}{"x": 0, "y": 0, "w": 2, "h": 1},
+						"queries": []interface{}{
+							map[string]interface{}{
+								"conditions": "transaction:/checkout",
+								"fields":     []interface{}{"count()"},
+								"name":       "Checkouts",
+								"orderby":    "-count",
+							},
+						},
+						"title": "Checkouts",
+					},
+				}),
+			},
+		},
+		"no widgets": {
+			news: resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"title":            resource.NewPropertyValue("Checkout"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.dashboardCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+		})
+	}
+}
+
+func TestDashboardCheckWithoutWidgets(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{}
+	resp, err := prov.dashboardCheck(ctx, &rpc.CheckRequest{
+		Urn: "urn:pulumi:fake::fake::fake::fake",
+		News: mustMarshalProperties(resource.PropertyMap{
+			"organizationSlug": resource.NewPropertyValue("org-slug"),
+			"title":            resource.NewPropertyValue("Checkout"),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.GetFailures())
+	inputs := mustUnmarshalProperties(resp.GetInputs())
+	assert.Equal(t, inputs["widgets"], emptyList)
+
+	// The state of a dashboard without widgets has an empty list of them.
+	diff, err := prov.dashboardDiff(resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"title":            resource.NewPropertyValue("Checkout"),
+		"widgets":          emptyList,
+	}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, *diff, rpc.DiffResponse{})
+}
+
+func TestDashboardDiff(t *testing.T) {
+	widget := func(id, title string) map[string]interface{} {
+		return map[string]interface{}{
+			"displayType": "line",
+			"id":          id,
+			"queries": []interface{}{
+				map[string]interface{}{"fields": []interface{}{"count()"}, "id": id + "0"},
+			},
+			"title": title,
+		}
+	}
+	baseOlds := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"title":            resource.NewPropertyValue("Checkout"),
+		"widgets": resource.NewPropertyValue([]interface{}{
+			widget("1", "Checkouts"),
+			widget("2", "Errors"),
+		}),
+	}
+	baseNews := dashboardProperties.inputs(baseOlds)
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{Changes: rpc.DiffResponse_DIFF_NONE},
+		},
+		"one widget changed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"widgets": resource.NewPropertyValue([]interface{}{
+					widget("", "Checkouts"),
+					widget("", "Errors per minute"),
+				}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:         rpc.DiffResponse_DIFF_SOME,
+				Diffs:           []string{"widgets"},
+				HasDetailedDiff: true,
+				DetailedDiff: map[string]*rpc.PropertyDiff{
+					"widgets[1]": {Kind: rpc.PropertyDiff_UPDATE},
+				},
+			},
+		},
+		"widget added and title changed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"title": resource.NewPropertyValue("Checkout service"),
+				"widgets": resource.NewPropertyValue([]interface{}{
+					widget("", "Checkouts"),
+					widget("", "Errors"),
+					widget("", "Latency"),
+				}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:         rpc.DiffResponse_DIFF_SOME,
+				Diffs:           []string{"title", "widgets"},
+				HasDetailedDiff: true,
+				DetailedDiff: map[string]*rpc.PropertyDiff{
+					"title":      {Kind: rpc.PropertyDiff_UPDATE},
+					"widgets[2]": {Kind: rpc.PropertyDiff_ADD},
+				},
+			},
+		},
+		"widget removed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"widgets": resource.NewPropertyValue([]interface{}{
+					widget("", "Checkouts"),
+				}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:         rpc.DiffResponse_DIFF_SOME,
+				Diffs:           []string{"widgets"},
+				HasDetailedDiff: true,
+				DetailedDiff: map[string]*rpc.PropertyDiff{
+					"widgets[1]": {Kind: rpc.PropertyDiff_DELETE},
+				},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("other-org"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+				HasDetailedDiff:     true,
+				DetailedDiff: map[string]*rpc.PropertyDiff{
+					"organizationSlug": {Kind: rpc.PropertyDiff_UPDATE_REPLACE},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.dashboardDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestDashboardCreate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createDashboard: func(org sentry.Organization, d dashboard) (dashboard, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, d, dashboard{
+					Title: "Checkout",
+					Widgets: []dashboardWidget{{
+						Title:       "Checkouts",
+						DisplayType: "line",
+						Queries: []dashboardWidgetQuery{
+							{Name: "Checkouts", Fields: []string{"count()"}, Conditions: "transaction:/checkout"},
+						},
+						Layout: &dashboardWidgetLayout{X: 0, Y: 0, W: 2, H: 1},
+					}},
+				})
+				d.ID = "7"
+				d.Widgets[0].ID = "70"
+				d.Widgets[0].Queries[0].ID = "700"
+				return d, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"title":            resource.NewPropertyValue("Checkout"),
+		"widgets": resource.NewPropertyValue([]interface{}{
+			map[string]interface{}{
+				"displayType": "line",
+				"layout":      map[string]interface{}{"x": 0, "y": 0, "w": 2, "h": 1},
+				"queries": []interface{}{
+					map[string]interface{}{
+						"conditions": "transaction:/checkout",
+						"fields":     []interface{}{"count()"},
+						"name":       "Checkouts",
+					},
+				},
+				"title": "Checkouts",
+			},
+		}),
+	}
+	resp, err := prov.dashboardCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/7")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"title":            resource.NewPropertyValue("Checkout"),
+		"widgets": resource.NewPropertyValue([]interface{}{
+			map[string]interface{}{
+				"displayType": "line",
+				"id":          "70",
+				"layout":      map[string]interface{}{"x": 0, "y": 0, "w": 2, "h": 1},
+				"queries": []interface{}{
+					map[string]interface{}{
+						"conditions": "transaction:/checkout",
+						"fields":     []interface{}{"count()"},
+						"id":         "700",
+						"name":       "Checkouts",
+					},
+				},
+				"title": "Checkouts",
+			},
+		}),
+	})
+}
+
+func TestDashboardRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getDashboard: func(org sentry.Organization, id string) (dashboard, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, id, "7")
+				return dashboard{
+					ID:    "7",
+					Title: "Checkout",
+					Widgets: []dashboardWidget{{
+						ID:          "70",
+						Title:       "Errors",
+						DisplayType: "big_number",
+						Queries: []dashboardWidgetQuery{
+							{ID: "700", Fields: []string{"count()"}, Conditions: "event.type:error"},
+						},
+					}},
+				}, nil
+			},
+		},
+	}
+	resp, err := prov.dashboardRead(ctx, &rpc.ReadRequest{Id: "org-slug/7"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/7")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"title":            resource.NewPropertyValue("Checkout"),
+		"widgets": resource.NewPropertyValue([]interface{}{
+			map[string]interface{}{
+				"displayType": "big_number",
+				"queries": []interface{}{
+					map[string]interface{}{
+						"conditions": "event.type:error",
+						"fields":     []interface{}{"count()"},
+					},
+				},
+				"title": "Errors",
+			},
+		}),
+	})
+}
+
+func TestDashboardRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getDashboard: func(org sentry.Organization, id string) (dashboard, error) {
+				return dashboard{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.dashboardRead(ctx, &rpc.ReadRequest{Id: "org-slug/7"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestDashboardUpdate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			updateDashboard: func(org sentry.Organization, d dashboard) (dashboard, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				// The first widget keeps its ID, so that Sentry updates it in
+				// place, and the second one is created.
+				assert.Equal(t, d, dashboard{
+					ID:    "7",
+					Title: "Checkout",
+					Widgets: []dashboardWidget{
+						{
+							ID:          "70",
+							Title:       "Errors per minute",
+							DisplayType: "line",
+							Queries:     []dashboardWidgetQuery{{ID: "700", Fields: []string{"count()"}}},
+						},
+						{
+							Title:       "Latency",
+							DisplayType: "line",
+							Queries:     []dashboardWidgetQuery{{Fields: []string{"p95()"}}},
+						},
+					},
+				})
+				d.Widgets[1].ID = "71"
+				d.Widgets[1].Queries[0].ID = "710"
+				return d, nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"title":            resource.NewPropertyValue("Checkout"),
+		"widgets": resource.NewPropertyValue([]interface{}{
+			map[string]interface{}{
+				"displayType": "line",
+				"id":          "70",
+				"queries":     []interface{}{map[string]interface{}{"fields": []interface{}{"count()"}, "id": "700"}},
+				"title":       "Errors",
+			},
+		}),
+	}
+	news := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"title":            resource.NewPropertyValue("Checkout"),
+		"widgets": resource.NewPropertyValue([]interface{}{
+			map[string]interface{}{
+				"displayType": "line",
+				"queries":     []interface{}{map[string]interface{}{"fields": []interface{}{"count()"}}},
+				"title":       "Errors per minute",
+			},
+			map[string]interface{}{
+				"displayType": "line",
+				"queries":     []interface{}{map[string]interface{}{"fields": []interface{}{"p95()"}}},
+				"title":       "Latency",
+			},
+		}),
+	}
+	resp, err := prov.dashboardUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/7",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"title":            resource.NewPropertyValue("Checkout"),
+		"widgets": resource.NewPropertyValue([]interface{}{
+			map[string]interface{}{
+				"displayType": "line",
+				"id":          "70",
+				"queries":     []interface{}{map[string]interface{}{"fields": []interface{}{"count()"}, "id": "700"}},
+				"title":       "Errors per minute",
+			},
+			map[string]interface{}{
+				"displayType": "line",
+				"id":          "71",
+				"queries":     []interface{}{map[string]interface{}{"fields": []interface{}{"p95()"}, "id": "710"}},
+				"title":       "Latency",
+			},
+		}),
+	})
+}
+
+func TestDashboardDelete(t *testing.T) {
+	ctx := context.Background()
+	var deleted string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			deleteDashboard: func(org sentry.Organization, id string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				deleted = id
+				return nil
+			},
+		},
+	}
+	_, err := prov.dashboardDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/7"})
+	assert.Nil(t, err)
+	assert.Equal(t, deleted, "7")
+}
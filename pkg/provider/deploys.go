@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// deployProperties are all changed by replacement: deploys are a log which
+// Sentry only appends to, so a changed deploy is recorded as a new one.
+var deployProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"dateFinished":     true,
+		"dateStarted":      true,
+		"environment":      true,
+		"name":             true,
+		"organizationSlug": true,
+		"url":              true,
+		"version":          true,
+	},
+	changedByUpdate: map[string]bool{},
+	outputs:         map[string]bool{},
+}
+
+func (k *sentryProvider) deployCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalTime(&failures, news, "dateFinished")
+	checkOptionalTime(&failures, news, "dateStarted")
+	checkNonEmptyString(&failures, news, "environment")
+	checkOptionalString(&failures, news, "name")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	if !news["url"].IsNull() {
+		checkHTTPURL(&failures, news, "url")
+	}
+	checkNonEmptyString(&failures, news, "version")
+
+	news["dateFinished"] = normalizedTime(news["dateFinished"])
+	news["dateStarted"] = normalizedTime(news["dateStarted"])
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) deployDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	// Sentry fills in the dates left out with the time of the deploy.
+	return deployProperties.diff(withoutUnset(olds, news, "dateFinished", "dateStarted"), news)
+}
+
+func (k *sentryProvider) deployCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	version := inputs["version"].StringValue()
+
+	deploy, err := k.sentryClient.CreateReleaseDeploy(sentry.Organization{Slug: &organizationSlug}, version, releaseDeploy{
+		Environment:  inputs["environment"].StringValue(),
+		Name:         stringPtrFromPropertyValue(inputs["name"]),
+		URL:          stringPtrFromPropertyValue(inputs["url"]),
+		DateStarted:  timePtrFromPropertyValue(inputs["dateStarted"]),
+		DateFinished: timePtrFromPropertyValue(inputs["dateFinished"]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateReleaseDeploy of %v to %v: %w", version, inputs["environment"].StringValue(), err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		deployPropertyMap(organizationSlug, version, deploy),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildID(organizationSlug, version, deploy.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+// deployUpdate has nothing to update, every change records a new deploy.
+func (k *sentryProvider) deployUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed deployUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed deployUpdate because of malformed resource inputs: %w", err)
+	}
+
+	if err := deployProperties.checkUpdatable("deployUpdate", withoutUnset(olds, news, "dateFinished", "dateStarted"), news); err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: req.GetOlds()}, nil
+}
+
+func (k *sentryProvider) deployRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, version, id, err := parseDeployID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	deploys, err := k.sentryClient.GetReleaseDeploys(sentry.Organization{Slug: &organizationSlug}, version)
+	if err != nil {
+		if isNotFound(err) {
+			// The release is not there, and its deploys with it.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetReleaseDeploys %v: %w", version, err)
+	}
+	for _, deploy := range deploys {
+		if deploy.ID != id {
+			continue
+		}
+		properties := deployPropertyMap(organizationSlug, version, deploy)
+		state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+			Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		inputs, err := plugin.MarshalProperties(deployProperties.inputs(properties), plugin.MarshalOptions{
+			Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &rpc.ReadResponse{
+			Id:         req.GetId(),
+			Properties: state,
+			Inputs:     inputs,
+		}, nil
+	}
+	// The deploy is not there, delete it from stack state.
+	return &rpc.ReadResponse{}, nil
+}
+
+// deployDelete does nothing: Sentry keeps deploys as a log, and has no way to
+// delete them.
+func (k *sentryProvider) deployDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	_, _, _, err := parseDeployID(req.GetId())
+	return &pbempty.Empty{}, err
+}
+
+// parseDeployID parses IDs of deploys: <orgSlug>/<version>/<deployID>.
+func parseDeployID(id string) (organizationSlug, version, deployID string, err error) {
+	parts, err := parseID(id, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func deployPropertyMap(organizationSlug, version string, deploy releaseDeploy) resource.PropertyMap {
+	properties := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"environment":      deploy.Environment,
+		"name":             deploy.Name,
+		"organizationSlug": organizationSlug,
+		"url":              deploy.URL,
+		"version":          version,
+	})
+	if deploy.DateStarted != nil {
+		properties["dateStarted"] = resource.NewStringProperty(formatTime(*deploy.DateStarted))
+	}
+	if deploy.DateFinished != nil {
+		properties["dateFinished"] = resource.NewStringProperty(formatTime(*deploy.DateFinished))
+	}
+	return properties
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestDeployCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "environment", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "version", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{},
+		},
+		"normalized dates": {
+			news: resource.PropertyMap{
+				"dateFinished":     resource.NewPropertyValue("2020-12-01T11:05:00+01:00"),
+				"dateStarted":      resource.NewPropertyValue("soon"),
+				"environment":      resource.NewPropertyValue("production"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"version":          resource.NewPropertyValue("1.2.3"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "dateStarted", Reason: "this input must be a date and time in RFC 3339 format, e.g. 2020-12-01T10:00:00Z"},
+			},
+			wantInputs: resource.PropertyMap{
+				"dateFinished":     resource.NewPropertyValue("2020-12-01T10:05:00Z"),
+				"dateStarted":      resource.NewPropertyValue("soon"),
+				"environment":      resource.NewPropertyValue("production"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"version":          resource.NewPropertyValue("1.2.3"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.deployCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestDeployDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"dateFinished":     resource.NewPropertyValue("2020-12-01T10:05:00.123456Z"),
+		"environment":      resource.NewPropertyValue("production"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"version":          resource.NewPropertyValue("1.2.3"),
+	}
+	baseNews := resource.PropertyMap{
+		"environment":      resource.NewPropertyValue("production"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"version":          resource.NewPropertyValue("1.2.3"),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change with the date filled in by Sentry": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"every change records a new deploy": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"version": resource.NewPropertyValue("1.2.4"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"version"},
+				Replaces:            []string{"version"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.deployDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestDeployCreate(t *testing.T) {
+	ctx := context.Background()
+	dateFinished := time.Date(2020, 12, 1, 10, 5, 0, 123456000, time.UTC)
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createReleaseDeploy: func(org sentry.Organization, version string, d releaseDeploy) (releaseDeploy, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, version, "1.2.3")
+				assert.Equal(t, d, releaseDeploy{Environment: "production", Name: stringPtr("deploy #1")})
+				d.ID = "42"
+				d.DateFinished = &dateFinished
+				return d, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"environment":      resource.NewPropertyValue("production"),
+		"name":             resource.NewPropertyValue("deploy #1"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"version":          resource.NewPropertyValue("1.2.3"),
+	}
+	resp, err := prov.deployCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/1.2.3/42")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(inputs, resource.PropertyMap{
+		"dateFinished": resource.NewPropertyValue("2020-12-01T10:05:00.123456Z"),
+	}))
+}
+
+func TestDeployRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getReleaseDeploys: func(org sentry.Organization, version string) ([]releaseDeploy, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, version, "1.2.3")
+				return []releaseDeploy{
+					{ID: "41", Environment: "staging"},
+					{ID: "42", Environment: "production"},
+				}, nil
+			},
+		},
+	}
+	resp, err := prov.deployRead(ctx, &rpc.ReadRequest{Id: "org-slug/1.2.3/42"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/1.2.3/42")
+	want := resource.PropertyMap{
+		"environment":      resource.NewPropertyValue("production"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"version":          resource.NewPropertyValue("1.2.3"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+
+	resp, err = prov.deployRead(ctx, &rpc.ReadRequest{Id: "org-slug/1.2.3/43"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+}
+
+func TestDeployDelete(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{sentryClient: &sentryClientMock{}}
+	_, err := prov.deployDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/1.2.3/42"})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// resourceProperties describes how each property of a resource reacts to
+// changes: by replacing the resource, by updating it in place, or not at all
+// because the property is an output computed by Sentry.
+type resourceProperties struct {
+	changedByReplacement map[string]bool
+	changedByUpdate      map[string]bool
+	outputs              map[string]bool
+	// nested describes the objects in list properties, such as the triggers
+	// of metric alert rules, which carry outputs of their own.
+	nested map[string]resourceProperties
+}
+
+// diff compares the old state of a resource with its new inputs.
+func (p resourceProperties) diff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	d := olds.Diff(news)
+	if d == nil {
+		return &rpc.DiffResponse{}, nil
+	}
+
+	var diffs, replaces []string
+	for _, key := range d.Keys() {
+		if d.Changed(key) && p.listChanged(key, olds[key], news[key]) {
+			switch {
+			case p.changedByReplacement[string(key)]:
+				diffs = append(diffs, string(key))
+				replaces = append(replaces, string(key))
+			case p.changedByUpdate[string(key)]:
+				diffs = append(diffs, string(key))
+			case p.outputs[string(key)]:
+				// Ignore.
+			default:
+				// Sanity check panic.  This should not happen unless we have
+				// an inconsistency in property definitions, in which case it's
+				// better find out here during testing.
+				panic(fmt.Sprintf("don't know how to deal with change in %v", key))
+			}
+		}
+	}
+
+	changes := rpc.DiffResponse_DIFF_NONE
+	if len(diffs) > 0 {
+		changes = rpc.DiffResponse_DIFF_SOME
+	}
+
+	return &rpc.DiffResponse{
+		Changes:             changes,
+		Diffs:               diffs,
+		Replaces:            replaces,
+		DeleteBeforeReplace: len(replaces) > 0,
+	}, nil
+}
+
+// checkUpdatable returns an error if any of the changes between olds and news
+// can't be applied by an in-place update.  This should already be validated by
+// Diff, but Update implementations use it to be strict just in case.
+func (p resourceProperties) checkUpdatable(label string, olds, news resource.PropertyMap) error {
+	d := olds.Diff(news)
+	if d == nil {
+		return nil
+	}
+	for _, key := range d.Keys() {
+		if d.Changed(key) && p.listChanged(key, olds[key], news[key]) && !p.changedByUpdate[string(key)] && !p.outputs[string(key)] {
+			return fmt.Errorf("%s: don't know how to change %v", label, key)
+		}
+	}
+	return nil
+}
+
+// listChanged compares the old and new values of a property changed
+// according to resource.PropertyMap.Diff.  For lists of objects described by
+// p.nested, the objects are compared element by element, ignoring their
+// outputs: the state has them, the inputs don't.
+func (p resourceProperties) listChanged(key resource.PropertyKey, old, new resource.PropertyValue) bool {
+	nested, ok := p.nested[string(key)]
+	if !ok || !old.IsArray() || !new.IsArray() || len(old.ArrayValue()) != len(new.ArrayValue()) {
+		return true
+	}
+	for i, oldElement := range old.ArrayValue() {
+		if nested.elementChanged(oldElement, new.ArrayValue()[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// elementChanged compares an element of a list of objects described by p,
+// ignoring the outputs of the objects.
+func (p resourceProperties) elementChanged(old, new resource.PropertyValue) bool {
+	if !old.IsObject() || !new.IsObject() {
+		return !old.DeepEquals(new)
+	}
+	d := old.ObjectValue().Diff(new.ObjectValue())
+	if d == nil {
+		return false
+	}
+	for _, key := range d.Keys() {
+		if d.Changed(key) && !p.outputs[string(key)] &&
+			p.listChanged(key, old.ObjectValue()[key], new.ObjectValue()[key]) {
+			return true
+		}
+	}
+	return false
+}
+
+// detailedDiff describes the changes reported by diff property by property.
+// Lists of objects described by p.nested, such as the widgets of dashboards,
+// are described element by element, e.g. widgets[1], so that editing one of
+// the objects is not shown as a change of the whole list.
+func (p resourceProperties) detailedDiff(olds, news resource.PropertyMap) map[string]*rpc.PropertyDiff {
+	d := olds.Diff(news)
+	if d == nil {
+		return nil
+	}
+
+	detailedDiff := map[string]*rpc.PropertyDiff{}
+	for _, key := range d.Keys() {
+		old, new := olds[key], news[key]
+		if !d.Changed(key) || p.outputs[string(key)] || !p.listChanged(key, old, new) {
+			continue
+		}
+		replace := p.changedByReplacement[string(key)]
+		nested, ok := p.nested[string(key)]
+		if !ok || replace || !old.IsArray() || !new.IsArray() {
+			detailedDiff[string(key)] = &rpc.PropertyDiff{Kind: propertyDiffKind(old, new, replace)}
+			continue
+		}
+		oldElements, newElements := old.ArrayValue(), new.ArrayValue()
+		for i := 0; i < len(oldElements) || i < len(newElements); i++ {
+			path := fmt.Sprintf("%s[%d]", key, i)
+			switch {
+			case i >= len(oldElements):
+				detailedDiff[path] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_ADD}
+			case i >= len(newElements):
+				detailedDiff[path] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_DELETE}
+			case nested.elementChanged(oldElements[i], newElements[i]):
+				detailedDiff[path] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE}
+			}
+		}
+	}
+	return detailedDiff
+}
+
+func propertyDiffKind(old, new resource.PropertyValue, replace bool) rpc.PropertyDiff_Kind {
+	switch {
+	case old.IsNull() && replace:
+		return rpc.PropertyDiff_ADD_REPLACE
+	case old.IsNull():
+		return rpc.PropertyDiff_ADD
+	case new.IsNull() && replace:
+		return rpc.PropertyDiff_DELETE_REPLACE
+	case new.IsNull():
+		return rpc.PropertyDiff_DELETE
+	case replace:
+		return rpc.PropertyDiff_UPDATE_REPLACE
+	default:
+		return rpc.PropertyDiff_UPDATE
+	}
+}
+
+// inputs picks the inputs of a resource out of its state, for Read to return
+// them together with the state.  Without them, resources brought in with
+// `pulumi import` would have no inputs and show a diff right away.  Empty
+// strings are left out as they are what Sentry returns for unset settings.
+func (p resourceProperties) inputs(state resource.PropertyMap) resource.PropertyMap {
+	inputs := resource.PropertyMap{}
+	for key, value := range state {
+		if !p.changedByReplacement[string(key)] && !p.changedByUpdate[string(key)] {
+			continue
+		}
+		if value.IsNull() || (value.IsString() && value.StringValue() == "") {
+			continue
+		}
+		if nested, ok := p.nested[string(key)]; ok && value.IsArray() {
+			elements := make([]resource.PropertyValue, 0, len(value.ArrayValue()))
+			for _, element := range value.ArrayValue() {
+				if element.IsObject() {
+					element = resource.NewObjectProperty(nested.inputs(element.ObjectValue()))
+				}
+				elements = append(elements, element)
+			}
+			value = resource.NewArrayProperty(elements)
+		}
+		inputs[key] = value
+	}
+	return inputs
+}
+
+// withoutEmptyStrings returns a copy of props without the given keys when
+// they are empty strings, for properties where Sentry doesn't tell an empty
+// string apart from an unset value.
+func withoutEmptyStrings(props resource.PropertyMap, keys ...string) resource.PropertyMap {
+	ret := props.Copy()
+	for _, key := range keys {
+		if value := ret[resource.PropertyKey(key)]; value.IsString() && value.StringValue() == "" {
+			delete(ret, resource.PropertyKey(key))
+		}
+	}
+	return ret
+}
+
+// withoutUnset returns a copy of olds without the optional keys left out of
+// news, so that what Sentry has for properties that are not managed is not
+// reported as a change.
+func withoutUnset(olds, news resource.PropertyMap, keys ...string) resource.PropertyMap {
+	ret := olds.Copy()
+	for _, key := range keys {
+		if news[resource.PropertyKey(key)].IsNull() {
+			delete(ret, resource.PropertyKey(key))
+		}
+	}
+	return ret
+}
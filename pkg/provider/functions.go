@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// invokeArgs unmarshals and validates the arguments of a function: every
+// name in required must be a non-empty string.  A nil map is returned
+// together with failures if they are not.
+func (k *sentryProvider) invokeArgs(req *rpc.InvokeRequest, required ...string) (resource.PropertyMap, []*rpc.CheckFailure, error) {
+	label := fmt.Sprintf("%s.Invoke(%s)", k.label(), req.GetTok())
+	logger.V(9).Infof("%s executing", label)
+
+	args, err := plugin.UnmarshalProperties(req.GetArgs(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.args", label),
+		SkipNulls:    true,
+		RejectAssets: true,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed %s because of malformed arguments: %w", req.GetTok(), err)
+	}
+
+	k.fillDefaultOrganization(args)
+
+	var failures []*rpc.CheckFailure
+	for _, name := range required {
+		checkNonEmptyString(&failures, args, name)
+	}
+	if len(failures) > 0 {
+		return nil, failures, nil
+	}
+	return args, nil, nil
+}
+
+func invokeResponse(outputs resource.PropertyMap) (*rpc.InvokeResponse, error) {
+	ret, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.InvokeResponse{Return: ret}, nil
+}
+
+func (k *sentryProvider) getOrganizationInvoke(ctx context.Context, req *rpc.InvokeRequest) (*rpc.InvokeResponse, error) {
+	args, failures, err := k.invokeArgs(req, "slug")
+	if err != nil || failures != nil {
+		return &rpc.InvokeResponse{Failures: failures}, err
+	}
+
+	slug := args["slug"].StringValue()
+	org, err := k.sentryClient.GetOrganization(slug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetOrganization %v: %v", slug, err)
+	}
+
+	return invokeResponse(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"id":   org.ID,
+		"name": org.Name,
+		"slug": slug,
+	}))
+}
+
+func (k *sentryProvider) getTeamInvoke(ctx context.Context, req *rpc.InvokeRequest) (*rpc.InvokeResponse, error) {
+	args, failures, err := k.invokeArgs(req, "organizationSlug", "slug")
+	if err != nil || failures != nil {
+		return &rpc.InvokeResponse{Failures: failures}, err
+	}
+
+	organizationSlug := args["organizationSlug"].StringValue()
+	slug := args["slug"].StringValue()
+	team, err := k.sentryClient.GetTeam(sentry.Organization{Slug: &organizationSlug}, slug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetTeam %v: %v", slug, err)
+	}
+
+	outputs := teamPropertyMap(organizationSlug, team)
+	outputs["id"] = resource.NewPropertyValue(team.ID)
+	return invokeResponse(outputs)
+}
+
+func (k *sentryProvider) getProjectInvoke(ctx context.Context, req *rpc.InvokeRequest) (*rpc.InvokeResponse, error) {
+	args, failures, err := k.invokeArgs(req, "organizationSlug", "slug")
+	if err != nil || failures != nil {
+		return &rpc.InvokeResponse{Failures: failures}, err
+	}
+
+	organizationSlug := args["organizationSlug"].StringValue()
+	slug := args["slug"].StringValue()
+	project, err := k.sentryClient.GetProject(sentry.Organization{Slug: &organizationSlug}, slug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProject %v: %v", slug, err)
+	}
+
+	outputs, err := k.projectPropertyMap(organizationSlug, project)
+	if err != nil {
+		return nil, err
+	}
+	outputs["id"] = resource.NewStringProperty(project.ID)
+	// Function results can't be marked secret, unlike the Project outputs.
+	delete(outputs, "securityToken")
+	return invokeResponse(outputs)
+}
+
+func (k *sentryProvider) getOrganizationIntegrationInvoke(ctx context.Context, req *rpc.InvokeRequest) (*rpc.InvokeResponse, error) {
+	args, failures, err := k.invokeArgs(req, "organizationSlug", "providerKey", "name")
+	if err != nil || failures != nil {
+		return &rpc.InvokeResponse{Failures: failures}, err
+	}
+
+	organizationSlug := args["organizationSlug"].StringValue()
+	providerKey := args["providerKey"].StringValue()
+	name := args["name"].StringValue()
+	integrations, err := k.sentryClient.GetOrganizationIntegrations(sentry.Organization{Slug: &organizationSlug}, providerKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetOrganizationIntegrations %v: %v", providerKey, err)
+	}
+	for _, integration := range integrations {
+		if integration.Name == name {
+			return invokeResponse(resource.NewPropertyMapFromMap(map[string]interface{}{
+				"domainName":       integration.DomainName,
+				"externalId":       integration.ExternalID,
+				"id":               integration.ID,
+				"name":             integration.Name,
+				"organizationSlug": organizationSlug,
+				"providerKey":      integration.Provider.Key,
+				"providerName":     integration.Provider.Name,
+				"status":           integration.Status,
+			}))
+		}
+	}
+	return nil, fmt.Errorf("no %v integration named %v in organization %v", providerKey, name, organizationSlug)
+}
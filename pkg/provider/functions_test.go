@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestInvokeUnknown(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{}
+	_, err := prov.Invoke(ctx, &rpc.InvokeRequest{Tok: "sentry:index:getNothing"})
+	assert.NotNil(t, err)
+}
+
+func TestInvokeMissingArgs(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{}
+	resp, err := prov.Invoke(ctx, &rpc.InvokeRequest{
+		Tok:  "sentry:index:getProject",
+		Args: mustMarshalProperties(resource.PropertyMap{"slug": resource.NewPropertyValue("")}),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.GetReturn())
+	assert.Equal(t, resp.GetFailures(), []*rpc.CheckFailure{
+		{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+		{Property: "slug", Reason: "this input must be a non-empty string"},
+	})
+}
+
+func TestGetOrganizationInvoke(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganization: func(orgslug string) (sentry.Organization, error) {
+				assert.Equal(t, orgslug, "org-slug")
+				return sentry.Organization{ID: stringPtr("1"), Name: "Org", Slug: stringPtr("org-slug")}, nil
+			},
+		},
+	}
+	resp, err := prov.Invoke(ctx, &rpc.InvokeRequest{
+		Tok:  "sentry:index:getOrganization",
+		Args: mustMarshalProperties(resource.PropertyMap{"slug": resource.NewPropertyValue("org-slug")}),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.GetFailures())
+	assert.Equal(t, mustUnmarshalProperties(resp.GetReturn()), resource.PropertyMap{
+		"id":   resource.NewPropertyValue("1"),
+		"name": resource.NewPropertyValue("Org"),
+		"slug": resource.NewPropertyValue("org-slug"),
+	})
+}
+
+func TestGetTeamInvoke(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getTeam: func(org sentry.Organization, teamSlug string) (sentry.Team, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, teamSlug, "team-slug")
+				return sentry.Team{ID: stringPtr("2"), Name: "Team", Slug: stringPtr("team-slug")}, nil
+			},
+		},
+	}
+	resp, err := prov.Invoke(ctx, &rpc.InvokeRequest{
+		Tok: "sentry:index:getTeam",
+		Args: mustMarshalProperties(resource.PropertyMap{
+			"organizationSlug": resource.NewPropertyValue("org-slug"),
+			"slug":             resource.NewPropertyValue("team-slug"),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetReturn()), resource.PropertyMap{
+		"id":               resource.NewPropertyValue("2"),
+		"name":             resource.NewPropertyValue("Team"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"slug":             resource.NewPropertyValue("team-slug"),
+	})
+}
+
+func TestGetProjectInvoke(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getClientKeys: func(o sentry.Organization, p sentry.Project) ([]sentry.Key, error) {
+				return []sentry.Key{
+					{ID: "default-key", Label: "Default", DSN: sentry.DSN{Public: "public-dsn"}},
+				}, nil
+			},
+			getClientKeyDetails: func(o sentry.Organization, p sentry.Project, id string) (clientKey, error) {
+				return clientKey{ID: id, Name: "Default", IsActive: true, RateLimit: &clientKeyRateLimit{Window: 3600, Count: 500}}, nil
+			},
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, projslug, "proj-slug")
+				return sentry.Project{
+					ID:                 "42",
+					DefaultEnvironment: stringPtr("production"),
+					Name:               "Project",
+					Slug:               stringPtr("proj-slug"),
+				}, nil
+			},
+			getProjectTeams: func(org sentry.Organization, proj sentry.Project) ([]sentry.Team, error) {
+				return []sentry.Team{{Slug: stringPtr("team-slug")}}, nil
+			},
+			getProjectSettings: func(org sentry.Organization, proj sentry.Project) (projectSettings, error) {
+				return projectSettings{DataScrubber: boolPtr(true), SecurityToken: stringPtr("security-token")}, nil
+			},
+		},
+	}
+	resp, err := prov.Invoke(ctx, &rpc.InvokeRequest{
+		Tok: "sentry:index:getProject",
+		Args: mustMarshalProperties(resource.PropertyMap{
+			"organizationSlug": resource.NewPropertyValue("org-slug"),
+			"slug":             resource.NewPropertyValue("proj-slug"),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetReturn()), resource.PropertyMap{
+		"dataScrubber":                    resource.NewPropertyValue(true),
+		"defaultClientKeyDSNPublic":       resource.NewPropertyValue("public-dsn"),
+		"defaultClientKeyRateLimitCount":  resource.NewPropertyValue(500),
+		"defaultClientKeyRateLimitWindow": resource.NewPropertyValue(3600),
+		"defaultEnvironment":              resource.NewPropertyValue("production"),
+		"id":                              resource.NewPropertyValue("42"),
+		"name":                            resource.NewPropertyValue("Project"),
+		"organizationSlug":                resource.NewPropertyValue("org-slug"),
+		"slug":                            resource.NewPropertyValue("proj-slug"),
+		"teamSlug":                        resource.NewPropertyValue("team-slug"),
+		"teamSlugs":                       resource.NewPropertyValue([]string{"team-slug"}),
+	})
+}
+
+func TestGetOrganizationIntegrationInvoke(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganizationIntegrations: func(org sentry.Organization, providerKey string) ([]organizationIntegration, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, providerKey, "slack")
+				return []organizationIntegration{
+					{ID: 2, Name: "Other Workspace", Provider: integrationProvider{Key: "slack", Name: "Slack"}},
+					{
+						ID:         3,
+						Name:       "Workspace",
+						DomainName: "workspace.slack.com",
+						ExternalID: "T0123",
+						Status:     "active",
+						Provider:   integrationProvider{Key: "slack", Name: "Slack"},
+					},
+				}, nil
+			},
+		},
+	}
+	args := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Workspace"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"providerKey":      resource.NewPropertyValue("slack"),
+	}
+	resp, err := prov.Invoke(ctx, &rpc.InvokeRequest{
+		Tok:  "sentry:index:getOrganizationIntegration",
+		Args: mustMarshalProperties(args),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetReturn()), resource.PropertyMap{
+		"domainName":       resource.NewPropertyValue("workspace.slack.com"),
+		"externalId":       resource.NewPropertyValue("T0123"),
+		"id":               resource.NewPropertyValue(3),
+		"name":             resource.NewPropertyValue("Workspace"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"providerKey":      resource.NewPropertyValue("slack"),
+		"providerName":     resource.NewPropertyValue("Slack"),
+		"status":           resource.NewPropertyValue("active"),
+	})
+
+	_, err = prov.Invoke(ctx, &rpc.InvokeRequest{
+		Tok: "sentry:index:getOrganizationIntegration",
+		Args: mustMarshalProperties(propertyMapWithOverrides(args, resource.PropertyMap{
+			"name": resource.NewPropertyValue("Missing Workspace"),
+		})),
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, err.Error(), "no slack integration named Missing Workspace in organization org-slug")
+}
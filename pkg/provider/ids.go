@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// buildID joins the parts identifying a resource in Sentry, usually a chain
+// of slugs from the organization down, into a Pulumi ID.
+func buildID(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// parseID splits an ID built by buildID, checking it has exactly n parts.
+func parseID(id string, n int) ([]string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != n {
+		return nil, fmt.Errorf("invalid ID: %s", id)
+	}
+	return parts, nil
+}
+
+// buildSlugID builds the Pulumi ID of a resource living in an organization
+// and identified by its slug, like projects and teams: <orgSlug>/<slug>.
+func buildSlugID(organizationSlug, slug string) string {
+	return buildID(organizationSlug, slug)
+}
+
+func parseSlugID(id string) (organizationSlug, slug string, err error) {
+	parts, err := parseID(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
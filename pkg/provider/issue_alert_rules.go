@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// Defaults applied by Sentry when creating issue alert rules.
+const (
+	issueAlertRuleDefaultMatch     = "all"
+	issueAlertRuleDefaultFrequency = 30
+)
+
+var issueAlertRuleProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		// A rule can't be moved between organizations.
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"actionMatch": true,
+		"actions":     true,
+		"conditions":  true,
+		"environment": true,
+		"filterMatch": true,
+		"filters":     true,
+		"frequency":   true,
+		"name":        true,
+		// Follows renames of the project, see buildProjectResourceID.
+		"projectSlug": true,
+	},
+	outputs: map[string]bool{},
+}
+
+func (k *sentryProvider) issueAlertRuleCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalOneOf(&failures, news, "actionMatch", "all", "any", "none")
+	checkObjectsWithID(&failures, news, "actions", true)
+	checkObjectsWithID(&failures, news, "conditions", false)
+	checkOptionalString(&failures, news, "environment")
+	checkOptionalOneOf(&failures, news, "filterMatch", "all", "any", "none")
+	checkObjectsWithID(&failures, news, "filters", false)
+	checkOptionalPositiveInteger(&failures, news, "frequency")
+	checkNonEmptyString(&failures, news, "name")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "projectSlug")
+
+	// Fill in what Sentry defaults to, so that Read does not report a
+	// difference against inputs that skip them.
+	if news["actionMatch"].IsNull() {
+		news["actionMatch"] = resource.NewStringProperty(issueAlertRuleDefaultMatch)
+	}
+	if news["filterMatch"].IsNull() {
+		news["filterMatch"] = resource.NewStringProperty(issueAlertRuleDefaultMatch)
+	}
+	if news["frequency"].IsNull() {
+		news["frequency"] = resource.NewNumberProperty(issueAlertRuleDefaultFrequency)
+	}
+	for _, key := range []resource.PropertyKey{"conditions", "filters"} {
+		if news[key].IsNull() {
+			news[key] = resource.NewArrayProperty([]resource.PropertyValue{})
+		}
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) issueAlertRuleDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return issueAlertRuleProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) issueAlertRuleCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	projectSlug := inputs["projectSlug"].StringValue()
+	org := sentry.Organization{Slug: &organizationSlug}
+	project, err := k.sentryClient.GetProject(org, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProject %v: %w", projectSlug, err)
+	}
+
+	wanted := issueAlertRuleFromInputs(inputs)
+	rule, err := k.sentryClient.CreateIssueAlertRule(org, project, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateIssueAlertRule %v: %v", wanted.Name, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		issueAlertRulePropertyMap(organizationSlug, projectSlug, rule),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildProjectResourceID(organizationSlug, project, rule.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) issueAlertRuleUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed issueAlertRuleUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed issueAlertRuleUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := issueAlertRuleProperties.checkUpdatable("issueAlertRuleUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	organizationSlug, project, parts, err := k.parseUpdatedProjectResourceID(req.GetId(), news, 1)
+	if err != nil {
+		return nil, err
+	}
+	wanted := issueAlertRuleFromInputs(news)
+	wanted.ID = parts[0]
+	rule, err := k.sentryClient.UpdateIssueAlertRule(sentry.Organization{Slug: &organizationSlug}, project, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateIssueAlertRule %v: %v", wanted.ID, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		issueAlertRulePropertyMap(organizationSlug, *project.Slug, rule),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) issueAlertRuleRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.properties", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed issueAlertRuleRead because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, parts, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 1)
+	var rule issueAlertRule
+	if err == nil {
+		rule, err = k.sentryClient.GetIssueAlertRule(sentry.Organization{Slug: &organizationSlug}, project, parts[0])
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// The rule or its project is not there, delete it from stack
+			// state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, err
+	}
+	properties := issueAlertRulePropertyMap(organizationSlug, *project.Slug, rule)
+	newState, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(issueAlertRuleProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		// This also migrates legacy <orgSlug>/<projectSlug>/<ruleID> IDs.
+		Id:         buildProjectResourceID(organizationSlug, project, rule.ID),
+		Properties: newState,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) issueAlertRuleDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{})
+	if err != nil {
+		return &pbempty.Empty{}, fmt.Errorf("failed issueAlertRuleDelete because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, parts, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 1)
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteIssueAlertRule(sentry.Organization{Slug: &organizationSlug}, project, parts[0])
+	return &pbempty.Empty{}, err
+}
+
+func issueAlertRuleFromInputs(inputs resource.PropertyMap) issueAlertRule {
+	rule := issueAlertRule{
+		Name:        inputs["name"].StringValue(),
+		ActionMatch: stringFromPropertyValue(inputs["actionMatch"]),
+		FilterMatch: stringFromPropertyValue(inputs["filterMatch"]),
+		Frequency:   issueAlertRuleDefaultFrequency,
+		Environment: stringPtrFromPropertyValue(inputs["environment"]),
+		Conditions:  objectsFromPropertyValue(inputs["conditions"]),
+		Filters:     objectsFromPropertyValue(inputs["filters"]),
+		Actions:     objectsFromPropertyValue(inputs["actions"]),
+	}
+	if rule.ActionMatch == "" {
+		rule.ActionMatch = issueAlertRuleDefaultMatch
+	}
+	if inputs["frequency"].IsNumber() {
+		rule.Frequency = int(inputs["frequency"].NumberValue())
+	}
+	return rule
+}
+
+func issueAlertRulePropertyMap(organizationSlug, projectSlug string, rule issueAlertRule) resource.PropertyMap {
+	if rule.FilterMatch == "" {
+		// Rules created before filters were introduced don't have it.
+		rule.FilterMatch = issueAlertRuleDefaultMatch
+	}
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"actionMatch":      rule.ActionMatch,
+		"actions":          withoutRuleComponentNames(rule.Actions),
+		"conditions":       withoutRuleComponentNames(rule.Conditions),
+		"environment":      rule.Environment,
+		"filterMatch":      rule.FilterMatch,
+		"filters":          withoutRuleComponentNames(rule.Filters),
+		"frequency":        rule.Frequency,
+		"name":             rule.Name,
+		"organizationSlug": organizationSlug,
+		"projectSlug":      projectSlug,
+	})
+}
+
+// withoutRuleComponentNames drops the "name" Sentry adds to each condition,
+// filter and action of a rule: it's a human readable description of the
+// settings, e.g. "Send a notification to all legacy integrations", and is not
+// accepted as an input.
+func withoutRuleComponentNames(components []map[string]interface{}) []interface{} {
+	ret := make([]interface{}, 0, len(components))
+	for _, component := range components {
+		stripped := make(map[string]interface{}, len(component))
+		for key, value := range component {
+			if key != "name" {
+				stripped[key] = value
+			}
+		}
+		ret = append(ret, stripped)
+	}
+	return ret
+}
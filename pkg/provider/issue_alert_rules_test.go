@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+var (
+	// emptyList is how empty lists come out of unmarshalling.
+	emptyList = resource.NewArrayProperty(nil)
+
+	firstSeenCondition = resource.NewPropertyValue(map[string]interface{}{
+		"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+	})
+	notifyEmailAction = resource.NewPropertyValue(map[string]interface{}{
+		"id":         "sentry.mail.actions.NotifyEmailAction",
+		"targetType": "IssueOwners",
+	})
+)
+
+func TestIssueAlertRuleCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "actions", Reason: "this input must be a non-empty list of objects with a non-empty id"},
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"actionMatch": resource.NewPropertyValue("all"),
+				"conditions":  emptyList,
+				"filterMatch": resource.NewPropertyValue("all"),
+				"filters":     emptyList,
+				"frequency":   resource.NewPropertyValue(30),
+			},
+		},
+		"wrong type": {
+			news: resource.PropertyMap{
+				"actionMatch":      resource.NewPropertyValue("some"),
+				"actions":          emptyList,
+				"conditions":       resource.NewPropertyValue([]interface{}{"condition"}),
+				"environment":      resource.NewPropertyValue(1),
+				"filterMatch":      resource.NewPropertyValue(1),
+				"filters":          resource.NewPropertyValue([]interface{}{map[string]interface{}{"id": ""}}),
+				"frequency":        resource.NewPropertyValue(0),
+				"name":             resource.NewPropertyValue(1),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "actionMatch", Reason: "this input must be one of: all, any, none"},
+				{Property: "actions", Reason: "this input must be a non-empty list of objects with a non-empty id"},
+				{Property: "conditions", Reason: "this input must be a list of objects with a non-empty id"},
+				{Property: "environment", Reason: "this input must be a string"},
+				{Property: "filterMatch", Reason: "this input must be one of: all, any, none"},
+				{Property: "filters", Reason: "this input must be a list of objects with a non-empty id"},
+				{Property: "frequency", Reason: "this input must be a positive integer"},
+				{Property: "name", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"actionMatch":      resource.NewPropertyValue("some"),
+				"actions":          emptyList,
+				"conditions":       resource.NewPropertyValue([]interface{}{"condition"}),
+				"environment":      resource.NewPropertyValue(1),
+				"filterMatch":      resource.NewPropertyValue(1),
+				"filters":          resource.NewPropertyValue([]interface{}{map[string]interface{}{"id": ""}}),
+				"frequency":        resource.NewPropertyValue(0),
+				"name":             resource.NewPropertyValue(1),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			},
+		},
+		"correct minimal": {
+			news: resource.PropertyMap{
+				"actions":          resource.NewArrayProperty([]resource.PropertyValue{notifyEmailAction}),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			},
+			wantFailures: nil,
+			wantInputs: resource.PropertyMap{
+				"actionMatch":      resource.NewPropertyValue("all"),
+				"actions":          resource.NewArrayProperty([]resource.PropertyValue{notifyEmailAction}),
+				"conditions":       emptyList,
+				"filterMatch":      resource.NewPropertyValue("all"),
+				"filters":          emptyList,
+				"frequency":        resource.NewPropertyValue(30),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.issueAlertRuleCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestIssueAlertRuleDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"actionMatch":      resource.NewPropertyValue("all"),
+		"actions":          resource.NewArrayProperty([]resource.PropertyValue{notifyEmailAction}),
+		"conditions":       resource.NewArrayProperty([]resource.PropertyValue{firstSeenCondition}),
+		"filterMatch":      resource.NewPropertyValue("all"),
+		"filters":          emptyList,
+		"frequency":        resource.NewPropertyValue(30),
+		"name":             resource.NewPropertyValue("base name"),
+		"organizationSlug": resource.NewPropertyValue("base-org-slug"),
+		"projectSlug":      resource.NewPropertyValue("base-proj-slug"),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseOlds,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"actionMatch": resource.NewPropertyValue("any"),
+				"conditions":  emptyList,
+				"environment": resource.NewPropertyValue("production"),
+				"frequency":   resource.NewPropertyValue(60),
+				"name":        resource.NewPropertyValue("new name"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"actionMatch", "conditions", "environment", "frequency", "name"},
+			},
+		},
+		"project renamed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"projectSlug": resource.NewPropertyValue("new-proj-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"projectSlug"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("new-org-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.issueAlertRuleDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestIssueAlertRuleCreate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			createIssueAlertRule: func(org sentry.Organization, proj sentry.Project, r issueAlertRule) (issueAlertRule, error) {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, *proj.Slug, "the-proj")
+				assert.Equal(t, r, issueAlertRule{
+					Name:        "a name",
+					ActionMatch: "any",
+					FilterMatch: "all",
+					Frequency:   60,
+					Environment: stringPtr("production"),
+					Conditions: []map[string]interface{}{
+						{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+					},
+					Filters: []map[string]interface{}{},
+					Actions: []map[string]interface{}{
+						{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"},
+					},
+				})
+				r.ID = "123"
+				r.Conditions[0]["name"] = "A new issue is created"
+				return r, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("the-proj")}),
+	}
+	inputs := resource.PropertyMap{
+		"actionMatch":      resource.NewPropertyValue("any"),
+		"actions":          resource.NewArrayProperty([]resource.PropertyValue{notifyEmailAction}),
+		"conditions":       resource.NewArrayProperty([]resource.PropertyValue{firstSeenCondition}),
+		"environment":      resource.NewPropertyValue("production"),
+		"filterMatch":      resource.NewPropertyValue("all"),
+		"filters":          emptyList,
+		"frequency":        resource.NewPropertyValue(60),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("the-org"),
+		"projectSlug":      resource.NewPropertyValue("the-proj"),
+	}
+	resp, err := prov.issueAlertRuleCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "the-org/42/123")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), inputs)
+}
+
+func TestIssueAlertRuleRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getIssueAlertRule: func(org sentry.Organization, proj sentry.Project, id string) (issueAlertRule, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				assert.Equal(t, id, "123")
+				return issueAlertRule{
+					ID:          "123",
+					Name:        "name-from-read",
+					ActionMatch: "all",
+					Frequency:   30,
+					Conditions: []map[string]interface{}{{
+						"id":   "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+						"name": "A new issue is created",
+					}},
+					Actions: []map[string]interface{}{{
+						"id":         "sentry.mail.actions.NotifyEmailAction",
+						"name":       "Send a notification to IssueOwners",
+						"targetType": "IssueOwners",
+					}},
+				}, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.issueAlertRuleRead(ctx, &rpc.ReadRequest{Id: "org-slug/42/123"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42/123")
+	want := resource.PropertyMap{
+		"actionMatch":      resource.NewPropertyValue("all"),
+		"actions":          resource.NewArrayProperty([]resource.PropertyValue{notifyEmailAction}),
+		"conditions":       resource.NewArrayProperty([]resource.PropertyValue{firstSeenCondition}),
+		"filterMatch":      resource.NewPropertyValue("all"),
+		"filters":          emptyList,
+		"frequency":        resource.NewPropertyValue(30),
+		"name":             resource.NewPropertyValue("name-from-read"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+}
+
+func TestIssueAlertRuleRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getIssueAlertRule: func(org sentry.Organization, proj sentry.Project, id string) (issueAlertRule, error) {
+				return issueAlertRule{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.issueAlertRuleRead(ctx, &rpc.ReadRequest{Id: "org-slug/42/123"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestIssueAlertRuleUpdate(t *testing.T) {
+	ctx := context.Background()
+	updateCalled := false
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateIssueAlertRule: func(org sentry.Organization, proj sentry.Project, r issueAlertRule) (issueAlertRule, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				assert.Equal(t, r.ID, "123")
+				assert.Equal(t, r.Name, "new name")
+				assert.Equal(t, r.Frequency, 5)
+				assert.Equal(t, r.Conditions, []map[string]interface{}{})
+				updateCalled = true
+				return r, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	olds := resource.PropertyMap{
+		"actionMatch":      resource.NewPropertyValue("all"),
+		"actions":          resource.NewArrayProperty([]resource.PropertyValue{notifyEmailAction}),
+		"conditions":       resource.NewArrayProperty([]resource.PropertyValue{firstSeenCondition}),
+		"filterMatch":      resource.NewPropertyValue("all"),
+		"filters":          emptyList,
+		"frequency":        resource.NewPropertyValue(30),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"conditions": emptyList,
+		"frequency":  resource.NewPropertyValue(5),
+		"name":       resource.NewPropertyValue("new name"),
+	})
+	resp, err := prov.issueAlertRuleUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42/123",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.True(t, updateCalled)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
+
+func TestIssueAlertRuleDelete(t *testing.T) {
+	ctx := context.Background()
+	deleteCalled := false
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			deleteIssueAlertRule: func(org sentry.Organization, proj sentry.Project, id string) error {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, *proj.Slug, "the-proj")
+				assert.Equal(t, id, "123")
+				deleteCalled = true
+				return nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("the-proj")}),
+	}
+	_, err := prov.issueAlertRuleDelete(ctx, &rpc.DeleteRequest{Id: "the-org/42/123"})
+	assert.Nil(t, err)
+	assert.True(t, deleteCalled)
+}
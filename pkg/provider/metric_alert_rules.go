@@ -0,0 +1,464 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// Defaults applied by Sentry when creating metric alert rules.
+const (
+	metricAlertRuleDefaultDataset       = "events"
+	metricAlertRuleDefaultThresholdType = "above"
+)
+
+// metricAlertRuleThresholdTypes are the names of Sentry's threshold types,
+// indexed by their value in the API.
+var metricAlertRuleThresholdTypes = []string{"above", "below"}
+
+var metricAlertRuleProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"aggregate":        true,
+		"dataset":          true,
+		"environment":      true,
+		"name":             true,
+		"projectSlug":      true,
+		"query":            true,
+		"resolveThreshold": true,
+		"thresholdType":    true,
+		"timeWindow":       true,
+		"triggers":         true,
+	},
+	outputs: map[string]bool{},
+	nested: map[string]resourceProperties{
+		"triggers": {
+			changedByUpdate: map[string]bool{
+				"actions":        true,
+				"alertThreshold": true,
+				"label":          true,
+			},
+			outputs: map[string]bool{
+				"id": true,
+			},
+			nested: map[string]resourceProperties{
+				"actions": {
+					changedByUpdate: map[string]bool{
+						"integrationId":    true,
+						"targetIdentifier": true,
+						"targetType":       true,
+						"type":             true,
+					},
+					outputs: map[string]bool{
+						"id": true,
+					},
+				},
+			},
+		},
+	},
+}
+
+func (k *sentryProvider) metricAlertRuleCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkNonEmptyString(&failures, news, "aggregate")
+	checkOptionalOneOf(&failures, news, "dataset", "events", "transactions")
+	checkOptionalString(&failures, news, "environment")
+	checkNonEmptyString(&failures, news, "name")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "projectSlug")
+	if query := news["query"]; !query.IsNull() && !query.ContainsUnknowns() && !query.IsString() {
+		// Unlike other strings, an empty query is fine: it matches all events.
+		failures = append(failures, &rpc.CheckFailure{Property: "query", Reason: "this input must be a string"})
+	}
+	checkOptionalNumber(&failures, news, "resolveThreshold")
+	checkOptionalOneOf(&failures, news, "thresholdType", metricAlertRuleThresholdTypes...)
+	checkPositiveInteger(&failures, news, "timeWindow")
+	checkMetricAlertRuleTriggers(&failures, news)
+
+	// Fill in what Sentry defaults to, so that Read does not report a
+	// difference against inputs that skip them.
+	if news["dataset"].IsNull() {
+		news["dataset"] = resource.NewStringProperty(metricAlertRuleDefaultDataset)
+	}
+	if news["query"].IsNull() {
+		news["query"] = resource.NewStringProperty("")
+	}
+	if news["thresholdType"].IsNull() {
+		news["thresholdType"] = resource.NewStringProperty(metricAlertRuleDefaultThresholdType)
+	}
+	if triggers := news["triggers"]; triggers.IsArray() {
+		for _, trigger := range triggers.ArrayValue() {
+			if trigger.IsObject() && trigger.ObjectValue()["actions"].IsNull() {
+				trigger.ObjectValue()["actions"] = resource.NewArrayProperty([]resource.PropertyValue{})
+			}
+		}
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+// checkMetricAlertRuleTriggers checks the triggers of a metric alert rule.
+// Failures in a trigger are reported under its path, e.g. triggers[0].label.
+func checkMetricAlertRuleTriggers(failures *[]*rpc.CheckFailure, props resource.PropertyMap) {
+	value := props["triggers"]
+	if value.IsComputed() {
+		return
+	}
+	if !value.IsArray() || len(value.ArrayValue()) == 0 {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "triggers",
+			Reason:   "this input must be a non-empty list of triggers",
+		})
+		return
+	}
+
+	labels := map[string]int{}
+	for i, element := range value.ArrayValue() {
+		if element.IsComputed() {
+			continue
+		}
+		path := fmt.Sprintf("triggers[%d]", i)
+		if !element.IsObject() {
+			*failures = append(*failures, &rpc.CheckFailure{Property: path, Reason: "this input must be an object"})
+			continue
+		}
+		trigger := element.ObjectValue()
+		if trigger["label"].IsString() {
+			labels[trigger["label"].StringValue()]++
+		}
+
+		var triggerFailures []*rpc.CheckFailure
+		checkOneOf(&triggerFailures, trigger, "label", "critical", "warning")
+		checkNumber(&triggerFailures, trigger, "alertThreshold")
+		if actions := trigger["actions"]; !actions.IsComputed() {
+			if actions.IsArray() {
+				for j, action := range actions.ArrayValue() {
+					actionPath := fmt.Sprintf("actions[%d]", j)
+					if action.IsComputed() {
+						continue
+					}
+					if !action.IsObject() {
+						triggerFailures = append(triggerFailures, &rpc.CheckFailure{Property: actionPath, Reason: "this input must be an object"})
+						continue
+					}
+					var actionFailures []*rpc.CheckFailure
+					checkNonEmptyString(&actionFailures, action.ObjectValue(), "type")
+					checkNonEmptyString(&actionFailures, action.ObjectValue(), "targetType")
+					checkOptionalString(&actionFailures, action.ObjectValue(), "targetIdentifier")
+					checkOptionalPositiveInteger(&actionFailures, action.ObjectValue(), "integrationId")
+					for _, failure := range actionFailures {
+						failure.Property = actionPath + "." + failure.Property
+					}
+					triggerFailures = append(triggerFailures, actionFailures...)
+				}
+			} else if !actions.IsNull() {
+				triggerFailures = append(triggerFailures, &rpc.CheckFailure{Property: "actions", Reason: "this input must be a list of actions"})
+			}
+		}
+		for _, failure := range triggerFailures {
+			failure.Property = path + "." + failure.Property
+		}
+		*failures = append(*failures, triggerFailures...)
+	}
+
+	if value.ContainsUnknowns() {
+		return
+	}
+	if labels["critical"] != 1 || labels["warning"] > 1 {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "triggers",
+			Reason:   "this input must have one critical trigger, and at most one warning trigger",
+		})
+	}
+}
+
+func (k *sentryProvider) metricAlertRuleDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return metricAlertRuleProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) metricAlertRuleCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+
+	wanted := metricAlertRuleFromInputs(inputs)
+	rule, err := k.sentryClient.CreateMetricAlertRule(sentry.Organization{Slug: &organizationSlug}, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateMetricAlertRule %v: %v", wanted.Name, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		metricAlertRulePropertyMap(organizationSlug, rule),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildID(organizationSlug, rule.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) metricAlertRuleUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, id, err := parseMetricAlertRuleID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed metricAlertRuleUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed metricAlertRuleUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := metricAlertRuleProperties.checkUpdatable("metricAlertRuleUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	wanted := metricAlertRuleFromInputs(news)
+	wanted.ID = id
+	keepMetricAlertRuleTriggerIDs(&wanted, metricAlertRuleFromInputs(olds))
+	rule, err := k.sentryClient.UpdateMetricAlertRule(sentry.Organization{Slug: &organizationSlug}, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateMetricAlertRule %v: %v", id, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		metricAlertRulePropertyMap(organizationSlug, rule),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) metricAlertRuleRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, id, err := parseMetricAlertRuleID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	rule, err := k.sentryClient.GetMetricAlertRule(sentry.Organization{Slug: &organizationSlug}, id)
+	if err != nil {
+		if isNotFound(err) {
+			// The rule is not there, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, err
+	}
+	properties := metricAlertRulePropertyMap(organizationSlug, rule)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(metricAlertRuleProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         buildID(organizationSlug, rule.ID),
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) metricAlertRuleDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, id, err := parseMetricAlertRuleID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteMetricAlertRule(sentry.Organization{Slug: &organizationSlug}, id)
+	return &pbempty.Empty{}, err
+}
+
+// parseMetricAlertRuleID parses IDs of metric alert rules: <orgSlug>/<ruleID>.
+func parseMetricAlertRuleID(id string) (organizationSlug, ruleID string, err error) {
+	parts, err := parseID(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// metricAlertRuleFromInputs converts inputs to a rule.  The IDs of triggers
+// and actions are only set when converting state, which has them.
+func metricAlertRuleFromInputs(inputs resource.PropertyMap) metricAlertRule {
+	rule := metricAlertRule{
+		Name:        stringFromPropertyValue(inputs["name"]),
+		Dataset:     stringFromPropertyValue(inputs["dataset"]),
+		Query:       stringFromPropertyValue(inputs["query"]),
+		Aggregate:   stringFromPropertyValue(inputs["aggregate"]),
+		TimeWindow:  numberFromPropertyValue(inputs["timeWindow"]),
+		Environment: stringPtrFromPropertyValue(inputs["environment"]),
+		Projects:    []string{stringFromPropertyValue(inputs["projectSlug"])},
+		Triggers:    []metricAlertRuleTrigger{},
+	}
+	if rule.Dataset == "" {
+		rule.Dataset = metricAlertRuleDefaultDataset
+	}
+	for value, name := range metricAlertRuleThresholdTypes {
+		if stringFromPropertyValue(inputs["thresholdType"]) == name {
+			rule.ThresholdType = value
+		}
+	}
+	if inputs["resolveThreshold"].IsNumber() {
+		resolveThreshold := inputs["resolveThreshold"].NumberValue()
+		rule.ResolveThreshold = &resolveThreshold
+	}
+	if !inputs["triggers"].IsArray() {
+		return rule
+	}
+	for _, element := range inputs["triggers"].ArrayValue() {
+		if !element.IsObject() {
+			continue
+		}
+		triggerInputs := element.ObjectValue()
+		trigger := metricAlertRuleTrigger{
+			ID:             stringFromPropertyValue(triggerInputs["id"]),
+			Label:          stringFromPropertyValue(triggerInputs["label"]),
+			AlertThreshold: numberFromPropertyValue(triggerInputs["alertThreshold"]),
+			Actions:        []metricAlertRuleTriggerAction{},
+		}
+		if triggerInputs["actions"].IsArray() {
+			for _, actionElement := range triggerInputs["actions"].ArrayValue() {
+				if !actionElement.IsObject() {
+					continue
+				}
+				actionInputs := actionElement.ObjectValue()
+				action := metricAlertRuleTriggerAction{
+					ID:               stringFromPropertyValue(actionInputs["id"]),
+					Type:             stringFromPropertyValue(actionInputs["type"]),
+					TargetType:       stringFromPropertyValue(actionInputs["targetType"]),
+					TargetIdentifier: stringFromPropertyValue(actionInputs["targetIdentifier"]),
+				}
+				if actionInputs["integrationId"].IsNumber() {
+					integrationID := int(actionInputs["integrationId"].NumberValue())
+					action.IntegrationID = &integrationID
+				}
+				trigger.Actions = append(trigger.Actions, action)
+			}
+		}
+		rule.Triggers = append(rule.Triggers, trigger)
+	}
+	return rule
+}
+
+// keepMetricAlertRuleTriggerIDs copies the IDs of the triggers and actions of
+// the current rule to the wanted one, so that Sentry updates them rather than
+// deleting and creating them again.  Triggers are matched by their label, and
+// actions by their position.
+func keepMetricAlertRuleTriggerIDs(wanted *metricAlertRule, current metricAlertRule) {
+	for i := range wanted.Triggers {
+		trigger := &wanted.Triggers[i]
+		for _, currentTrigger := range current.Triggers {
+			if currentTrigger.Label != trigger.Label {
+				continue
+			}
+			trigger.ID = currentTrigger.ID
+			for j := range trigger.Actions {
+				if j < len(currentTrigger.Actions) {
+					trigger.Actions[j].ID = currentTrigger.Actions[j].ID
+				}
+			}
+		}
+	}
+}
+
+func metricAlertRulePropertyMap(organizationSlug string, rule metricAlertRule) resource.PropertyMap {
+	var projectSlug string
+	if len(rule.Projects) > 0 {
+		projectSlug = rule.Projects[0]
+	}
+	thresholdType := metricAlertRuleDefaultThresholdType
+	if rule.ThresholdType >= 0 && rule.ThresholdType < len(metricAlertRuleThresholdTypes) {
+		thresholdType = metricAlertRuleThresholdTypes[rule.ThresholdType]
+	}
+	triggers := make([]interface{}, 0, len(rule.Triggers))
+	for _, trigger := range rule.Triggers {
+		actions := make([]interface{}, 0, len(trigger.Actions))
+		for _, action := range trigger.Actions {
+			properties := map[string]interface{}{
+				"id":            action.ID,
+				"integrationId": action.IntegrationID,
+				"targetType":    action.TargetType,
+				"type":          action.Type,
+			}
+			if action.TargetIdentifier != "" {
+				properties["targetIdentifier"] = action.TargetIdentifier
+			}
+			actions = append(actions, properties)
+		}
+		triggers = append(triggers, map[string]interface{}{
+			"actions":        actions,
+			"alertThreshold": trigger.AlertThreshold,
+			"id":             trigger.ID,
+			"label":          trigger.Label,
+		})
+	}
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"aggregate":        rule.Aggregate,
+		"dataset":          rule.Dataset,
+		"environment":      rule.Environment,
+		"name":             rule.Name,
+		"organizationSlug": organizationSlug,
+		"projectSlug":      projectSlug,
+		"query":            rule.Query,
+		"resolveThreshold": rule.ResolveThreshold,
+		"thresholdType":    thresholdType,
+		"timeWindow":       rule.TimeWindow,
+		"triggers":         triggers,
+	})
+}
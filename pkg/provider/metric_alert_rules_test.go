@@ -0,0 +1,522 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+// metricAlertRuleTriggerProperty builds a trigger notifying a team by email,
+// with the IDs Sentry assigns when triggerID and actionID are set.
+func metricAlertRuleTriggerProperty(label string, threshold float64, triggerID, actionID string) resource.PropertyValue {
+	action := map[string]interface{}{
+		"targetIdentifier": "42",
+		"targetType":       "team",
+		"type":             "email",
+	}
+	trigger := map[string]interface{}{
+		"alertThreshold": threshold,
+		"label":          label,
+	}
+	if triggerID != "" {
+		trigger["id"] = triggerID
+	}
+	if actionID != "" {
+		action["id"] = actionID
+	}
+	trigger["actions"] = []interface{}{action}
+	return resource.NewPropertyValue(trigger)
+}
+
+func TestMetricAlertRuleCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "aggregate", Reason: "this input must be a non-empty string"},
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+				{Property: "timeWindow", Reason: "this input must be a positive integer"},
+				{Property: "triggers", Reason: "this input must be a non-empty list of triggers"},
+			},
+			wantInputs: resource.PropertyMap{
+				"dataset":       resource.NewPropertyValue("events"),
+				"query":         resource.NewPropertyValue(""),
+				"thresholdType": resource.NewPropertyValue("above"),
+			},
+		},
+		"wrong type": {
+			news: resource.PropertyMap{
+				"aggregate":        resource.NewPropertyValue("count()"),
+				"dataset":          resource.NewPropertyValue("sessions"),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"query":            resource.NewPropertyValue(1),
+				"resolveThreshold": resource.NewPropertyValue("10"),
+				"thresholdType":    resource.NewPropertyValue("sideways"),
+				"timeWindow":       resource.NewPropertyValue(1.5),
+				"triggers": resource.NewPropertyValue([]interface{}{
+					"trigger",
+					map[string]interface{}{
+						"actions":        []interface{}{map[string]interface{}{"type": "email", "integrationId": -1}},
+						"alertThreshold": "100",
+						"label":          "emergency",
+					},
+				}),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "dataset", Reason: "this input must be one of: events, transactions"},
+				{Property: "query", Reason: "this input must be a string"},
+				{Property: "resolveThreshold", Reason: "this input must be a number"},
+				{Property: "thresholdType", Reason: "this input must be one of: above, below"},
+				{Property: "timeWindow", Reason: "this input must be a positive integer"},
+				{Property: "triggers", Reason: "this input must have one critical trigger, and at most one warning trigger"},
+				{Property: "triggers[0]", Reason: "this input must be an object"},
+				{Property: "triggers[1].actions[0].integrationId", Reason: "this input must be a positive integer"},
+				{Property: "triggers[1].actions[0].targetType", Reason: "this input must be a non-empty string"},
+				{Property: "triggers[1].alertThreshold", Reason: "this input must be a number"},
+				{Property: "triggers[1].label", Reason: "this input must be one of: critical, warning"},
+			},
+			wantInputs: resource.PropertyMap{
+				"aggregate":        resource.NewPropertyValue("count()"),
+				"dataset":          resource.NewPropertyValue("sessions"),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"query":            resource.NewPropertyValue(1),
+				"resolveThreshold": resource.NewPropertyValue("10"),
+				"thresholdType":    resource.NewPropertyValue("sideways"),
+				"timeWindow":       resource.NewPropertyValue(1.5),
+				"triggers": resource.NewPropertyValue([]interface{}{
+					"trigger",
+					map[string]interface{}{
+						"actions":        []interface{}{map[string]interface{}{"type": "email", "integrationId": -1}},
+						"alertThreshold": "100",
+						"label":          "emergency",
+					},
+				}),
+			},
+		},
+		"correct minimal": {
+			news: resource.PropertyMap{
+				"aggregate":        resource.NewPropertyValue("count()"),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"timeWindow":       resource.NewPropertyValue(60),
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					metricAlertRuleTriggerProperty("critical", 100, "", ""),
+				}),
+			},
+			wantFailures: nil,
+			wantInputs: resource.PropertyMap{
+				"aggregate":        resource.NewPropertyValue("count()"),
+				"dataset":          resource.NewPropertyValue("events"),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"query":            resource.NewPropertyValue(""),
+				"thresholdType":    resource.NewPropertyValue("above"),
+				"timeWindow":       resource.NewPropertyValue(60),
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					metricAlertRuleTriggerProperty("critical", 100, "", ""),
+				}),
+			},
+		},
+		"trigger without actions": {
+			news: resource.PropertyMap{
+				"aggregate":        resource.NewPropertyValue("count()"),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"timeWindow":       resource.NewPropertyValue(60),
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewPropertyValue(map[string]interface{}{"alertThreshold": 100, "label": "critical"}),
+				}),
+			},
+			wantFailures: nil,
+			wantInputs: resource.PropertyMap{
+				"aggregate":        resource.NewPropertyValue("count()"),
+				"dataset":          resource.NewPropertyValue("events"),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"query":            resource.NewPropertyValue(""),
+				"thresholdType":    resource.NewPropertyValue("above"),
+				"timeWindow":       resource.NewPropertyValue(60),
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewObjectProperty(resource.PropertyMap{
+						"actions":        emptyList,
+						"alertThreshold": resource.NewPropertyValue(100),
+						"label":          resource.NewPropertyValue("critical"),
+					}),
+				}),
+			},
+		},
+		"two critical triggers": {
+			news: resource.PropertyMap{
+				"aggregate":        resource.NewPropertyValue("count()"),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"timeWindow":       resource.NewPropertyValue(60),
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					metricAlertRuleTriggerProperty("critical", 100, "", ""),
+					metricAlertRuleTriggerProperty("critical", 50, "", ""),
+				}),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "triggers", Reason: "this input must have one critical trigger, and at most one warning trigger"},
+			},
+			wantInputs: resource.PropertyMap{
+				"aggregate":        resource.NewPropertyValue("count()"),
+				"dataset":          resource.NewPropertyValue("events"),
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"query":            resource.NewPropertyValue(""),
+				"thresholdType":    resource.NewPropertyValue("above"),
+				"timeWindow":       resource.NewPropertyValue(60),
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					metricAlertRuleTriggerProperty("critical", 100, "", ""),
+					metricAlertRuleTriggerProperty("critical", 50, "", ""),
+				}),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.metricAlertRuleCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestMetricAlertRuleDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"aggregate":        resource.NewPropertyValue("count()"),
+		"dataset":          resource.NewPropertyValue("events"),
+		"name":             resource.NewPropertyValue("base name"),
+		"organizationSlug": resource.NewPropertyValue("base-org-slug"),
+		"projectSlug":      resource.NewPropertyValue("base-proj-slug"),
+		"query":            resource.NewPropertyValue(""),
+		"thresholdType":    resource.NewPropertyValue("above"),
+		"timeWindow":       resource.NewPropertyValue(60),
+		"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+			metricAlertRuleTriggerProperty("critical", 100, "1", "2"),
+			metricAlertRuleTriggerProperty("warning", 50, "3", "4"),
+		}),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseOlds,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"no change but trigger IDs": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					metricAlertRuleTriggerProperty("critical", 100, "", ""),
+					metricAlertRuleTriggerProperty("warning", 50, "", ""),
+				}),
+			}),
+			wantResponse: rpc.DiffResponse{Changes: rpc.DiffResponse_DIFF_NONE},
+		},
+		"nested threshold": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					metricAlertRuleTriggerProperty("critical", 200, "", ""),
+					metricAlertRuleTriggerProperty("warning", 50, "", ""),
+				}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"triggers"},
+			},
+		},
+		"removed trigger": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+					metricAlertRuleTriggerProperty("critical", 100, "", ""),
+				}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"triggers"},
+			},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"aggregate":   resource.NewPropertyValue("p95(transaction.duration)"),
+				"dataset":     resource.NewPropertyValue("transactions"),
+				"projectSlug": resource.NewPropertyValue("new-proj-slug"),
+				"timeWindow":  resource.NewPropertyValue(5),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"aggregate", "dataset", "projectSlug", "timeWindow"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("new-org-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.metricAlertRuleDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			sort.Strings(resp.Diffs)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestMetricAlertRuleCreate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createMetricAlertRule: func(org sentry.Organization, r metricAlertRule) (metricAlertRule, error) {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, r, metricAlertRule{
+					Name:          "a name",
+					Dataset:       "events",
+					Query:         "level:error",
+					Aggregate:     "count()",
+					TimeWindow:    60,
+					ThresholdType: 1,
+					Environment:   stringPtr("production"),
+					Projects:      []string{"the-proj"},
+					Triggers: []metricAlertRuleTrigger{{
+						Label:          "critical",
+						AlertThreshold: 100,
+						Actions: []metricAlertRuleTriggerAction{{
+							Type:             "email",
+							TargetType:       "team",
+							TargetIdentifier: "42",
+						}},
+					}},
+				})
+				r.ID = "123"
+				r.Triggers[0].ID = "1"
+				r.Triggers[0].Actions[0].ID = "2"
+				return r, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"aggregate":        resource.NewPropertyValue("count()"),
+		"dataset":          resource.NewPropertyValue("events"),
+		"environment":      resource.NewPropertyValue("production"),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("the-org"),
+		"projectSlug":      resource.NewPropertyValue("the-proj"),
+		"query":            resource.NewPropertyValue("level:error"),
+		"thresholdType":    resource.NewPropertyValue("below"),
+		"timeWindow":       resource.NewPropertyValue(60),
+		"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+			metricAlertRuleTriggerProperty("critical", 100, "", ""),
+		}),
+	}
+	resp, err := prov.metricAlertRuleCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "the-org/123")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(inputs, resource.PropertyMap{
+		"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+			metricAlertRuleTriggerProperty("critical", 100, "1", "2"),
+		}),
+	}))
+}
+
+func TestMetricAlertRuleRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getMetricAlertRule: func(org sentry.Organization, id string) (metricAlertRule, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, id, "123")
+				return metricAlertRule{
+					ID:         "123",
+					Name:       "name-from-read",
+					Dataset:    "events",
+					Aggregate:  "count()",
+					TimeWindow: 60,
+					Projects:   []string{"proj-slug"},
+					Triggers: []metricAlertRuleTrigger{{
+						ID:             "1",
+						Label:          "critical",
+						AlertThreshold: 100,
+						Actions: []metricAlertRuleTriggerAction{{
+							ID:               "2",
+							Type:             "email",
+							TargetType:       "team",
+							TargetIdentifier: "42",
+						}},
+					}},
+				}, nil
+			},
+		},
+	}
+	resp, err := prov.metricAlertRuleRead(ctx, &rpc.ReadRequest{Id: "org-slug/123"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/123")
+	want := resource.PropertyMap{
+		"aggregate":        resource.NewPropertyValue("count()"),
+		"dataset":          resource.NewPropertyValue("events"),
+		"name":             resource.NewPropertyValue("name-from-read"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"query":            resource.NewPropertyValue(""),
+		"thresholdType":    resource.NewPropertyValue("above"),
+		"timeWindow":       resource.NewPropertyValue(60),
+		"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+			metricAlertRuleTriggerProperty("critical", 100, "1", "2"),
+		}),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	// Inputs have neither the empty query nor the IDs of triggers.
+	wantInputs := propertyMapWithOverrides(want, resource.PropertyMap{
+		"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+			metricAlertRuleTriggerProperty("critical", 100, "", ""),
+		}),
+	})
+	delete(wantInputs, "query")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), wantInputs)
+}
+
+func TestMetricAlertRuleRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getMetricAlertRule: func(org sentry.Organization, id string) (metricAlertRule, error) {
+				return metricAlertRule{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.metricAlertRuleRead(ctx, &rpc.ReadRequest{Id: "org-slug/123"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestMetricAlertRuleUpdate(t *testing.T) {
+	ctx := context.Background()
+	updateCalled := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			updateMetricAlertRule: func(org sentry.Organization, r metricAlertRule) (metricAlertRule, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, r.ID, "123")
+				assert.Equal(t, r.Name, "new name")
+				// The critical trigger is updated, the warning one created.
+				assert.Equal(t, r.Triggers, []metricAlertRuleTrigger{
+					{
+						ID:             "1",
+						Label:          "critical",
+						AlertThreshold: 200,
+						Actions: []metricAlertRuleTriggerAction{
+							{ID: "2", Type: "email", TargetType: "team", TargetIdentifier: "42"},
+						},
+					},
+					{
+						Label:          "warning",
+						AlertThreshold: 50,
+						Actions: []metricAlertRuleTriggerAction{
+							{Type: "email", TargetType: "team", TargetIdentifier: "42"},
+						},
+					},
+				})
+				r.Triggers[1].ID = "3"
+				r.Triggers[1].Actions[0].ID = "4"
+				updateCalled = true
+				return r, nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"aggregate":        resource.NewPropertyValue("count()"),
+		"dataset":          resource.NewPropertyValue("events"),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"query":            resource.NewPropertyValue(""),
+		"thresholdType":    resource.NewPropertyValue("above"),
+		"timeWindow":       resource.NewPropertyValue(60),
+		"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+			metricAlertRuleTriggerProperty("critical", 100, "1", "2"),
+		}),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"name": resource.NewPropertyValue("new name"),
+		"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+			metricAlertRuleTriggerProperty("critical", 200, "", ""),
+			metricAlertRuleTriggerProperty("warning", 50, "", ""),
+		}),
+	})
+	resp, err := prov.metricAlertRuleUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/123",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.True(t, updateCalled)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(news, resource.PropertyMap{
+		"triggers": resource.NewArrayProperty([]resource.PropertyValue{
+			metricAlertRuleTriggerProperty("critical", 200, "1", "2"),
+			metricAlertRuleTriggerProperty("warning", 50, "3", "4"),
+		}),
+	}))
+}
+
+func TestMetricAlertRuleDelete(t *testing.T) {
+	ctx := context.Background()
+	deleteCalled := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			deleteMetricAlertRule: func(org sentry.Organization, id string) error {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, id, "123")
+				deleteCalled = true
+				return nil
+			},
+		},
+	}
+	_, err := prov.metricAlertRuleDelete(ctx, &rpc.DeleteRequest{Id: "the-org/123"})
+	assert.Nil(t, err)
+	assert.True(t, deleteCalled)
+}
@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// Defaults applied by Sentry when creating monitors.
+const (
+	monitorType            = "cron_job"
+	monitorDefaultTimezone = "UTC"
+)
+
+var monitorProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"organizationSlug": true,
+		// The monitor's ID is based on its slug, which jobs also use to
+		// check in.
+		"slug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"checkinMargin": true,
+		"intervalUnit":  true,
+		"intervalValue": true,
+		"maxRuntime":    true,
+		"name":          true,
+		"projectSlug":   true,
+		"schedule":      true,
+		"timezone":      true,
+	},
+	outputs: map[string]bool{},
+}
+
+// monitorIntervalUnits are the units of the intervals between check-ins.
+var monitorIntervalUnits = []string{"minute", "hour", "day", "week", "month", "year"}
+
+func (k *sentryProvider) monitorCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalPositiveInteger(&failures, news, "checkinMargin")
+	checkOptionalOneOf(&failures, news, "intervalUnit", monitorIntervalUnits...)
+	checkOptionalPositiveInteger(&failures, news, "intervalValue")
+	checkBothOrNeither(&failures, news, "intervalValue", "intervalUnit")
+	checkOptionalPositiveInteger(&failures, news, "maxRuntime")
+	checkNonEmptyString(&failures, news, "name")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "projectSlug")
+	checkMonitorSchedule(&failures, news)
+	checkNonEmptyString(&failures, news, "slug")
+	checkOptionalString(&failures, news, "timezone")
+
+	// Fill in what Sentry defaults to, so that Read does not report a
+	// difference against inputs that skip it.
+	if news["timezone"].IsNull() {
+		news["timezone"] = resource.NewStringProperty(monitorDefaultTimezone)
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+// checkMonitorSchedule checks that a monitor has either a crontab schedule or
+// an interval, and that the crontab is one Sentry accepts.
+func checkMonitorSchedule(failures *[]*rpc.CheckFailure, props resource.PropertyMap) {
+	schedule := props["schedule"]
+	hasInterval := !props["intervalValue"].IsNull() || !props["intervalUnit"].IsNull()
+	switch {
+	case schedule.IsNull() && !hasInterval:
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "schedule",
+			Reason:   "a monitor needs either a crontab schedule or intervalValue and intervalUnit",
+		})
+		return
+	case !schedule.IsNull() && hasInterval:
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "schedule",
+			Reason:   "a monitor can't have both a crontab schedule and intervalValue and intervalUnit",
+		})
+		return
+	case schedule.IsNull() || schedule.ContainsUnknowns():
+		return
+	}
+
+	if !schedule.IsString() {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "schedule",
+			Reason:   "this input must be a string",
+		})
+		return
+	}
+	if problem := crontabProblem(schedule.StringValue()); problem != "" {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "schedule",
+			Reason:   problem,
+		})
+	}
+}
+
+// crontabNicknames are the shorthands Sentry accepts in place of the five
+// fields of a crontab.
+var crontabNicknames = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@hourly"}
+
+// crontabFields are the fields of a crontab, with their allowed values.
+// Months and days of the week can also be given by their English
+// three-letter names, and both 0 and 7 are Sunday.
+var crontabFields = []struct {
+	name     string
+	min, max int
+	names    []string
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of the month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of the week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// crontabProblem describes what's wrong with a crontab schedule, or returns
+// an empty string if there is nothing wrong with it.
+func crontabProblem(schedule string) string {
+	if containsString(crontabNicknames, strings.ToLower(strings.TrimSpace(schedule))) {
+		return ""
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) != len(crontabFields) {
+		return fmt.Sprintf(`this input must be a crontab with 5 fields, e.g. "0 * * * *", or one of: %s`, strings.Join(crontabNicknames, ", "))
+	}
+	for i, field := range fields {
+		spec := crontabFields[i]
+		for _, part := range strings.Split(field, ",") {
+			if !validCrontabPart(part, spec.min, spec.max, spec.names) {
+				return fmt.Sprintf("the %s field of the crontab must be *, or values, ranges and steps between %d and %d, got %q", spec.name, spec.min, spec.max, field)
+			}
+		}
+	}
+	return ""
+}
+
+// validCrontabPart checks one of the comma-separated parts of a crontab
+// field: *, a value, or a range, optionally followed by a /step.
+func validCrontabPart(part string, min, max int, names []string) bool {
+	base := part
+	if i := strings.Index(part, "/"); i >= 0 {
+		step, err := strconv.Atoi(part[i+1:])
+		if err != nil || step <= 0 {
+			return false
+		}
+		base = part[:i]
+	}
+	if base == "*" {
+		return true
+	}
+
+	value := func(s string) (int, bool) {
+		for i, name := range names {
+			if strings.EqualFold(s, name) {
+				return min + i, true
+			}
+		}
+		n, err := strconv.Atoi(s)
+		return n, err == nil && n >= min && n <= max
+	}
+	bounds := strings.SplitN(base, "-", 2)
+	from, ok := value(bounds[0])
+	if !ok {
+		return false
+	}
+	if len(bounds) == 1 {
+		return true
+	}
+	to, ok := value(bounds[1])
+	return ok && from <= to
+}
+
+func (k *sentryProvider) monitorDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	// Sentry fills in margins and runtimes left out with its own defaults.
+	return monitorProperties.diff(withoutUnset(olds, news, "checkinMargin", "maxRuntime"), news)
+}
+
+func (k *sentryProvider) monitorCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+
+	wanted := monitorFromInputs(inputs)
+	created, err := k.sentryClient.CreateMonitor(sentry.Organization{Slug: &organizationSlug}, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateMonitor %v: %w", wanted.Slug, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		monitorPropertyMap(organizationSlug, created),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildSlugID(organizationSlug, created.Slug),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) monitorUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, slug, err := parseSlugID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed monitorUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed monitorUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := monitorProperties.checkUpdatable("monitorUpdate", withoutUnset(olds, news, "checkinMargin", "maxRuntime"), news); err != nil {
+		return nil, err
+	}
+
+	wanted := monitorFromInputs(news)
+	wanted.Slug = slug
+	updated, err := k.sentryClient.UpdateMonitor(sentry.Organization{Slug: &organizationSlug}, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateMonitor %v: %w", slug, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		monitorPropertyMap(organizationSlug, updated),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) monitorRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, slug, err := parseSlugID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	m, err := k.sentryClient.GetMonitor(sentry.Organization{Slug: &organizationSlug}, slug)
+	if err != nil {
+		if isNotFound(err) {
+			// The monitor is not there, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetMonitor %v: %w", req.GetId(), err)
+	}
+	properties := monitorPropertyMap(organizationSlug, m)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(monitorProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         buildSlugID(organizationSlug, m.Slug),
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) monitorDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, slug, err := parseSlugID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteMonitor(sentry.Organization{Slug: &organizationSlug}, slug)
+	if isNotFound(err) {
+		// The monitor is already gone.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+func monitorFromInputs(inputs resource.PropertyMap) monitor {
+	m := monitor{
+		Slug:    stringFromPropertyValue(inputs["slug"]),
+		Name:    stringFromPropertyValue(inputs["name"]),
+		Type:    monitorType,
+		Project: stringFromPropertyValue(inputs["projectSlug"]),
+		Config: monitorConfig{
+			CheckinMargin: intPtrFromPropertyValue(inputs["checkinMargin"]),
+			MaxRuntime:    intPtrFromPropertyValue(inputs["maxRuntime"]),
+			Timezone:      stringFromPropertyValue(inputs["timezone"]),
+		},
+	}
+	if inputs["schedule"].IsString() {
+		m.Config.ScheduleType = "crontab"
+		m.Config.Schedule = inputs["schedule"].StringValue()
+	} else {
+		m.Config.ScheduleType = "interval"
+		m.Config.Schedule = []interface{}{
+			numberFromPropertyValue(inputs["intervalValue"]),
+			stringFromPropertyValue(inputs["intervalUnit"]),
+		}
+	}
+	return m
+}
+
+func monitorPropertyMap(organizationSlug string, m monitor) resource.PropertyMap {
+	properties := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"checkinMargin":    m.Config.CheckinMargin,
+		"maxRuntime":       m.Config.MaxRuntime,
+		"name":             m.Name,
+		"organizationSlug": organizationSlug,
+		"projectSlug":      m.Project,
+		"slug":             m.Slug,
+		"timezone":         m.Config.Timezone,
+	})
+	switch schedule := m.Config.Schedule.(type) {
+	case string:
+		properties["schedule"] = resource.NewStringProperty(schedule)
+	case []interface{}:
+		// Intervals are decoded from JSON as [2, "hour"].
+		if len(schedule) == 2 {
+			if value, ok := schedule[0].(float64); ok {
+				properties["intervalValue"] = resource.NewNumberProperty(value)
+			}
+			if unit, ok := schedule[1].(string); ok {
+				properties["intervalUnit"] = resource.NewStringProperty(unit)
+			}
+		}
+	}
+	return properties
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestMonitorCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+				{Property: "schedule", Reason: "a monitor needs either a crontab schedule or intervalValue and intervalUnit"},
+				{Property: "slug", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"timezone": resource.NewPropertyValue("UTC"),
+			},
+		},
+		"both schedules": {
+			news: resource.PropertyMap{
+				"intervalUnit":     resource.NewPropertyValue("fortnight"),
+				"name":             resource.NewPropertyValue("Nightly backup"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"schedule":         resource.NewPropertyValue("0 3 * * *"),
+				"slug":             resource.NewPropertyValue("nightly-backup"),
+				"timezone":         resource.NewPropertyValue("Europe/Warsaw"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "intervalUnit", Reason: "this input must be one of: minute, hour, day, week, month, year"},
+				{Property: "intervalUnit", Reason: "intervalValue and intervalUnit must be set together"},
+				{Property: "intervalValue", Reason: "intervalValue and intervalUnit must be set together"},
+				{Property: "schedule", Reason: "a monitor can't have both a crontab schedule and intervalValue and intervalUnit"},
+			},
+			wantInputs: resource.PropertyMap{
+				"intervalUnit":     resource.NewPropertyValue("fortnight"),
+				"name":             resource.NewPropertyValue("Nightly backup"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"schedule":         resource.NewPropertyValue("0 3 * * *"),
+				"slug":             resource.NewPropertyValue("nightly-backup"),
+				"timezone":         resource.NewPropertyValue("Europe/Warsaw"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.monitorCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestCrontabProblem(t *testing.T) {
+	for _, schedule := range []string{
+		"0 * * * *",
+		"*/15 9-17 * * mon-fri",
+		"0 0 1,15 jan,JUL 0",
+		"5 4 * * 7",
+		"@daily",
+	} {
+		assert.Equal(t, crontabProblem(schedule), "", schedule)
+	}
+
+	tests := map[string]string{
+		"0 * * *":      `this input must be a crontab with 5 fields, e.g. "0 * * * *", or one of: @yearly, @annually, @monthly, @weekly, @daily, @hourly`,
+		"60 * * * *":   `the minute field of the crontab must be *, or values, ranges and steps between 0 and 59, got "60"`,
+		"0 17-9 * * *": `the hour field of the crontab must be *, or values, ranges and steps between 0 and 23, got "17-9"`,
+		"0 0 0 * *":    `the day of the month field of the crontab must be *, or values, ranges and steps between 1 and 31, got "0"`,
+		"0 0 * foo *":  `the month field of the crontab must be *, or values, ranges and steps between 1 and 12, got "foo"`,
+		"0 0 * * */0":  `the day of the week field of the crontab must be *, or values, ranges and steps between 0 and 7, got "*/0"`,
+	}
+	for schedule, want := range tests {
+		assert.Equal(t, crontabProblem(schedule), want, schedule)
+	}
+}
+
+func TestMonitorDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"checkinMargin":    resource.NewPropertyValue(1),
+		"maxRuntime":       resource.NewPropertyValue(30),
+		"name":             resource.NewPropertyValue("Nightly backup"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"schedule":         resource.NewPropertyValue("0 3 * * *"),
+		"slug":             resource.NewPropertyValue("nightly-backup"),
+		"timezone":         resource.NewPropertyValue("UTC"),
+	}
+	baseNews := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Nightly backup"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"schedule":         resource.NewPropertyValue("0 3 * * *"),
+		"slug":             resource.NewPropertyValue("nightly-backup"),
+		"timezone":         resource.NewPropertyValue("UTC"),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change with the defaults of Sentry": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"intervalUnit":  resource.NewPropertyValue("hour"),
+				"intervalValue": resource.NewPropertyValue(6),
+				"maxRuntime":    resource.NewPropertyValue(60),
+				"schedule":      resource.NewNullProperty(),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"intervalUnit", "intervalValue", "maxRuntime", "schedule"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"name": resource.NewPropertyValue("Weekly backup"),
+				"slug": resource.NewPropertyValue("weekly-backup"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"name", "slug"},
+				Replaces:            []string{"slug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.monitorDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			sort.Strings(resp.Diffs)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestMonitorCreate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createMonitor: func(org sentry.Organization, mon monitor) (monitor, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, mon, monitor{
+					Slug:    "hourly-sync",
+					Name:    "Hourly sync",
+					Type:    "cron_job",
+					Project: "proj-slug",
+					Config: monitorConfig{
+						ScheduleType:  "interval",
+						Schedule:      []interface{}{float64(1), "hour"},
+						CheckinMargin: intPtr(5),
+						Timezone:      "UTC",
+					},
+				})
+				mon.ID = "7"
+				mon.Config.MaxRuntime = intPtr(30)
+				return mon, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"checkinMargin":    resource.NewPropertyValue(5),
+		"intervalUnit":     resource.NewPropertyValue("hour"),
+		"intervalValue":    resource.NewPropertyValue(1),
+		"name":             resource.NewPropertyValue("Hourly sync"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"slug":             resource.NewPropertyValue("hourly-sync"),
+		"timezone":         resource.NewPropertyValue("UTC"),
+	}
+	resp, err := prov.monitorCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/hourly-sync")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(inputs, resource.PropertyMap{
+		"maxRuntime": resource.NewPropertyValue(30),
+	}))
+}
+
+func TestMonitorRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getMonitor: func(org sentry.Organization, slug string) (monitor, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, slug, "nightly-backup")
+				return monitor{
+					ID:      "7",
+					Slug:    slug,
+					Name:    "Nightly backup",
+					Type:    "cron_job",
+					Project: "proj-slug",
+					Config:  monitorConfig{ScheduleType: "crontab", Schedule: "0 3 * * *", Timezone: "UTC"},
+				}, nil
+			},
+		},
+	}
+	resp, err := prov.monitorRead(ctx, &rpc.ReadRequest{Id: "org-slug/nightly-backup"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/nightly-backup")
+	want := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Nightly backup"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"schedule":         resource.NewPropertyValue("0 3 * * *"),
+		"slug":             resource.NewPropertyValue("nightly-backup"),
+		"timezone":         resource.NewPropertyValue("UTC"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+}
+
+func TestMonitorRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getMonitor: func(org sentry.Organization, slug string) (monitor, error) {
+				return monitor{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.monitorRead(ctx, &rpc.ReadRequest{Id: "org-slug/nightly-backup"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestMonitorUpdate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			updateMonitor: func(org sentry.Organization, mon monitor) (monitor, error) {
+				assert.Equal(t, mon.Slug, "nightly-backup")
+				assert.Equal(t, mon.Config.Schedule, "30 3 * * *")
+				return mon, nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Nightly backup"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"schedule":         resource.NewPropertyValue("0 3 * * *"),
+		"slug":             resource.NewPropertyValue("nightly-backup"),
+		"timezone":         resource.NewPropertyValue("UTC"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"schedule": resource.NewPropertyValue("30 3 * * *"),
+	})
+	resp, err := prov.monitorUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/nightly-backup",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
+
+func TestMonitorDelete(t *testing.T) {
+	ctx := context.Background()
+	var deleted string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			deleteMonitor: func(org sentry.Organization, slug string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				deleted = slug
+				return nil
+			},
+		},
+	}
+	_, err := prov.monitorDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/nightly-backup"})
+	assert.Nil(t, err)
+	assert.Equal(t, deleted, "nightly-backup")
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var organizationMemberProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"email":            true,
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"role":      true,
+		"teamSlugs": true,
+	},
+	outputs: map[string]bool{
+		"memberId": true,
+		"pending":  true,
+	},
+}
+
+var organizationMemberRoles = []string{"member", "admin", "manager", "owner", "billing"}
+
+func (k *sentryProvider) organizationMemberCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkNonEmptyString(&failures, news, "email")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkOptionalOneOf(&failures, news, "role", organizationMemberRoles...)
+	checkOptionalStringArray(&failures, news, "teamSlugs")
+
+	// Sentry keeps emails in lower case, and teams as a set.
+	if email := news["email"]; email.IsString() {
+		news["email"] = resource.NewStringProperty(strings.ToLower(email.StringValue()))
+	}
+	if news["role"].IsNull() {
+		news["role"] = resource.NewStringProperty("member")
+	}
+	news["teamSlugs"] = sortedStrings(news["teamSlugs"])
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) organizationMemberDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	// Teams are not managed when left out, e.g. in favor of TeamMember
+	// resources.
+	return organizationMemberProperties.diff(withoutUnset(olds, news, "teamSlugs"), news)
+}
+
+func (k *sentryProvider) organizationMemberCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	email := inputs["email"].StringValue()
+
+	member, err := k.sentryClient.CreateOrganizationMember(sentry.Organization{Slug: &organizationSlug}, organizationMember{
+		Email: email,
+		Role:  inputs["role"].StringValue(),
+		Teams: organizationMemberTeams(inputs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateOrganizationMember %v: %w", email, err)
+	}
+	member.Teams = organizationMemberTeams(inputs)
+
+	outputProperties, err := plugin.MarshalProperties(
+		organizationMemberPropertyMap(organizationSlug, member),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildID(organizationSlug, member.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) organizationMemberUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, memberID, err := parseOrganizationMemberID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed organizationMemberUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed organizationMemberUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := organizationMemberProperties.checkUpdatable("organizationMemberUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	member, err := k.sentryClient.UpdateOrganizationMember(sentry.Organization{Slug: &organizationSlug}, organizationMember{
+		ID:    memberID,
+		Role:  news["role"].StringValue(),
+		Teams: organizationMemberTeams(news),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateOrganizationMember %v: %w", req.GetId(), err)
+	}
+	// Keep what Sentry answers with for pending, the rest is as requested.
+	member.ID = memberID
+	member.Email = news["email"].StringValue()
+	member.Role = news["role"].StringValue()
+	member.Teams = organizationMemberTeams(news)
+
+	outputProperties, err := plugin.MarshalProperties(
+		organizationMemberPropertyMap(organizationSlug, member),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) organizationMemberRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, memberID, err := parseOrganizationMemberID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	member, err := k.sentryClient.GetOrganizationMember(sentry.Organization{Slug: &organizationSlug}, memberID)
+	if err != nil {
+		if isNotFound(err) {
+			// The member left or was removed, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetOrganizationMember %v: %w", req.GetId(), err)
+	}
+	properties := organizationMemberPropertyMap(organizationSlug, member)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(organizationMemberProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         req.GetId(),
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) organizationMemberDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, memberID, err := parseOrganizationMemberID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteOrganizationMember(sentry.Organization{Slug: &organizationSlug}, memberID)
+	if isNotFound(err) {
+		// The member already left or was removed.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+// parseOrganizationMemberID parses IDs of organization members:
+// <orgSlug>/<memberID>.
+func parseOrganizationMemberID(id string) (organizationSlug, memberID string, err error) {
+	parts, err := parseID(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// organizationMemberTeams returns the slugs of the teams of a member, or nil
+// when they are not managed.
+func organizationMemberTeams(inputs resource.PropertyMap) []string {
+	if !inputs["teamSlugs"].IsArray() {
+		return nil
+	}
+	return stringsFromPropertyValue(inputs["teamSlugs"])
+}
+
+func organizationMemberPropertyMap(organizationSlug string, member organizationMember) resource.PropertyMap {
+	properties := map[string]interface{}{
+		"email":            strings.ToLower(member.Email),
+		"memberId":         member.ID,
+		"organizationSlug": organizationSlug,
+		"pending":          member.Pending,
+		"role":             member.Role,
+	}
+	if member.Teams != nil {
+		teams := append([]string{}, member.Teams...)
+		sort.Strings(teams)
+		properties["teamSlugs"] = teams
+	}
+	return resource.NewPropertyMapFromMap(properties)
+}
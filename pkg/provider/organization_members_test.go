@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestOrganizationMemberCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "email", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"role": resource.NewPropertyValue("member"),
+			},
+		},
+		"wrong type": {
+			news: resource.PropertyMap{
+				"email":            resource.NewPropertyValue(1),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"role":             resource.NewPropertyValue("boss"),
+				"teamSlugs":        resource.NewPropertyValue("the-team"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "email", Reason: "this input must be a non-empty string"},
+				{Property: "role", Reason: "this input must be one of: member, admin, manager, owner, billing"},
+				{Property: "teamSlugs", Reason: "this input must be a list of non-empty strings"},
+			},
+			wantInputs: resource.PropertyMap{
+				"email":            resource.NewPropertyValue(1),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"role":             resource.NewPropertyValue("boss"),
+				"teamSlugs":        resource.NewPropertyValue("the-team"),
+			},
+		},
+		"normalized": {
+			news: resource.PropertyMap{
+				"email":            resource.NewPropertyValue("Alice@Example.com"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"role":             resource.NewPropertyValue("admin"),
+				"teamSlugs":        resource.NewPropertyValue([]interface{}{"web", "backend"}),
+			},
+			wantFailures: nil,
+			wantInputs: resource.PropertyMap{
+				"email":            resource.NewPropertyValue("alice@example.com"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"role":             resource.NewPropertyValue("admin"),
+				"teamSlugs":        resource.NewPropertyValue([]interface{}{"backend", "web"}),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.organizationMemberCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestOrganizationMemberDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"email":            resource.NewPropertyValue("alice@example.com"),
+		"memberId":         resource.NewPropertyValue("42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"pending":          resource.NewPropertyValue(true),
+		"role":             resource.NewPropertyValue("member"),
+		"teamSlugs":        resource.NewPropertyValue([]interface{}{"backend"}),
+	}
+	baseNews := resource.PropertyMap{
+		"email":            resource.NewPropertyValue("alice@example.com"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"role":             resource.NewPropertyValue("member"),
+		"teamSlugs":        resource.NewPropertyValue([]interface{}{"backend"}),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{Changes: rpc.DiffResponse_DIFF_NONE},
+		},
+		"teams left out": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"teamSlugs": resource.NewNullProperty(),
+			}),
+			wantResponse: rpc.DiffResponse{Changes: rpc.DiffResponse_DIFF_NONE},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"role":      resource.NewPropertyValue("admin"),
+				"teamSlugs": resource.NewPropertyValue([]interface{}{"backend", "web"}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"role", "teamSlugs"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"email": resource.NewPropertyValue("bob@example.com"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"email"},
+				Replaces:            []string{"email"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.organizationMemberDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			sort.Strings(resp.Diffs)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestOrganizationMemberCreate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createOrganizationMember: func(org sentry.Organization, member organizationMember) (organizationMember, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, member, organizationMember{
+					Email: "alice@example.com",
+					Role:  "admin",
+					Teams: []string{"backend", "web"},
+				})
+				member.ID = "42"
+				member.Pending = true
+				member.Teams = nil
+				return member, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"email":            resource.NewPropertyValue("alice@example.com"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"role":             resource.NewPropertyValue("admin"),
+		"teamSlugs":        resource.NewPropertyValue([]interface{}{"backend", "web"}),
+	}
+	resp, err := prov.organizationMemberCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(inputs, resource.PropertyMap{
+		"memberId": resource.NewPropertyValue("42"),
+		"pending":  resource.NewPropertyValue(true),
+	}))
+}
+
+func TestOrganizationMemberRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganizationMember: func(org sentry.Organization, id string) (organizationMember, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, id, "42")
+				return organizationMember{
+					ID:    "42",
+					Email: "Alice@example.com",
+					Role:  "member",
+					Teams: []string{"web", "backend"},
+				}, nil
+			},
+		},
+	}
+	resp, err := prov.organizationMemberRead(ctx, &rpc.ReadRequest{Id: "org-slug/42"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42")
+	wantInputs := resource.PropertyMap{
+		"email":            resource.NewPropertyValue("alice@example.com"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"role":             resource.NewPropertyValue("member"),
+		"teamSlugs":        resource.NewPropertyValue([]interface{}{"backend", "web"}),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(wantInputs, resource.PropertyMap{
+		"memberId": resource.NewPropertyValue("42"),
+		"pending":  resource.NewPropertyValue(false),
+	}))
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), wantInputs)
+}
+
+func TestOrganizationMemberRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganizationMember: func(org sentry.Organization, id string) (organizationMember, error) {
+				return organizationMember{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.organizationMemberRead(ctx, &rpc.ReadRequest{Id: "org-slug/42"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestOrganizationMemberUpdate(t *testing.T) {
+	olds := resource.PropertyMap{
+		"email":            resource.NewPropertyValue("alice@example.com"),
+		"memberId":         resource.NewPropertyValue("42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"pending":          resource.NewPropertyValue(true),
+		"role":             resource.NewPropertyValue("member"),
+		"teamSlugs":        resource.NewPropertyValue([]interface{}{"backend"}),
+	}
+	tests := map[string]struct {
+		teamSlugs resource.PropertyValue
+		wantTeams []string
+	}{
+		"teams managed": {
+			teamSlugs: resource.NewPropertyValue([]interface{}{"web"}),
+			wantTeams: []string{"web"},
+		},
+		"teams left out": {
+			teamSlugs: resource.NewNullProperty(),
+			wantTeams: nil,
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{
+				sentryClient: &sentryClientMock{
+					updateOrganizationMember: func(org sentry.Organization, member organizationMember) (organizationMember, error) {
+						assert.Equal(t, *org.Slug, "org-slug")
+						assert.Equal(t, member, organizationMember{ID: "42", Role: "admin", Teams: tc.wantTeams})
+						return organizationMember{ID: "42", Email: "alice@example.com", Role: "admin"}, nil
+					},
+				},
+			}
+			news := resource.PropertyMap{
+				"email":            resource.NewPropertyValue("alice@example.com"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"role":             resource.NewPropertyValue("admin"),
+			}
+			if !tc.teamSlugs.IsNull() {
+				news["teamSlugs"] = tc.teamSlugs
+			}
+			resp, err := prov.organizationMemberUpdate(ctx, &rpc.UpdateRequest{
+				Id:   "org-slug/42",
+				News: mustMarshalProperties(news),
+				Olds: mustMarshalProperties(olds),
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(news, resource.PropertyMap{
+				"memberId": resource.NewPropertyValue("42"),
+				"pending":  resource.NewPropertyValue(false),
+			}))
+		})
+	}
+}
+
+func TestOrganizationMemberDelete(t *testing.T) {
+	ctx := context.Background()
+	deleted := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			deleteOrganizationMember: func(org sentry.Organization, id string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, id, "42")
+				deleted = true
+				return nil
+			},
+		},
+	}
+	_, err := prov.organizationMemberDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/42"})
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// organizationRepositoryProperties are all replaced: Sentry can't move a
+// repository to another integration, nor rename it.
+var organizationRepositoryProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"integrationId":    true,
+		"name":             true,
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{},
+	outputs: map[string]bool{
+		"provider": true,
+		"url":      true,
+	},
+}
+
+func (k *sentryProvider) organizationRepositoryCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkPositiveInteger(&failures, news, "integrationId")
+	checkNonEmptyString(&failures, news, "name")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) organizationRepositoryDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return organizationRepositoryProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) organizationRepositoryCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	integrationID := int(inputs["integrationId"].NumberValue())
+	name := inputs["name"].StringValue()
+	org := sentry.Organization{Slug: &organizationSlug}
+
+	// Sentry needs the provider of the integration too, e.g.
+	// "integrations:github".
+	integration, err := k.getOrganizationIntegration(org, integrationID)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := k.sentryClient.CreateRepository(org, integration, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateRepository %v: %w", name, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		organizationRepositoryPropertyMap(organizationSlug, repo),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildID(organizationSlug, repo.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+// organizationRepositoryUpdate has nothing to update, every change replaces
+// the repository.
+func (k *sentryProvider) organizationRepositoryUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed organizationRepositoryUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed organizationRepositoryUpdate because of malformed resource inputs: %w", err)
+	}
+
+	if err := organizationRepositoryProperties.checkUpdatable("organizationRepositoryUpdate", olds, news); err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: req.GetOlds()}, nil
+}
+
+func (k *sentryProvider) organizationRepositoryRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, id, err := parseOrganizationRepositoryID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	repo, err := k.sentryClient.GetRepository(sentry.Organization{Slug: &organizationSlug}, id)
+	if err != nil {
+		if isNotFound(err) {
+			// The repository was unlinked, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetRepository %v: %w", req.GetId(), err)
+	}
+	properties := organizationRepositoryPropertyMap(organizationSlug, repo)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(organizationRepositoryProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         req.GetId(),
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) organizationRepositoryDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, id, err := parseOrganizationRepositoryID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteRepository(sentry.Organization{Slug: &organizationSlug}, id)
+	if isNotFound(err) {
+		// The repository is already unlinked.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+// getOrganizationIntegration finds an integration of an organization by its
+// ID.
+func (k *sentryProvider) getOrganizationIntegration(org sentry.Organization, id int) (organizationIntegration, error) {
+	integrations, err := k.sentryClient.GetOrganizationIntegrations(org, "")
+	if err != nil {
+		return organizationIntegration{}, fmt.Errorf("could not GetOrganizationIntegrations: %w", err)
+	}
+	for _, integration := range integrations {
+		if integration.ID == id {
+			return integration, nil
+		}
+	}
+	return organizationIntegration{}, fmt.Errorf("no integration %v in organization %v", id, *org.Slug)
+}
+
+// parseOrganizationRepositoryID parses IDs of repositories:
+// <orgSlug>/<repositoryID>.
+func parseOrganizationRepositoryID(id string) (organizationSlug, repositoryID string, err error) {
+	parts, err := parseID(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+func organizationRepositoryPropertyMap(organizationSlug string, repo repository) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"integrationId":    repo.IntegrationID,
+		"name":             repo.Name,
+		"organizationSlug": organizationSlug,
+		"provider":         repo.Provider.ID,
+		"url":              repo.URL,
+	})
+}
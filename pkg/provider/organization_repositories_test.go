@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestOrganizationRepositoryCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "integrationId", Reason: "this input must be a positive integer"},
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+			},
+		},
+		"wrong types": {
+			news: resource.PropertyMap{
+				"integrationId":    resource.NewPropertyValue("3"),
+				"name":             resource.NewPropertyValue("owner/repo"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "integrationId", Reason: "this input must be a positive integer"},
+			},
+		},
+		"valid": {
+			news: resource.PropertyMap{
+				"integrationId":    resource.NewPropertyValue(3),
+				"name":             resource.NewPropertyValue("owner/repo"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+			},
+			wantFailures: nil,
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.organizationRepositoryCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.news)
+		})
+	}
+}
+
+func TestOrganizationRepositoryDiff(t *testing.T) {
+	olds := resource.PropertyMap{
+		"integrationId":    resource.NewPropertyValue(3),
+		"name":             resource.NewPropertyValue("owner/repo"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"provider":         resource.NewPropertyValue("integrations:github"),
+		"url":              resource.NewPropertyValue("https://github.com/owner/repo"),
+	}
+	news := resource.PropertyMap{
+		"integrationId":    resource.NewPropertyValue(3),
+		"name":             resource.NewPropertyValue("owner/repo"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+	}
+	prov := sentryProvider{}
+	resp, err := prov.organizationRepositoryDiff(olds, news)
+	assert.Nil(t, err)
+	assert.Equal(t, *resp, rpc.DiffResponse{Changes: rpc.DiffResponse_DIFF_NONE})
+
+	resp, err = prov.organizationRepositoryDiff(olds, propertyMapWithOverrides(news, resource.PropertyMap{
+		"integrationId": resource.NewPropertyValue(4),
+	}))
+	assert.Nil(t, err)
+	assert.Equal(t, *resp, rpc.DiffResponse{
+		Changes:             rpc.DiffResponse_DIFF_SOME,
+		Diffs:               []string{"integrationId"},
+		Replaces:            []string{"integrationId"},
+		DeleteBeforeReplace: true,
+	})
+}
+
+func TestOrganizationRepositoryCreate(t *testing.T) {
+	ctx := context.Background()
+	github := organizationIntegration{ID: 3, Name: "owner", Provider: integrationProvider{Key: "github", Name: "GitHub"}}
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganizationIntegrations: func(org sentry.Organization, providerKey string) ([]organizationIntegration, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, providerKey, "")
+				return []organizationIntegration{
+					{ID: 2, Name: "Workspace", Provider: integrationProvider{Key: "slack", Name: "Slack"}},
+					github,
+				}, nil
+			},
+			createRepository: func(org sentry.Organization, i organizationIntegration, name string) (repository, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, i, github)
+				assert.Equal(t, name, "owner/repo")
+				return repository{
+					ID:            "5",
+					Name:          name,
+					URL:           "https://github.com/owner/repo",
+					IntegrationID: 3,
+					Provider:      repositoryProvider{ID: "integrations:github", Name: "GitHub"},
+				}, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"integrationId":    resource.NewPropertyValue(3),
+		"name":             resource.NewPropertyValue("owner/repo"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+	}
+	resp, err := prov.organizationRepositoryCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/5")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(inputs, resource.PropertyMap{
+		"provider": resource.NewPropertyValue("integrations:github"),
+		"url":      resource.NewPropertyValue("https://github.com/owner/repo"),
+	}))
+}
+
+func TestOrganizationRepositoryCreateMissingIntegration(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganizationIntegrations: func(org sentry.Organization, providerKey string) ([]organizationIntegration, error) {
+				return []organizationIntegration{}, nil
+			},
+		},
+	}
+	_, err := prov.organizationRepositoryCreate(ctx, &rpc.CreateRequest{}, resource.PropertyMap{
+		"integrationId":    resource.NewPropertyValue(3),
+		"name":             resource.NewPropertyValue("owner/repo"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, err.Error(), "no integration 3 in organization org-slug")
+}
+
+func TestOrganizationRepositoryRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getRepository: func(org sentry.Organization, id string) (repository, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, id, "5")
+				return repository{
+					ID:            id,
+					Name:          "owner/repo",
+					URL:           "https://github.com/owner/repo",
+					IntegrationID: 3,
+					Provider:      repositoryProvider{ID: "integrations:github", Name: "GitHub"},
+				}, nil
+			},
+		},
+	}
+	resp, err := prov.organizationRepositoryRead(ctx, &rpc.ReadRequest{Id: "org-slug/5"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/5")
+	inputs := resource.PropertyMap{
+		"integrationId":    resource.NewPropertyValue(3),
+		"name":             resource.NewPropertyValue("owner/repo"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(inputs, resource.PropertyMap{
+		"provider": resource.NewPropertyValue("integrations:github"),
+		"url":      resource.NewPropertyValue("https://github.com/owner/repo"),
+	}))
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), inputs)
+}
+
+func TestOrganizationRepositoryRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getRepository: func(org sentry.Organization, id string) (repository, error) {
+				return repository{}, sentry.APIError{Detail: "no repository with ID 5", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.organizationRepositoryRead(ctx, &rpc.ReadRequest{Id: "org-slug/5"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestOrganizationRepositoryDelete(t *testing.T) {
+	ctx := context.Background()
+	var deleted string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			deleteRepository: func(org sentry.Organization, id string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				deleted = id
+				return nil
+			},
+		},
+	}
+	_, err := prov.organizationRepositoryDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/5"})
+	assert.Nil(t, err)
+	assert.Equal(t, deleted, "5")
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var (
+	organizationSettingsProperties = resourceProperties{
+		changedByReplacement: map[string]bool{
+			"organizationSlug": true,
+		},
+		changedByUpdate: map[string]bool{
+			"allowSharedIssues":    true,
+			"dataScrubber":         true,
+			"dataScrubberDefaults": true,
+			"defaultRole":          true,
+			"enhancedPrivacy":      true,
+			"name":                 true,
+			"openMembership":       true,
+			"require2FA":           true,
+			"safeFields":           true,
+			"scrubIPAddresses":     true,
+			"sensitiveFields":      true,
+		},
+		outputs: map[string]bool{},
+	}
+
+	// organizationSettingsKeys are the settings of organizations.  Like the
+	// settings of projects, they are only managed when set: the ones left
+	// out keep what they have in Sentry.
+	organizationSettingsKeys = []string{
+		"allowSharedIssues",
+		"dataScrubber",
+		"dataScrubberDefaults",
+		"defaultRole",
+		"enhancedPrivacy",
+		"name",
+		"openMembership",
+		"require2FA",
+		"safeFields",
+		"scrubIPAddresses",
+		"sensitiveFields",
+	}
+)
+
+func (k *sentryProvider) organizationSettingsCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalBool(&failures, news, "allowSharedIssues")
+	checkOptionalBool(&failures, news, "dataScrubber")
+	checkOptionalBool(&failures, news, "dataScrubberDefaults")
+	checkOptionalOneOf(&failures, news, "defaultRole", organizationMemberRoles...)
+	checkOptionalBool(&failures, news, "enhancedPrivacy")
+	checkOptionalString(&failures, news, "name")
+	checkOptionalBool(&failures, news, "openMembership")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkOptionalBool(&failures, news, "require2FA")
+	checkOptionalStringArray(&failures, news, "safeFields")
+	checkOptionalBool(&failures, news, "scrubIPAddresses")
+	checkOptionalStringArray(&failures, news, "sensitiveFields")
+
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) organizationSettingsDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return organizationSettingsProperties.diff(withoutUnset(olds, news, organizationSettingsKeys...), news)
+}
+
+// organizationSettingsCreate adopts an existing organization: Sentry
+// organizations are not created, nor deleted, by Pulumi.
+func (k *sentryProvider) organizationSettingsCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+
+	if _, err := k.sentryClient.GetOrganization(organizationSlug); err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("organization %v does not exist, it must be created in Sentry before its settings are managed", organizationSlug)
+		}
+		return nil, fmt.Errorf("could not GetOrganization %v: %w", organizationSlug, err)
+	}
+	settings, err := k.applyOrganizationSettings(organizationSlug, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		organizationSettingsPropertyMap(organizationSlug, settings),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         organizationSlug,
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) organizationSettingsUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed organizationSettingsUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed organizationSettingsUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := organizationSettingsProperties.checkUpdatable("organizationSettingsUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	settings, err := k.applyOrganizationSettings(req.GetId(), news)
+	if err != nil {
+		return nil, err
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		organizationSettingsPropertyMap(req.GetId(), settings),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+// applyOrganizationSettings changes the settings set in inputs, and returns
+// all the settings of the organization.
+func (k *sentryProvider) applyOrganizationSettings(organizationSlug string, inputs resource.PropertyMap) (organizationSettings, error) {
+	org := sentry.Organization{Slug: &organizationSlug}
+	wanted, ok := organizationSettingsFromInputs(inputs)
+	if !ok {
+		settings, err := k.sentryClient.GetOrganizationSettings(org)
+		if err != nil {
+			return organizationSettings{}, fmt.Errorf("could not GetOrganizationSettings %v: %w", organizationSlug, err)
+		}
+		return settings, nil
+	}
+	settings, err := k.sentryClient.UpdateOrganizationSettings(org, wanted)
+	if err != nil {
+		return organizationSettings{}, fmt.Errorf("could not UpdateOrganizationSettings %v: %w", organizationSlug, err)
+	}
+	return settings, nil
+}
+
+func (k *sentryProvider) organizationSettingsRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug := req.GetId()
+	settings, err := k.sentryClient.GetOrganizationSettings(sentry.Organization{Slug: &organizationSlug})
+	if err != nil {
+		if isNotFound(err) {
+			// The organization is not there, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetOrganizationSettings %v: %w", organizationSlug, err)
+	}
+	properties := organizationSettingsPropertyMap(organizationSlug, settings)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(organizationSettingsProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         organizationSlug,
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+// organizationSettingsDelete does nothing: the organization is left as it is,
+// with the settings it has.
+func (k *sentryProvider) organizationSettingsDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	return &pbempty.Empty{}, nil
+}
+
+// organizationSettingsFromInputs returns the settings set in inputs, and
+// whether any of them is set.
+func organizationSettingsFromInputs(inputs resource.PropertyMap) (organizationSettings, bool) {
+	settings := organizationSettings{
+		AllowSharedIssues:    boolPtrFromPropertyValue(inputs["allowSharedIssues"]),
+		DataScrubber:         boolPtrFromPropertyValue(inputs["dataScrubber"]),
+		DataScrubberDefaults: boolPtrFromPropertyValue(inputs["dataScrubberDefaults"]),
+		DefaultRole:          stringPtrFromPropertyValue(inputs["defaultRole"]),
+		EnhancedPrivacy:      boolPtrFromPropertyValue(inputs["enhancedPrivacy"]),
+		Name:                 stringPtrFromPropertyValue(inputs["name"]),
+		OpenMembership:       boolPtrFromPropertyValue(inputs["openMembership"]),
+		Require2FA:           boolPtrFromPropertyValue(inputs["require2FA"]),
+		SafeFields:           stringsPtrFromPropertyValue(inputs["safeFields"]),
+		ScrubIPAddresses:     boolPtrFromPropertyValue(inputs["scrubIPAddresses"]),
+		SensitiveFields:      stringsPtrFromPropertyValue(inputs["sensitiveFields"]),
+	}
+	return settings, settings != organizationSettings{}
+}
+
+func organizationSettingsPropertyMap(organizationSlug string, settings organizationSettings) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"allowSharedIssues":    settings.AllowSharedIssues,
+		"dataScrubber":         settings.DataScrubber,
+		"dataScrubberDefaults": settings.DataScrubberDefaults,
+		"defaultRole":          settings.DefaultRole,
+		"enhancedPrivacy":      settings.EnhancedPrivacy,
+		"name":                 settings.Name,
+		"openMembership":       settings.OpenMembership,
+		"organizationSlug":     organizationSlug,
+		"require2FA":           settings.Require2FA,
+		"safeFields":           settings.SafeFields,
+		"scrubIPAddresses":     settings.ScrubIPAddresses,
+		"sensitiveFields":      settings.SensitiveFields,
+	})
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestOrganizationSettingsCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{},
+		},
+		"wrong types": {
+			news: resource.PropertyMap{
+				"defaultRole":      resource.NewPropertyValue("superuser"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"require2FA":       resource.NewPropertyValue("yes"),
+				"sensitiveFields":  resource.NewPropertyValue([]interface{}{""}),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "defaultRole", Reason: "this input must be one of: member, admin, manager, owner, billing"},
+				{Property: "require2FA", Reason: "this input must be a boolean"},
+				{Property: "sensitiveFields", Reason: "this input must be a list of non-empty strings"},
+			},
+			wantInputs: resource.PropertyMap{
+				"defaultRole":      resource.NewPropertyValue("superuser"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"require2FA":       resource.NewPropertyValue("yes"),
+				"sensitiveFields":  resource.NewPropertyValue([]interface{}{""}),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.organizationSettingsCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestOrganizationSettingsDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"allowSharedIssues": resource.NewPropertyValue(true),
+		"defaultRole":       resource.NewPropertyValue("member"),
+		"name":              resource.NewPropertyValue("Org"),
+		"openMembership":    resource.NewPropertyValue(true),
+		"organizationSlug":  resource.NewPropertyValue("org-slug"),
+		"require2FA":        resource.NewPropertyValue(false),
+	}
+	baseNews := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"require2FA":       resource.NewPropertyValue(false),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change in managed settings": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"openMembership": resource.NewPropertyValue(false),
+				"require2FA":     resource.NewPropertyValue(true),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"openMembership", "require2FA"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("other-org"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.organizationSettingsDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestOrganizationSettingsCreate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganization: func(orgslug string) (sentry.Organization, error) {
+				assert.Equal(t, orgslug, "org-slug")
+				return sentry.Organization{Slug: &orgslug, Name: "Org"}, nil
+			},
+			updateOrganizationSettings: func(org sentry.Organization, settings organizationSettings) (organizationSettings, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, settings, organizationSettings{Require2FA: boolPtr(true)})
+				return organizationSettings{Name: stringPtr("Org"), DefaultRole: stringPtr("member"), Require2FA: boolPtr(true)}, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"require2FA":       resource.NewPropertyValue(true),
+	}
+	resp, err := prov.organizationSettingsCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"defaultRole":      resource.NewPropertyValue("member"),
+		"name":             resource.NewPropertyValue("Org"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"require2FA":       resource.NewPropertyValue(true),
+	})
+}
+
+func TestOrganizationSettingsCreateMissingOrganization(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganization: func(orgslug string) (sentry.Organization, error) {
+				return sentry.Organization{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	_, err := prov.organizationSettingsCreate(ctx, &rpc.CreateRequest{}, resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, "must be created in Sentry", err.Error())
+}
+
+func TestOrganizationSettingsRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getOrganizationSettings: func(org sentry.Organization) (organizationSettings, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				return organizationSettings{Name: stringPtr("Org"), EnhancedPrivacy: boolPtr(false), SafeFields: &[]string{"order_id"}}, nil
+			},
+		},
+	}
+	resp, err := prov.organizationSettingsRead(ctx, &rpc.ReadRequest{Id: "org-slug"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug")
+	want := resource.PropertyMap{
+		"enhancedPrivacy":  resource.NewPropertyValue(false),
+		"name":             resource.NewPropertyValue("Org"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"safeFields":       resource.NewPropertyValue([]interface{}{"order_id"}),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+}
+
+func TestOrganizationSettingsUpdate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			updateOrganizationSettings: func(org sentry.Organization, settings organizationSettings) (organizationSettings, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, settings, organizationSettings{OpenMembership: boolPtr(false)})
+				return organizationSettings{Name: stringPtr("Org"), OpenMembership: boolPtr(false)}, nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Org"),
+		"openMembership":   resource.NewPropertyValue(true),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+	}
+	news := resource.PropertyMap{
+		"openMembership":   resource.NewPropertyValue(false),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+	}
+	resp, err := prov.organizationSettingsUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(news, resource.PropertyMap{
+		"name": resource.NewPropertyValue("Org"),
+	}))
+}
+
+func TestOrganizationSettingsDelete(t *testing.T) {
+	ctx := context.Background()
+	// Nothing is sent to Sentry, the mock would panic otherwise.
+	prov := sentryProvider{sentryClient: &sentryClientMock{}}
+	_, err := prov.organizationSettingsDelete(ctx, &rpc.DeleteRequest{Id: "org-slug"})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var projectEnvironmentProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"name":             true,
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"hidden": true,
+		// Follows renames of the project, see buildProjectResourceID.
+		"projectSlug": true,
+	},
+	outputs: map[string]bool{},
+}
+
+func (k *sentryProvider) projectEnvironmentCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalBool(&failures, news, "hidden")
+	checkNonEmptyString(&failures, news, "name")
+	if name := news["name"]; name.IsString() && strings.ContainsAny(name.StringValue(), "/\r\n") {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "name",
+			Reason:   "environment names can't contain slashes or new lines",
+		})
+	}
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "projectSlug")
+
+	if news["hidden"].IsNull() {
+		news["hidden"] = resource.NewBoolProperty(false)
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) projectEnvironmentDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return projectEnvironmentProperties.diff(olds, news)
+}
+
+// projectEnvironmentCreate adopts an environment, which Sentry creates when
+// the first event is sent from it, and sets its visibility.
+func (k *sentryProvider) projectEnvironmentCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	projectSlug := inputs["projectSlug"].StringValue()
+	name := inputs["name"].StringValue()
+	org := sentry.Organization{Slug: &organizationSlug}
+	project, err := k.sentryClient.GetProject(org, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProject %v: %w", projectSlug, err)
+	}
+
+	environment, err := k.sentryClient.UpdateProjectEnvironment(org, project, name, boolFromPropertyValue(inputs["hidden"]))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("environment %v is not in project %v yet, it is added when the first event is sent from it: %w", name, projectSlug, err)
+		}
+		return nil, fmt.Errorf("could not UpdateProjectEnvironment %v: %w", name, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		projectEnvironmentPropertyMap(organizationSlug, projectSlug, name, environment),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		// <orgSlug>/<projectID>/<name>: Sentry does not allow slashes in
+		// names.
+		Id:         buildProjectResourceID(organizationSlug, project, name),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) projectEnvironmentUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectEnvironmentUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectEnvironmentUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := projectEnvironmentProperties.checkUpdatable("projectEnvironmentUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	organizationSlug, project, parts, err := k.parseUpdatedProjectResourceID(req.GetId(), news, 1)
+	if err != nil {
+		return nil, err
+	}
+	name := parts[0]
+	environment, err := k.sentryClient.UpdateProjectEnvironment(
+		sentry.Organization{Slug: &organizationSlug}, project, name, boolFromPropertyValue(news["hidden"]))
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateProjectEnvironment %v: %w", req.GetId(), err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		projectEnvironmentPropertyMap(organizationSlug, *project.Slug, name, environment),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) projectEnvironmentRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.properties", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectEnvironmentRead because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, parts, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 1)
+	var environment projectEnvironment
+	if err == nil {
+		environment, err = k.sentryClient.GetProjectEnvironment(sentry.Organization{Slug: &organizationSlug}, project, parts[0])
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// The environment, or its project, is not there, delete it from
+			// stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetProjectEnvironment %v: %w", req.GetId(), err)
+	}
+	properties := projectEnvironmentPropertyMap(organizationSlug, *project.Slug, parts[0], environment)
+	newState, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(projectEnvironmentProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		// This also migrates legacy <orgSlug>/<projectSlug>/<name> IDs.
+		Id:         buildProjectResourceID(organizationSlug, project, parts[0]),
+		Properties: newState,
+		Inputs:     inputs,
+	}, nil
+}
+
+// projectEnvironmentDelete shows the environment again; Sentry has no way to
+// delete environments.
+func (k *sentryProvider) projectEnvironmentDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{})
+	if err != nil {
+		return &pbempty.Empty{}, fmt.Errorf("failed projectEnvironmentDelete because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, parts, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 1)
+	if err == nil {
+		_, err = k.sentryClient.UpdateProjectEnvironment(sentry.Organization{Slug: &organizationSlug}, project, parts[0], false)
+	}
+	if isNotFound(err) {
+		// The environment, or its project, is already gone.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+func projectEnvironmentPropertyMap(organizationSlug, projectSlug, name string, environment projectEnvironment) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"hidden":           environment.IsHidden,
+		"name":             name,
+		"organizationSlug": organizationSlug,
+		"projectSlug":      projectSlug,
+	})
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestProjectEnvironmentCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"hidden": resource.NewPropertyValue(false),
+			},
+		},
+		"wrong type": {
+			news: resource.PropertyMap{
+				"hidden":           resource.NewPropertyValue("yes"),
+				"name":             resource.NewPropertyValue("preview/42"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "hidden", Reason: "this input must be a boolean"},
+				{Property: "name", Reason: "environment names can't contain slashes or new lines"},
+			},
+			wantInputs: resource.PropertyMap{
+				"hidden":           resource.NewPropertyValue("yes"),
+				"name":             resource.NewPropertyValue("preview/42"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.projectEnvironmentCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestProjectEnvironmentDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"hidden":           resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("preview-42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseOlds,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"hidden": resource.NewPropertyValue(false),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"hidden"},
+			},
+		},
+		"project renamed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"projectSlug": resource.NewPropertyValue("new-proj-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"projectSlug"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"name": resource.NewPropertyValue("preview-43"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"name"},
+				Replaces:            []string{"name"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.projectEnvironmentDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestProjectEnvironmentCreate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateProjectEnvironment: func(org sentry.Organization, proj sentry.Project, name string, hidden bool) (projectEnvironment, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				assert.Equal(t, name, "preview-42")
+				assert.True(t, hidden)
+				return projectEnvironment{ID: "7", Name: name, IsHidden: hidden}, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	inputs := resource.PropertyMap{
+		"hidden":           resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("preview-42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	}
+	resp, err := prov.projectEnvironmentCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42/preview-42")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), inputs)
+}
+
+func TestProjectEnvironmentCreateWithoutEvents(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateProjectEnvironment: func(org sentry.Organization, proj sentry.Project, name string, hidden bool) (projectEnvironment, error) {
+				return projectEnvironment{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	_, err := prov.projectEnvironmentCreate(ctx, &rpc.CreateRequest{}, resource.PropertyMap{
+		"hidden":           resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("preview-42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, "it is added when the first event is sent from it", err.Error())
+}
+
+func TestProjectEnvironmentRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getProjectEnvironment: func(org sentry.Organization, proj sentry.Project, name string) (projectEnvironment, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				assert.Equal(t, name, "preview-42")
+				return projectEnvironment{ID: "7", Name: name, IsHidden: true}, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.projectEnvironmentRead(ctx, &rpc.ReadRequest{Id: "org-slug/42/preview-42"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42/preview-42")
+	want := resource.PropertyMap{
+		"hidden":           resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("preview-42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+}
+
+func TestProjectEnvironmentRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getProjectEnvironment: func(org sentry.Organization, proj sentry.Project, name string) (projectEnvironment, error) {
+				return projectEnvironment{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.projectEnvironmentRead(ctx, &rpc.ReadRequest{Id: "org-slug/42/preview-42"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestProjectEnvironmentUpdate(t *testing.T) {
+	ctx := context.Background()
+	var hiddenSet *bool
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateProjectEnvironment: func(org sentry.Organization, proj sentry.Project, name string, hidden bool) (projectEnvironment, error) {
+				assert.Equal(t, name, "preview-42")
+				hiddenSet = &hidden
+				return projectEnvironment{ID: "7", Name: name, IsHidden: hidden}, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	olds := resource.PropertyMap{
+		"hidden":           resource.NewPropertyValue(false),
+		"name":             resource.NewPropertyValue("preview-42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"hidden": resource.NewPropertyValue(true),
+	})
+	resp, err := prov.projectEnvironmentUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42/preview-42",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, hiddenSet, boolPtr(true))
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
+
+func TestProjectEnvironmentDelete(t *testing.T) {
+	ctx := context.Background()
+	var hiddenSet *bool
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateProjectEnvironment: func(org sentry.Organization, proj sentry.Project, name string, hidden bool) (projectEnvironment, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				assert.Equal(t, name, "preview-42")
+				hiddenSet = &hidden
+				return projectEnvironment{ID: "7", Name: name, IsHidden: hidden}, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	_, err := prov.projectEnvironmentDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/42/preview-42"})
+	assert.Nil(t, err)
+	assert.Equal(t, hiddenSet, boolPtr(false))
+}
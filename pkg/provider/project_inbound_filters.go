@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// projectInboundFilterToggles are the inputs turning filters on and off, with
+// the IDs of the filters in Sentry.
+var projectInboundFilterToggles = []struct{ key, id string }{
+	{"browserExtensions", "browser-extensions"},
+	{"localhost", "localhost"},
+	{"webCrawlers", "web-crawlers"},
+}
+
+// projectInboundFilterOptions maps the inputs listing filtered values to the
+// project options storing them, one per line.
+var projectInboundFilterOptions = map[string]string{
+	"errorMessages": "filters:error_messages",
+	"ipAddresses":   "filters:blacklisted_ips",
+	"releases":      "filters:releases",
+}
+
+var projectInboundFiltersProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"browserExtensions": true,
+		"errorMessages":     true,
+		"ipAddresses":       true,
+		"legacyBrowsers":    true,
+		"localhost":         true,
+		// Follows renames of the project, see buildProjectResourceID.
+		"projectSlug": true,
+		"releases":    true,
+		"webCrawlers": true,
+	},
+	outputs: map[string]bool{},
+}
+
+func (k *sentryProvider) projectInboundFiltersCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalBool(&failures, news, "browserExtensions")
+	checkOptionalStringArray(&failures, news, "errorMessages")
+	checkOptionalStringArray(&failures, news, "ipAddresses")
+	checkOptionalStringArray(&failures, news, "legacyBrowsers")
+	checkOptionalBool(&failures, news, "localhost")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "projectSlug")
+	checkOptionalStringArray(&failures, news, "releases")
+	checkOptionalBool(&failures, news, "webCrawlers")
+
+	// Filters left out are turned off.  Fill that in, so that Read does not
+	// report a difference against inputs that skip them.
+	for _, toggle := range projectInboundFilterToggles {
+		if news[resource.PropertyKey(toggle.key)].IsNull() {
+			news[resource.PropertyKey(toggle.key)] = resource.NewBoolProperty(false)
+		}
+	}
+	for _, key := range []string{"errorMessages", "ipAddresses", "legacyBrowsers", "releases"} {
+		if news[resource.PropertyKey(key)].IsNull() {
+			news[resource.PropertyKey(key)] = resource.NewArrayProperty([]resource.PropertyValue{})
+		}
+	}
+	news["legacyBrowsers"] = sortedStrings(news["legacyBrowsers"])
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) projectInboundFiltersDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return projectInboundFiltersProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) projectInboundFiltersCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	projectSlug := inputs["projectSlug"].StringValue()
+	project, err := k.sentryClient.GetProject(sentry.Organization{Slug: &organizationSlug}, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProject %v: %w", projectSlug, err)
+	}
+
+	if err := k.setProjectInboundFilters(organizationSlug, projectSlug, inputs); err != nil {
+		return nil, err
+	}
+	properties, err := k.projectInboundFiltersPropertyMap(organizationSlug, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	outputProperties, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildProjectResourceID(organizationSlug, project),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) projectInboundFiltersUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectInboundFiltersUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectInboundFiltersUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := projectInboundFiltersProperties.checkUpdatable("projectInboundFiltersUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	organizationSlug, project, _, err := k.parseUpdatedProjectResourceID(req.GetId(), news, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Set all the filters, not only the changed ones, to also undo changes
+	// made in Sentry since the last refresh.
+	if err := k.setProjectInboundFilters(organizationSlug, *project.Slug, news); err != nil {
+		return nil, err
+	}
+	properties, err := k.projectInboundFiltersPropertyMap(organizationSlug, *project.Slug)
+	if err != nil {
+		return nil, err
+	}
+	outputProperties, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) projectInboundFiltersRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.properties", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectInboundFiltersRead because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, _, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 0)
+	var properties resource.PropertyMap
+	if err == nil {
+		properties, err = k.projectInboundFiltersPropertyMap(organizationSlug, *project.Slug)
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// The project is not there, delete its filters from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, err
+	}
+	newState, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(projectInboundFiltersProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		// This also migrates legacy <orgSlug>/<projectSlug> IDs.
+		Id:         buildProjectResourceID(organizationSlug, project),
+		Properties: newState,
+		Inputs:     inputs,
+	}, nil
+}
+
+// projectInboundFiltersDelete turns all the filters off, as they are when a
+// project is created.
+func (k *sentryProvider) projectInboundFiltersDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{})
+	if err != nil {
+		return &pbempty.Empty{}, fmt.Errorf("failed projectInboundFiltersDelete because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, _, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 0)
+	if err == nil {
+		err = k.setProjectInboundFilters(organizationSlug, *project.Slug, resource.PropertyMap{})
+	}
+	if isNotFound(err) {
+		// The project and its filters are already gone.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+// setProjectInboundFilters sets all the filters of a project to their state
+// in inputs; filters missing from inputs are turned off.
+func (k *sentryProvider) setProjectInboundFilters(organizationSlug, projectSlug string, inputs resource.PropertyMap) error {
+	org := sentry.Organization{Slug: &organizationSlug}
+	proj := sentry.Project{Slug: &projectSlug}
+
+	for _, toggle := range projectInboundFilterToggles {
+		active := boolFromPropertyValue(inputs[resource.PropertyKey(toggle.key)])
+		if err := k.sentryClient.UpdateProjectInboundFilter(org, proj, toggle.id, active); err != nil {
+			return fmt.Errorf("could not UpdateProjectInboundFilter %v: %w", toggle.id, err)
+		}
+	}
+	if err := k.sentryClient.UpdateProjectLegacyBrowsersFilter(org, proj, stringsFromPropertyValue(inputs["legacyBrowsers"])); err != nil {
+		return fmt.Errorf("could not UpdateProjectLegacyBrowsersFilter: %w", err)
+	}
+	options := map[string]interface{}{}
+	for key, option := range projectInboundFilterOptions {
+		options[option] = strings.Join(stringsFromPropertyValue(inputs[resource.PropertyKey(key)]), "\n")
+	}
+	if err := k.sentryClient.UpdateProjectOptions(org, proj, options); err != nil {
+		return fmt.Errorf("could not UpdateProjectOptions: %w", err)
+	}
+	return nil
+}
+
+// projectInboundFiltersPropertyMap fetches the state of the filters of a
+// project.
+func (k *sentryProvider) projectInboundFiltersPropertyMap(organizationSlug, projectSlug string) (resource.PropertyMap, error) {
+	org := sentry.Organization{Slug: &organizationSlug}
+	project, err := k.sentryClient.GetProject(org, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := k.sentryClient.GetProjectInboundFilters(org, project)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]interface{}{
+		"legacyBrowsers":   []string{},
+		"organizationSlug": organizationSlug,
+		"projectSlug":      projectSlug,
+	}
+	for _, toggle := range projectInboundFilterToggles {
+		properties[toggle.key] = false
+	}
+	for _, filter := range filters {
+		if filter.ID == "legacy-browsers" {
+			// Either false, or the list of filtered browsers.
+			var browsers []string
+			if err := json.Unmarshal(filter.Active, &browsers); err == nil && browsers != nil {
+				sort.Strings(browsers)
+				properties["legacyBrowsers"] = browsers
+			}
+			continue
+		}
+		for _, toggle := range projectInboundFilterToggles {
+			if filter.ID == toggle.id {
+				var active bool
+				_ = json.Unmarshal(filter.Active, &active)
+				properties[toggle.key] = active
+			}
+		}
+	}
+	var options map[string]interface{}
+	if project.Options != nil {
+		options = *project.Options
+	}
+	for key, option := range projectInboundFilterOptions {
+		properties[key] = linesFromProjectOption(options[option])
+	}
+	return resource.NewPropertyMapFromMap(properties), nil
+}
+
+// linesFromProjectOption splits the value of a project option holding one
+// value per line.  Unset options are empty.
+func linesFromProjectOption(value interface{}) []string {
+	ret := []string{}
+	text, _ := value.(string)
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			ret = append(ret, line)
+		}
+	}
+	return ret
+}
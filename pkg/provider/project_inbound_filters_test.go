@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+// projectInboundFiltersMock keeps the state of the filters of a project like
+// Sentry does.
+type projectInboundFiltersMock struct {
+	active         map[string]bool
+	legacyBrowsers []string
+	options        map[string]interface{}
+}
+
+func (m *projectInboundFiltersMock) client(t *testing.T) *sentryClientMock {
+	return &sentryClientMock{
+		getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+			assert.Equal(t, *org.Slug, "org-slug")
+			assert.Equal(t, projslug, "proj-slug")
+			return sentry.Project{ID: "42", Slug: &projslug, Options: &m.options}, nil
+		},
+		getProjectByID: func(org sentry.Organization, id string) (sentry.Project, error) {
+			assert.Equal(t, id, "42")
+			return sentry.Project{ID: id, Slug: stringPtr("proj-slug")}, nil
+		},
+		getProjectInboundFilters: func(org sentry.Organization, proj sentry.Project) ([]projectInboundFilter, error) {
+			assert.Equal(t, *proj.Slug, "proj-slug")
+			filters := []projectInboundFilter{}
+			for _, id := range []string{"browser-extensions", "localhost", "web-crawlers"} {
+				active, _ := json.Marshal(m.active[id])
+				filters = append(filters, projectInboundFilter{ID: id, Active: active})
+			}
+			legacyBrowsers := json.RawMessage("false")
+			if len(m.legacyBrowsers) > 0 {
+				legacyBrowsers, _ = json.Marshal(m.legacyBrowsers)
+			}
+			return append(filters, projectInboundFilter{ID: "legacy-browsers", Active: legacyBrowsers}), nil
+		},
+		updateProjectInboundFilter: func(org sentry.Organization, proj sentry.Project, id string, active bool) error {
+			assert.Equal(t, *proj.Slug, "proj-slug")
+			m.active[id] = active
+			return nil
+		},
+		updateProjectLegacyBrowsersFilter: func(org sentry.Organization, proj sentry.Project, browsers []string) error {
+			assert.Equal(t, *proj.Slug, "proj-slug")
+			m.legacyBrowsers = browsers
+			return nil
+		},
+		updateProjectOptions: func(org sentry.Organization, proj sentry.Project, options map[string]interface{}) error {
+			assert.Equal(t, *proj.Slug, "proj-slug")
+			for key, value := range options {
+				m.options[key] = value
+			}
+			return nil
+		},
+	}
+}
+
+func TestProjectInboundFiltersCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"browserExtensions": resource.NewPropertyValue(false),
+				"errorMessages":     emptyList,
+				"ipAddresses":       emptyList,
+				"legacyBrowsers":    emptyList,
+				"localhost":         resource.NewPropertyValue(false),
+				"releases":          emptyList,
+				"webCrawlers":       resource.NewPropertyValue(false),
+			},
+		},
+		"wrong type": {
+			news: resource.PropertyMap{
+				"browserExtensions": resource.NewPropertyValue("yes"),
+				"errorMessages":     resource.NewPropertyValue("*TypeError*"),
+				"ipAddresses":       resource.NewPropertyValue([]interface{}{""}),
+				"legacyBrowsers":    resource.NewPropertyValue(true),
+				"localhost":         resource.NewPropertyValue(1),
+				"organizationSlug":  resource.NewPropertyValue("org-slug"),
+				"projectSlug":       resource.NewPropertyValue("proj-slug"),
+				"releases":          resource.NewPropertyValue([]interface{}{1}),
+				"webCrawlers":       resource.NewPropertyValue("no"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "browserExtensions", Reason: "this input must be a boolean"},
+				{Property: "errorMessages", Reason: "this input must be a list of non-empty strings"},
+				{Property: "ipAddresses", Reason: "this input must be a list of non-empty strings"},
+				{Property: "legacyBrowsers", Reason: "this input must be a list of non-empty strings"},
+				{Property: "localhost", Reason: "this input must be a boolean"},
+				{Property: "releases", Reason: "this input must be a list of non-empty strings"},
+				{Property: "webCrawlers", Reason: "this input must be a boolean"},
+			},
+			wantInputs: resource.PropertyMap{
+				"browserExtensions": resource.NewPropertyValue("yes"),
+				"errorMessages":     resource.NewPropertyValue("*TypeError*"),
+				"ipAddresses":       resource.NewPropertyValue([]interface{}{""}),
+				"legacyBrowsers":    resource.NewPropertyValue(true),
+				"localhost":         resource.NewPropertyValue(1),
+				"organizationSlug":  resource.NewPropertyValue("org-slug"),
+				"projectSlug":       resource.NewPropertyValue("proj-slug"),
+				"releases":          resource.NewPropertyValue([]interface{}{1}),
+				"webCrawlers":       resource.NewPropertyValue("no"),
+			},
+		},
+		"legacy browsers sorted": {
+			news: resource.PropertyMap{
+				"legacyBrowsers":   resource.NewPropertyValue([]interface{}{"safari_pre_6", "ie_pre_9"}),
+				"localhost":        resource.NewPropertyValue(true),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+			},
+			wantFailures: nil,
+			wantInputs: resource.PropertyMap{
+				"browserExtensions": resource.NewPropertyValue(false),
+				"errorMessages":     emptyList,
+				"ipAddresses":       emptyList,
+				"legacyBrowsers":    resource.NewPropertyValue([]interface{}{"ie_pre_9", "safari_pre_6"}),
+				"localhost":         resource.NewPropertyValue(true),
+				"organizationSlug":  resource.NewPropertyValue("org-slug"),
+				"projectSlug":       resource.NewPropertyValue("proj-slug"),
+				"releases":          emptyList,
+				"webCrawlers":       resource.NewPropertyValue(false),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.projectInboundFiltersCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestProjectInboundFiltersDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"browserExtensions": resource.NewPropertyValue(false),
+		"errorMessages":     emptyList,
+		"ipAddresses":       emptyList,
+		"legacyBrowsers":    emptyList,
+		"localhost":         resource.NewPropertyValue(false),
+		"organizationSlug":  resource.NewPropertyValue("org-slug"),
+		"projectSlug":       resource.NewPropertyValue("proj-slug"),
+		"releases":          emptyList,
+		"webCrawlers":       resource.NewPropertyValue(false),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseOlds,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"errorMessages": resource.NewPropertyValue([]interface{}{"*TypeError*"}),
+				"localhost":     resource.NewPropertyValue(true),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"errorMessages", "localhost"},
+			},
+		},
+		"project renamed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"projectSlug": resource.NewPropertyValue("other-proj-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"projectSlug"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("other-org-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.projectInboundFiltersDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			sort.Strings(resp.Diffs)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestProjectInboundFiltersCreate(t *testing.T) {
+	ctx := context.Background()
+	mock := &projectInboundFiltersMock{
+		active:  map[string]bool{"web-crawlers": true},
+		options: map[string]interface{}{"sentry:origins": "*"},
+	}
+	prov := sentryProvider{sentryClient: mock.client(t)}
+	inputs := resource.PropertyMap{
+		"browserExtensions": resource.NewPropertyValue(true),
+		"errorMessages":     resource.NewPropertyValue([]interface{}{"*TypeError*", "*ChunkLoadError*"}),
+		"ipAddresses":       emptyList,
+		"legacyBrowsers":    resource.NewPropertyValue([]interface{}{"ie_pre_9"}),
+		"localhost":         resource.NewPropertyValue(false),
+		"organizationSlug":  resource.NewPropertyValue("org-slug"),
+		"projectSlug":       resource.NewPropertyValue("proj-slug"),
+		"releases":          resource.NewPropertyValue([]interface{}{"*-dev"}),
+		"webCrawlers":       resource.NewPropertyValue(false),
+	}
+	resp, err := prov.projectInboundFiltersCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42")
+	assert.Equal(t, mock.active, map[string]bool{"browser-extensions": true, "localhost": false, "web-crawlers": false})
+	assert.Equal(t, mock.legacyBrowsers, []string{"ie_pre_9"})
+	assert.Equal(t, mock.options, map[string]interface{}{
+		"filters:blacklisted_ips": "",
+		"filters:error_messages":  "*TypeError*\n*ChunkLoadError*",
+		"filters:releases":        "*-dev",
+		"sentry:origins":          "*",
+	})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), inputs)
+}
+
+func TestProjectInboundFiltersRead(t *testing.T) {
+	ctx := context.Background()
+	mock := &projectInboundFiltersMock{
+		active:         map[string]bool{"localhost": true},
+		legacyBrowsers: []string{"safari_pre_6", "ie_pre_9"},
+		options: map[string]interface{}{
+			"filters:blacklisted_ips": "10.0.0.0/8\n\n127.0.0.1",
+		},
+	}
+	prov := sentryProvider{sentryClient: mock.client(t)}
+	resp, err := prov.projectInboundFiltersRead(ctx, &rpc.ReadRequest{Id: "org-slug/42"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42")
+	want := resource.PropertyMap{
+		"browserExtensions": resource.NewPropertyValue(false),
+		"errorMessages":     emptyList,
+		"ipAddresses":       resource.NewPropertyValue([]interface{}{"10.0.0.0/8", "127.0.0.1"}),
+		"legacyBrowsers":    resource.NewPropertyValue([]interface{}{"ie_pre_9", "safari_pre_6"}),
+		"localhost":         resource.NewPropertyValue(true),
+		"organizationSlug":  resource.NewPropertyValue("org-slug"),
+		"projectSlug":       resource.NewPropertyValue("proj-slug"),
+		"releases":          emptyList,
+		"webCrawlers":       resource.NewPropertyValue(false),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+}
+
+func TestProjectInboundFiltersRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				return sentry.Project{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.projectInboundFiltersRead(ctx, &rpc.ReadRequest{Id: "org-slug/proj-slug"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestProjectInboundFiltersUpdate(t *testing.T) {
+	ctx := context.Background()
+	mock := &projectInboundFiltersMock{
+		// Turned on in Sentry since the last refresh.
+		active:  map[string]bool{"browser-extensions": true},
+		options: map[string]interface{}{},
+	}
+	prov := sentryProvider{sentryClient: mock.client(t)}
+	olds := resource.PropertyMap{
+		"browserExtensions": resource.NewPropertyValue(false),
+		"errorMessages":     emptyList,
+		"ipAddresses":       emptyList,
+		"legacyBrowsers":    emptyList,
+		"localhost":         resource.NewPropertyValue(false),
+		"organizationSlug":  resource.NewPropertyValue("org-slug"),
+		"projectSlug":       resource.NewPropertyValue("proj-slug"),
+		"releases":          emptyList,
+		"webCrawlers":       resource.NewPropertyValue(false),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"ipAddresses": resource.NewPropertyValue([]interface{}{"127.0.0.1"}),
+		"webCrawlers": resource.NewPropertyValue(true),
+	})
+	resp, err := prov.projectInboundFiltersUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mock.active, map[string]bool{"browser-extensions": false, "localhost": false, "web-crawlers": true})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
+
+func TestProjectInboundFiltersDelete(t *testing.T) {
+	ctx := context.Background()
+	mock := &projectInboundFiltersMock{
+		active:         map[string]bool{"browser-extensions": true, "localhost": true, "web-crawlers": true},
+		legacyBrowsers: []string{"ie_pre_9"},
+		options:        map[string]interface{}{"filters:releases": "*-dev"},
+	}
+	prov := sentryProvider{sentryClient: mock.client(t)}
+	_, err := prov.projectInboundFiltersDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/42"})
+	assert.Nil(t, err)
+	assert.Equal(t, mock.active, map[string]bool{"browser-extensions": false, "localhost": false, "web-crawlers": false})
+	assert.Equal(t, mock.legacyBrowsers, []string{})
+	assert.Equal(t, mock.options, map[string]interface{}{
+		"filters:blacklisted_ips": "",
+		"filters:error_messages":  "",
+		"filters:releases":        "",
+	})
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var projectOwnershipProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"autoAssignment": true,
+		"fallthrough":    true,
+		// Follows renames of the project, see buildProjectResourceID.
+		"projectSlug": true,
+		"raw":         true,
+	},
+	outputs: map[string]bool{},
+}
+
+// The ownership rules of a project have the format of CODEOWNERS files, with
+// the type of what is matched in front of the pattern, e.g.
+//
+//	path:src/billing/* #billing alice@example.com
+//	url:*/checkout/* #payments
+//	tags.browser:Chrome* #frontend
+//
+// Rules without a type match paths.
+var (
+	projectOwnershipMatcherType = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_.-]*):`)
+	projectOwnershipTagType     = regexp.MustCompile(`^tags\.[^:\s]+$`)
+	projectOwnershipQuoted      = regexp.MustCompile(`^"([^"\\]*(?:\\.[^"\\]*)*)"`)
+)
+
+var projectOwnershipMatcherTypes = map[string]bool{
+	"codeowners": true,
+	"module":     true,
+	"path":       true,
+	"url":        true,
+}
+
+func (k *sentryProvider) projectOwnershipCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalBool(&failures, news, "autoAssignment")
+	checkOptionalBool(&failures, news, "fallthrough")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "projectSlug")
+	checkNonEmptyString(&failures, news, "raw")
+	if raw := news["raw"]; raw.IsString() {
+		for _, problem := range checkProjectOwnershipRules(raw.StringValue()) {
+			failures = append(failures, &rpc.CheckFailure{Property: "raw", Reason: problem})
+		}
+	}
+
+	// Fill in the defaults of Sentry, so that Read does not report a
+	// difference against inputs that skip them.
+	if news["autoAssignment"].IsNull() {
+		news["autoAssignment"] = resource.NewBoolProperty(false)
+	}
+	if news["fallthrough"].IsNull() {
+		news["fallthrough"] = resource.NewBoolProperty(true)
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+// checkProjectOwnershipRules finds the problems Sentry would reject, or
+// silently misread, in ownership rules, one per line.
+func checkProjectOwnershipRules(raw string) []string {
+	var problems []string
+	for i, line := range strings.Split(raw, "\n") {
+		if problem := checkProjectOwnershipRule(strings.TrimSpace(line)); problem != "" {
+			problems = append(problems, fmt.Sprintf("line %d: %s", i+1, problem))
+		}
+	}
+	return problems
+}
+
+func checkProjectOwnershipRule(rule string) string {
+	if rule == "" || strings.HasPrefix(rule, "#") {
+		// Empty lines and comments.
+		return ""
+	}
+
+	if match := projectOwnershipMatcherType.FindStringSubmatch(rule); match != nil {
+		matcherType := match[1]
+		if !projectOwnershipMatcherTypes[matcherType] && !projectOwnershipTagType.MatchString(matcherType) {
+			return fmt.Sprintf("unknown matcher type %q, must be one of path, url, module, codeowners or tags.<name>", matcherType)
+		}
+		rule = rule[len(match[0]):]
+	}
+
+	var pattern string
+	if strings.HasPrefix(rule, `"`) {
+		quoted := projectOwnershipQuoted.FindString(rule)
+		if quoted == "" {
+			return "unterminated quoted pattern"
+		}
+		pattern, rule = quoted[1:len(quoted)-1], rule[len(quoted):]
+	} else {
+		fields := strings.SplitN(rule, " ", 2)
+		pattern, rule = fields[0], ""
+		if len(fields) > 1 {
+			rule = fields[1]
+		}
+	}
+	if strings.TrimSpace(pattern) == "" {
+		return "missing pattern"
+	}
+
+	owners := strings.Fields(rule)
+	if len(owners) == 0 {
+		return fmt.Sprintf("no owners for %q", pattern)
+	}
+	for _, owner := range owners {
+		if owner == "#" || (!strings.HasPrefix(owner, "#") && !strings.Contains(owner, "@")) {
+			return fmt.Sprintf("owner %q must be a #team-slug or the email of a user", owner)
+		}
+	}
+	return ""
+}
+
+func (k *sentryProvider) projectOwnershipDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return projectOwnershipProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) projectOwnershipCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	projectSlug := inputs["projectSlug"].StringValue()
+	project, err := k.sentryClient.GetProject(sentry.Organization{Slug: &organizationSlug}, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProject %v: %w", projectSlug, err)
+	}
+
+	ownership, err := k.setProjectOwnership(organizationSlug, projectSlug, projectOwnershipFromInputs(inputs))
+	if err != nil {
+		return nil, err
+	}
+	outputProperties, err := plugin.MarshalProperties(projectOwnershipPropertyMap(organizationSlug, projectSlug, ownership), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildProjectResourceID(organizationSlug, project),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) projectOwnershipUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectOwnershipUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectOwnershipUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := projectOwnershipProperties.checkUpdatable("projectOwnershipUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	organizationSlug, project, _, err := k.parseUpdatedProjectResourceID(req.GetId(), news, 0)
+	if err != nil {
+		return nil, err
+	}
+	ownership, err := k.setProjectOwnership(organizationSlug, *project.Slug, projectOwnershipFromInputs(news))
+	if err != nil {
+		return nil, err
+	}
+	outputProperties, err := plugin.MarshalProperties(projectOwnershipPropertyMap(organizationSlug, *project.Slug, ownership), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) projectOwnershipRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.properties", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed projectOwnershipRead because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, _, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 0)
+	var ownership projectOwnership
+	if err == nil {
+		ownership, err = k.sentryClient.GetProjectOwnership(sentry.Organization{Slug: &organizationSlug}, project)
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// The project is not there, delete its ownership rules from
+			// stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetProjectOwnership %v: %w", req.GetId(), err)
+	}
+	properties := projectOwnershipPropertyMap(organizationSlug, *project.Slug, ownership)
+	newState, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(projectOwnershipProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		// This also migrates legacy <orgSlug>/<projectSlug> IDs.
+		Id:         buildProjectResourceID(organizationSlug, project),
+		Properties: newState,
+		Inputs:     inputs,
+	}, nil
+}
+
+// projectOwnershipDelete removes all the ownership rules, and restores the
+// defaults of a new project.
+func (k *sentryProvider) projectOwnershipDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{})
+	if err != nil {
+		return &pbempty.Empty{}, fmt.Errorf("failed projectOwnershipDelete because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, _, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 0)
+	if err == nil {
+		_, err = k.setProjectOwnership(organizationSlug, *project.Slug, projectOwnership{Fallthrough: true})
+	}
+	if isNotFound(err) {
+		// The project and its ownership rules are already gone.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+func (k *sentryProvider) setProjectOwnership(organizationSlug, projectSlug string, ownership projectOwnership) (projectOwnership, error) {
+	updated, err := k.sentryClient.UpdateProjectOwnership(sentry.Organization{Slug: &organizationSlug}, sentry.Project{Slug: &projectSlug}, ownership)
+	if err != nil {
+		return projectOwnership{}, fmt.Errorf("could not UpdateProjectOwnership %v: %w", buildID(organizationSlug, projectSlug), err)
+	}
+	return updated, nil
+}
+
+func projectOwnershipFromInputs(inputs resource.PropertyMap) projectOwnership {
+	return projectOwnership{
+		Raw:            stringFromPropertyValue(inputs["raw"]),
+		Fallthrough:    boolFromPropertyValue(inputs["fallthrough"]),
+		AutoAssignment: boolFromPropertyValue(inputs["autoAssignment"]),
+	}
+}
+
+func projectOwnershipPropertyMap(organizationSlug, projectSlug string, ownership projectOwnership) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"autoAssignment":   ownership.AutoAssignment,
+		"fallthrough":      ownership.Fallthrough,
+		"organizationSlug": organizationSlug,
+		"projectSlug":      projectSlug,
+		"raw":              ownership.Raw,
+	})
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestProjectOwnershipCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+				{Property: "raw", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"autoAssignment": resource.NewPropertyValue(false),
+				"fallthrough":    resource.NewPropertyValue(true),
+			},
+		},
+		"wrong type": {
+			news: resource.PropertyMap{
+				"autoAssignment":   resource.NewPropertyValue("yes"),
+				"fallthrough":      resource.NewPropertyValue(0),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"raw":              resource.NewPropertyValue([]interface{}{"path:* #team"}),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "autoAssignment", Reason: "this input must be a boolean"},
+				{Property: "fallthrough", Reason: "this input must be a boolean"},
+				{Property: "raw", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"autoAssignment":   resource.NewPropertyValue("yes"),
+				"fallthrough":      resource.NewPropertyValue(0),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"raw":              resource.NewPropertyValue([]interface{}{"path:* #team"}),
+			},
+		},
+		"valid rules": {
+			news: resource.PropertyMap{
+				"autoAssignment":   resource.NewPropertyValue(true),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"raw": resource.NewPropertyValue("# Billing\n" +
+					"path:src/billing/* #billing alice@example.com\n" +
+					"\n" +
+					"  url:*/checkout/*   #payments\n" +
+					"tags.browser:Chrome* #frontend\n" +
+					"module:com.example.* #backend\n" +
+					"path:\"src/with space/*\" #docs\n" +
+					"*.js #frontend\n"),
+			},
+			wantFailures: nil,
+			wantInputs: resource.PropertyMap{
+				"autoAssignment":   resource.NewPropertyValue(true),
+				"fallthrough":      resource.NewPropertyValue(true),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"raw": resource.NewPropertyValue("# Billing\n" +
+					"path:src/billing/* #billing alice@example.com\n" +
+					"\n" +
+					"  url:*/checkout/*   #payments\n" +
+					"tags.browser:Chrome* #frontend\n" +
+					"module:com.example.* #backend\n" +
+					"path:\"src/with space/*\" #docs\n" +
+					"*.js #frontend\n"),
+			},
+		},
+		"invalid rules": {
+			news: resource.PropertyMap{
+				"fallthrough":      resource.NewPropertyValue(false),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"raw": resource.NewPropertyValue("paht:src/* #team\n" +
+					"path:src/*\n" +
+					"url:*/checkout/* payments\n" +
+					"path:\"src/* #team\n" +
+					"path: #team\n" +
+					"path:src/* #\n"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "raw", Reason: `line 1: unknown matcher type "paht", must be one of path, url, module, codeowners or tags.<name>`},
+				{Property: "raw", Reason: `line 2: no owners for "src/*"`},
+				{Property: "raw", Reason: `line 3: owner "payments" must be a #team-slug or the email of a user`},
+				{Property: "raw", Reason: "line 4: unterminated quoted pattern"},
+				{Property: "raw", Reason: "line 5: missing pattern"},
+				{Property: "raw", Reason: `line 6: owner "#" must be a #team-slug or the email of a user`},
+			},
+			wantInputs: resource.PropertyMap{
+				"autoAssignment":   resource.NewPropertyValue(false),
+				"fallthrough":      resource.NewPropertyValue(false),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"raw": resource.NewPropertyValue("paht:src/* #team\n" +
+					"path:src/*\n" +
+					"url:*/checkout/* payments\n" +
+					"path:\"src/* #team\n" +
+					"path: #team\n" +
+					"path:src/* #\n"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.projectOwnershipCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Stable(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestProjectOwnershipDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"autoAssignment":   resource.NewPropertyValue(false),
+		"fallthrough":      resource.NewPropertyValue(true),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"raw":              resource.NewPropertyValue("path:* #team"),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseOlds,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"autoAssignment": resource.NewPropertyValue(true),
+				"raw":            resource.NewPropertyValue("path:* #other-team"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"autoAssignment", "raw"},
+			},
+		},
+		"project renamed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"projectSlug": resource.NewPropertyValue("other-proj-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"projectSlug"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("other-org-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.projectOwnershipDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			sort.Strings(resp.Diffs)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestProjectOwnershipCreate(t *testing.T) {
+	ctx := context.Background()
+	var updated projectOwnership
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateProjectOwnership: func(org sentry.Organization, proj sentry.Project, ownership projectOwnership) (projectOwnership, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				updated = ownership
+				return ownership, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	inputs := resource.PropertyMap{
+		"autoAssignment":   resource.NewPropertyValue(true),
+		"fallthrough":      resource.NewPropertyValue(false),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"raw":              resource.NewPropertyValue("path:src/* #team"),
+	}
+	resp, err := prov.projectOwnershipCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42")
+	assert.Equal(t, updated, projectOwnership{Raw: "path:src/* #team", AutoAssignment: true})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), inputs)
+}
+
+func TestProjectOwnershipRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getProjectOwnership: func(org sentry.Organization, proj sentry.Project) (projectOwnership, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				return projectOwnership{Raw: "url:* #team", Fallthrough: true}, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.projectOwnershipRead(ctx, &rpc.ReadRequest{Id: "org-slug/42"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42")
+	want := resource.PropertyMap{
+		"autoAssignment":   resource.NewPropertyValue(false),
+		"fallthrough":      resource.NewPropertyValue(true),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"raw":              resource.NewPropertyValue("url:* #team"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+}
+
+func TestProjectOwnershipRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getProjectOwnership: func(org sentry.Organization, proj sentry.Project) (projectOwnership, error) {
+				return projectOwnership{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.projectOwnershipRead(ctx, &rpc.ReadRequest{Id: "org-slug/42"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestProjectOwnershipUpdate(t *testing.T) {
+	ctx := context.Background()
+	var updated projectOwnership
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateProjectOwnership: func(org sentry.Organization, proj sentry.Project, ownership projectOwnership) (projectOwnership, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				updated = ownership
+				return ownership, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	olds := resource.PropertyMap{
+		"autoAssignment":   resource.NewPropertyValue(false),
+		"fallthrough":      resource.NewPropertyValue(true),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"raw":              resource.NewPropertyValue("path:* #team"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"raw": resource.NewPropertyValue("path:* #team\nurl:* #web"),
+	})
+	resp, err := prov.projectOwnershipUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, updated, projectOwnership{Raw: "path:* #team\nurl:* #web", Fallthrough: true})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
+
+func TestProjectOwnershipDelete(t *testing.T) {
+	ctx := context.Background()
+	var updated *projectOwnership
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateProjectOwnership: func(org sentry.Organization, proj sentry.Project, ownership projectOwnership) (projectOwnership, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				updated = &ownership
+				return ownership, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	_, err := prov.projectOwnershipDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/42"})
+	assert.Nil(t, err)
+	assert.Equal(t, updated, &projectOwnership{Fallthrough: true})
+}
@@ -3,7 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"sort"
+	"strconv"
 
 	pbempty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/marcin-ro/go-sentry-api"
@@ -16,45 +17,74 @@ import (
 
 var (
 	projectPropertiesChangedByReplacement = map[string]bool{
-		// Organization and project slugs are part of the Project's ID.
-		//
-		// Sentry API allows changing the project slug, but Pulumi does not
-		// allow updating a resource ID in `update`, so if we were to allow
-		// that we would have to use artificial IDs on Pulumi side instead of
-		// the fully readable and predictable <orgSlug>/<projSlug>.
-		//
-		// It does not sound worth it, let's just assume that changing a
-		// project's slug requires a replacement.
+		// Projects can't be moved between organizations.
 		"organizationSlug": true,
-		"slug":             true,
-
-		// Changing a project's owner team is a more complex operation now: I
-		// can't find any up to date documentation about it, only deprecation
-		// warnings on updating the team via the "update project" endpoint (it
-		// is already deprecated and not functioning on sentry.io).  I checked
-		// in Sentry code and it was already mostly refactored to not have the
-		// notion of an "owner team", just "teams with access to" (although it
-		// pretends to have one of them singled out as "the team" for the
-		// project).
-		//
-		// Taking that into account I'm shelving this.  Doing it cleanly will
-		// require changing the input on the Project resource from a single
-		// teamSlug to an array of slugs, and updating the go-sentry-api
-		// library, all so that we don't spend time handling the old
-		// abstraction that Sentry is already out of.  For the time being let's
-		// handle team changes by replacements and return to them when we know
-		// we need them.
-		"teamSlug": true,
 	}
 	projectPropertiesChangedByUpdate = map[string]bool{
-		"defaultEnvironment": true,
-		"name":               true,
-		"subjectPrefix":      true,
-		"subjectTemplate":    true,
+		"allowedDomains":       true,
+		"dataScrubber":         true,
+		"dataScrubberDefaults": true,
+		"defaultEnvironment":   true,
+		"name":                 true,
+		"resolveAge":           true,
+		"safeFields":           true,
+		"scrapeJavaScript":     true,
+		"scrubIPAddresses":     true,
+		"securityToken":        true,
+		"sensitiveFields":      true,
+		"subjectPrefix":        true,
+
+		// The project's ID is based on its numeric Sentry ID rather than its
+		// slug (see buildProjectID), so that renames don't need a
+		// replacement, which would lose all the project's issues.
+		"slug": true,
+
+		"subjectTemplate": true,
+
+		// Sentry no longer has the notion of a single owner team, only of
+		// teams with access to the project, so instead of replacing the
+		// project when its teams change we grant and revoke access in place.
+		// The deprecated teamSlug input is folded into teamSlugs by
+		// normalizeProjectTeams before diffing.
+		"teamSlugs": true,
+
+		// The rate limit of the project's default client key, which Sentry
+		// creates along with the project.
+		"defaultClientKeyRateLimitCount":  true,
+		"defaultClientKeyRateLimitWindow": true,
 	}
 	projectOutputs = map[string]bool{
 		"defaultClientKeyDSNPublic": true,
 	}
+	projectProperties = resourceProperties{
+		changedByReplacement: projectPropertiesChangedByReplacement,
+		changedByUpdate:      projectPropertiesChangedByUpdate,
+		outputs:              projectOutputs,
+	}
+
+	// projectSettingsKeys are the data scrubbing and security settings of
+	// projects.  Sentry has defaults for all of them, so they are only
+	// managed when set: projects leaving them out keep what they have in
+	// Sentry.
+	projectSettingsKeys = []string{
+		"allowedDomains",
+		"dataScrubber",
+		"dataScrubberDefaults",
+		"resolveAge",
+		"safeFields",
+		"scrapeJavaScript",
+		"scrubIPAddresses",
+		"securityToken",
+		"sensitiveFields",
+	}
+
+	// projectDefaultClientKeyKeys are the settings of the project's default
+	// client key.  Like projectSettingsKeys, they are only managed when set:
+	// keys of projects leaving them out are not rate limited by Pulumi.
+	projectDefaultClientKeyKeys = []string{
+		"defaultClientKeyRateLimitCount",
+		"defaultClientKeyRateLimitWindow",
+	}
 )
 
 func (k *sentryProvider) projectCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
@@ -73,69 +103,80 @@ func (k *sentryProvider) projectCheck(ctx context.Context, req *rpc.CheckRequest
 		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
 	}
 
+	k.fillDefaultOrganization(news)
+
 	var failures []*rpc.CheckFailure
+	checkOptionalStringArray(&failures, news, "allowedDomains")
+	checkOptionalBool(&failures, news, "dataScrubber")
+	checkOptionalBool(&failures, news, "dataScrubberDefaults")
+	checkOptionalPositiveInteger(&failures, news, "defaultClientKeyRateLimitCount")
+	checkOptionalPositiveInteger(&failures, news, "defaultClientKeyRateLimitWindow")
+	checkBothOrNeither(&failures, news, "defaultClientKeyRateLimitCount", "defaultClientKeyRateLimitWindow")
 	checkOptionalString(&failures, news, "defaultEnvironment")
 	checkNonEmptyString(&failures, news, "organizationSlug")
 	checkNonEmptyString(&failures, news, "name")
+	checkOptionalNonNegativeInteger(&failures, news, "resolveAge")
+	checkOptionalStringArray(&failures, news, "safeFields")
+	checkOptionalBool(&failures, news, "scrapeJavaScript")
+	checkOptionalBool(&failures, news, "scrubIPAddresses")
+	checkOptionalString(&failures, news, "securityToken")
+	checkOptionalStringArray(&failures, news, "sensitiveFields")
 	checkNonEmptyString(&failures, news, "slug")
 	checkOptionalString(&failures, news, "subjectPrefix")
 	checkOptionalString(&failures, news, "subjectTemplate")
-	checkNonEmptyString(&failures, news, "teamSlug")
+	checkOptionalString(&failures, news, "teamSlug")
+	checkOptionalStringArray(&failures, news, "teamSlugs")
+	checkProjectTeams(&failures, news)
 
-	return &rpc.CheckResponse{Inputs: req.News, Failures: failures}, nil
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
 }
 
 func (k *sentryProvider) projectDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
-	d := olds.Diff(news)
-	if d == nil {
-		return &rpc.DiffResponse{}, nil
-	}
-
-	var diffs, replaces []string
-	for _, key := range d.Keys() {
-		if d.Changed(key) {
-			switch {
-			case projectPropertiesChangedByReplacement[string(key)]:
-				diffs = append(diffs, string(key))
-				replaces = append(replaces, string(key))
-			case projectPropertiesChangedByUpdate[string(key)]:
-				diffs = append(diffs, string(key))
-			case projectOutputs[string(key)]:
-				// Ignore.
-			default:
-				// Sanity check panic.  This should not happen unless we have
-				// an inconsistency in property definitions, in which case it's
-				// better find out here during testing.
-				panic(fmt.Sprintf("don't know how to deal with change in %v", key))
-			}
-		}
-	}
+	olds = withoutEmptyStrings(olds, projectOptionalStringKeys...)
+	news = withoutEmptyStrings(news, projectOptionalStringKeys...)
+	return projectProperties.diff(normalizeProjectTeams(projectWithoutUnset(olds, news)), normalizeProjectTeams(news))
+}
 
-	changes := rpc.DiffResponse_DIFF_NONE
-	if len(diffs) > 0 {
-		changes = rpc.DiffResponse_DIFF_SOME
-	}
+// projectOptionalStringKeys are the properties Sentry returns as empty
+// strings when they are not set.
+var projectOptionalStringKeys = []string{"defaultEnvironment", "subjectPrefix", "subjectTemplate"}
 
-	return &rpc.DiffResponse{
-		Changes:             changes,
-		Diffs:               diffs,
-		Replaces:            replaces,
-		DeleteBeforeReplace: len(replaces) > 0,
-	}, nil
+// projectWithoutUnset returns a copy of olds without the settings of the
+// project and of its default key left out of news.
+func projectWithoutUnset(olds, news resource.PropertyMap) resource.PropertyMap {
+	return withoutUnset(withoutUnset(olds, news, projectSettingsKeys...), news, projectDefaultClientKeyKeys...)
 }
 
 func (k *sentryProvider) projectCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
 	organizationSlug := inputs["organizationSlug"].StringValue()
 	name := inputs["name"].StringValue()
 	slug := inputs["slug"].StringValue()
-	teamSlug := inputs["teamSlug"].StringValue()
+	teamSlugs := projectTeamSlugs(inputs)
 
 	org := sentry.Organization{Slug: &organizationSlug}
-	project, err := k.sentryClient.CreateProject(org, sentry.Team{Slug: &teamSlug}, name, &slug)
+	project, err := k.sentryClient.CreateProject(org, sentry.Team{Slug: &teamSlugs[0]}, name, &slug)
 	if err != nil {
 		return nil, fmt.Errorf("could not CreateProject %v: %v", slug, err)
 	}
 
+	// A project is created with access for a single team, grant it to the
+	// rest of them.
+	for _, teamSlug := range teamSlugs[1:] {
+		if err := k.sentryClient.AddProjectTeam(org, sentry.Project{Slug: project.Slug}, teamSlug); err != nil {
+			return nil, fmt.Errorf("could not AddProjectTeam %v to %v: %v", teamSlug, slug, err)
+		}
+	}
+
 	project.DefaultEnvironment = stringPtrFromPropertyValue(inputs["defaultEnvironment"])
 	project.SubjectPrefix = stringPtrFromPropertyValue(inputs["subjectPrefix"])
 	project.SubjectTemplate = stringPtrFromPropertyValue(inputs["subjectTemplate"])
@@ -143,6 +184,16 @@ func (k *sentryProvider) projectCreate(ctx context.Context, req *rpc.CreateReque
 	if err := k.sentryClient.UpdateProject(org, project); err != nil {
 		return nil, fmt.Errorf("could not UpdateProject %v: %v", project.Slug, err)
 	}
+	if settings, ok := projectSettingsFromInputs(inputs); ok {
+		if err := k.sentryClient.UpdateProjectSettings(org, sentry.Project{Slug: &slug}, settings); err != nil {
+			return nil, fmt.Errorf("could not UpdateProjectSettings %v: %v", slug, err)
+		}
+	}
+	if rateLimit := projectDefaultClientKeyRateLimit(inputs); rateLimit != nil {
+		if err := k.updateDefaultClientKeyRateLimit(organizationSlug, slug, rateLimit); err != nil {
+			return nil, err
+		}
+	}
 
 	defaultKey, err := getDefaultClientKey(k.sentryClient, organizationSlug, slug)
 	if err != nil {
@@ -157,7 +208,13 @@ func (k *sentryProvider) projectCreate(ctx context.Context, req *rpc.CreateReque
 		"slug":                      *project.Slug,
 		"subjectPrefix":             project.SubjectPrefix,
 		"subjectTemplate":           project.SubjectTemplate,
-		"teamSlug":                  teamSlug,
+		"teamSlug":                  teamSlugs[0],
+		"teamSlugs":                 teamSlugs,
+	}
+	for _, key := range append(append([]string{}, projectSettingsKeys...), projectDefaultClientKeyKeys...) {
+		if value := inputs[resource.PropertyKey(key)]; !value.IsNull() {
+			outputs[key] = value.Mappable()
+		}
 	}
 
 	outputProperties, err := plugin.MarshalProperties(
@@ -168,7 +225,7 @@ func (k *sentryProvider) projectCreate(ctx context.Context, req *rpc.CreateReque
 		return nil, err
 	}
 	return &rpc.CreateResponse{
-		Id:         buildProjectID(organizationSlug, *project.Slug),
+		Id:         buildProjectID(organizationSlug, project),
 		Properties: outputProperties,
 	}, nil
 }
@@ -178,10 +235,6 @@ func (k *sentryProvider) projectUpdate(ctx context.Context, req *rpc.UpdateReque
 	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
 	logger.V(9).Infof("%s executing", label)
 
-	organizationSlug, slug, err := parseProjectID(req.GetId())
-	if err != nil {
-		return nil, err
-	}
 	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
 		Label: fmt.Sprintf("%s.olds", label),
 	})
@@ -195,16 +248,44 @@ func (k *sentryProvider) projectUpdate(ctx context.Context, req *rpc.UpdateReque
 		return nil, fmt.Errorf("failed projectUpdate because of malformed resource inputs: %w", err)
 	}
 
-	d := olds.Diff(news)
-	if d == nil {
+	if normalizeProjectTeams(projectWithoutUnset(olds, news)).Diff(normalizeProjectTeams(news)) == nil {
 		// This would be really surprising, pulumi should not let that happen.
 		return &rpc.UpdateResponse{}, nil
 	}
 
 	// This should already be validated by Diff, but let's be strict just in case.
-	for _, key := range d.Keys() {
-		if d.Changed(key) && !projectPropertiesChangedByUpdate[string(key)] && !projectOutputs[string(key)] {
-			return nil, fmt.Errorf("projectUpdate: don't know how to change %v", key)
+	if err := projectProperties.checkUpdatable("projectUpdate", normalizeProjectTeams(olds), normalizeProjectTeams(news)); err != nil {
+		return nil, err
+	}
+
+	organizationSlug, current, err := k.getProject(req.GetId(), stringFromPropertyValue(olds["slug"]))
+	if err != nil {
+		return nil, fmt.Errorf("could not find project %v: %v", req.GetId(), err)
+	}
+	org := sentry.Organization{Slug: &organizationSlug}
+	slug := news["slug"].StringValue()
+	if *current.Slug != slug {
+		if err := k.sentryClient.RenameProject(org, current, slug); err != nil {
+			return nil, fmt.Errorf("could not RenameProject %v to %v: %v", *current.Slug, slug, err)
+		}
+	}
+
+	oldTeamSlugs := projectTeamSlugs(olds)
+	teamSlugs := projectTeamSlugs(news)
+	// Grant access to new teams before revoking it from the old ones, so that
+	// the project is never left without any team.
+	for _, teamSlug := range teamSlugs {
+		if !containsString(oldTeamSlugs, teamSlug) {
+			if err := k.sentryClient.AddProjectTeam(org, sentry.Project{Slug: &slug}, teamSlug); err != nil {
+				return nil, fmt.Errorf("could not AddProjectTeam %v to %v: %v", teamSlug, slug, err)
+			}
+		}
+	}
+	for _, teamSlug := range oldTeamSlugs {
+		if !containsString(teamSlugs, teamSlug) {
+			if err := k.sentryClient.RemoveProjectTeam(org, sentry.Project{Slug: &slug}, teamSlug); err != nil {
+				return nil, fmt.Errorf("could not RemoveProjectTeam %v from %v: %v", teamSlug, slug, err)
+			}
 		}
 	}
 
@@ -216,8 +297,35 @@ func (k *sentryProvider) projectUpdate(ctx context.Context, req *rpc.UpdateReque
 		SubjectTemplate:    stringPtrFromPropertyValue(news["subjectTemplate"]),
 	}
 
-	err = k.sentryClient.UpdateProject(sentry.Organization{Slug: &organizationSlug}, project)
-	return &rpc.UpdateResponse{Properties: req.GetNews()}, nil
+	if err := k.sentryClient.UpdateProject(org, project); err != nil {
+		return nil, fmt.Errorf("could not UpdateProject %v: %v", slug, err)
+	}
+	if settings, ok := projectSettingsFromInputs(news); ok {
+		if err := k.sentryClient.UpdateProjectSettings(org, project, settings); err != nil {
+			return nil, fmt.Errorf("could not UpdateProjectSettings %v: %v", slug, err)
+		}
+	}
+	if rateLimit := projectDefaultClientKeyRateLimit(news); rateLimit != nil {
+		if err := k.updateDefaultClientKeyRateLimit(organizationSlug, slug, rateLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	outputs := news.Copy()
+	outputs["teamSlug"] = resource.NewStringProperty(teamSlugs[0])
+	outputs["teamSlugs"] = resource.NewPropertyValue(teamSlugs)
+	for key := range projectOutputs {
+		if value, ok := olds[resource.PropertyKey(key)]; ok {
+			outputs[resource.PropertyKey(key)] = value
+		}
+	}
+	outputProperties, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.outputs", label), KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
 }
 
 func (k *sentryProvider) projectRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
@@ -225,67 +333,217 @@ func (k *sentryProvider) projectRead(ctx context.Context, req *rpc.ReadRequest)
 	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
 	logger.V(9).Infof("%s executing", label)
 
-	id := req.GetId()
-	organizationSlug, slug, err := parseProjectID(id)
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.properties", label),
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed projectRead because of malformed resource state: %w", err)
 	}
-	project, err := k.sentryClient.GetProject(sentry.Organization{Slug: &organizationSlug}, slug)
+	organizationSlug, project, err := k.getProject(req.GetId(), stringFromPropertyValue(state["slug"]))
 	if err != nil {
-		if apiError, ok := err.(sentry.APIError); ok {
-			if apiError.StatusCode == 404 {
-				// The project is not there, delete it from stack state.
-				return &rpc.ReadResponse{}, nil
-			}
-			// All other errors: just report them.
+		if isNotFound(err) {
+			// The project is not there, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
 		}
+		// All other errors: just report them.
 		return nil, err
 	}
-	defaultKey, err := getDefaultClientKey(k.sentryClient, organizationSlug, slug)
+	properties, err := k.projectPropertyMap(organizationSlug, project)
 	if err != nil {
-		return nil, fmt.Errorf("could not get default ClientKey for %v: %v", slug, err)
+		return nil, err
 	}
-	properties := resource.NewPropertyMapFromMap(map[string]interface{}{
-		"defaultClientKeyDSNPublic": defaultKey.DSN.Public,
-		"defaultEnvironment":        project.DefaultEnvironment,
-		"organizationSlug":          organizationSlug,
-		"name":                      project.Name,
-		"slug":                      *project.Slug,
-		"subjectPrefix":             project.SubjectPrefix,
-		"subjectTemplate":           project.SubjectTemplate,
-		"teamSlug":                  *project.Team.Slug,
-	})
-	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+	newState, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
 		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
 	})
 	if err != nil {
 		return nil, err
 	}
+	// The deprecated teamSlug is left out of inputs, as it's not in
+	// projectPropertiesChangedByUpdate.
+	inputs, err := plugin.MarshalProperties(projectProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
 	return &rpc.ReadResponse{
-		Id:         buildProjectID(organizationSlug, *project.Slug),
-		Properties: state,
+		// This also migrates legacy <orgSlug>/<slug> IDs, so that projects
+		// can be imported by `pulumi import sentry:index:Project <name>
+		// <orgSlug>/<slug>`.
+		Id:         buildProjectID(organizationSlug, project),
+		Properties: newState,
+		Inputs:     inputs,
 	}, nil
 }
 
 func (k *sentryProvider) projectDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
-	organizationSlug, slug, err := parseProjectID(req.GetId())
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{})
+	if err != nil {
+		return &pbempty.Empty{}, fmt.Errorf("failed projectDelete because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, err := k.getProject(req.GetId(), stringFromPropertyValue(state["slug"]))
 	if err != nil {
 		return &pbempty.Empty{}, err
 	}
-	err = k.sentryClient.DeleteProject(sentry.Organization{Slug: &organizationSlug}, sentry.Project{Slug: &slug})
+	err = k.sentryClient.DeleteProject(sentry.Organization{Slug: &organizationSlug}, sentry.Project{Slug: project.Slug})
 	return &pbempty.Empty{}, err
 }
 
-func buildProjectID(organizationSlug, slug string) string {
-	return fmt.Sprintf("%s/%s", organizationSlug, slug)
+// buildProjectID builds the ID of a project: <orgSlug>/<projectID>, where
+// projectID is the numeric ID assigned by Sentry, which unlike the slug
+// never changes.
+func buildProjectID(organizationSlug string, project sentry.Project) string {
+	return buildID(organizationSlug, project.ID)
+}
+
+// parseProjectID parses project IDs built by buildProjectID, as well as
+// legacy <orgSlug>/<slug> IDs used by earlier versions of the provider, in
+// which case legacySlug is set instead of projectID.  Sentry does not allow
+// slugs made of digits only, so the two can't be confused.
+func parseProjectID(id string) (organizationSlug, projectID, legacySlug string, err error) {
+	organizationSlug, last, err := parseSlugID(id)
+	if err != nil {
+		return "", "", "", err
+	}
+	if _, err := strconv.ParseUint(last, 10, 64); err == nil {
+		return organizationSlug, last, "", nil
+	}
+	return organizationSlug, "", last, nil
+}
+
+// buildProjectResourceID builds the ID of a resource belonging to a project,
+// such as a client key: <orgSlug>/<projectID>/<parts>.  Like projects, they
+// are keyed on the numeric ID of the project, so that renaming the project
+// updates their projectSlug in place instead of replacing them.
+func buildProjectResourceID(organizationSlug string, project sentry.Project, parts ...string) string {
+	return buildID(append([]string{organizationSlug, project.ID}, parts...)...)
 }
 
-func parseProjectID(id string) (organizationSlug, slug string, err error) {
-	parts := strings.Split(id, "/")
-	if len(parts) != 2 {
-		return "", "", fmt.Errorf("invalid ID: %s", id)
+// parseProjectResourceID parses IDs built by buildProjectResourceID with n
+// parts after the project, as well as legacy IDs with the slug of the project
+// instead of its ID, and fetches the project.  slugHint is the slug from
+// stack state, see getProject.
+func (k *sentryProvider) parseProjectResourceID(id, slugHint string, n int) (organizationSlug string, project sentry.Project, parts []string, err error) {
+	all, err := parseID(id, n+2)
+	if err != nil {
+		return "", sentry.Project{}, nil, err
+	}
+	organizationSlug, project, err = k.getProject(buildID(all[0], all[1]), slugHint)
+	if err != nil {
+		return "", sentry.Project{}, nil, err
+	}
+	return organizationSlug, project, all[2:], nil
+}
+
+// parseUpdatedProjectResourceID is parseProjectResourceID for updates, where
+// the projectSlug of news may follow a rename of the project, but can't move
+// the resource to another project.
+func (k *sentryProvider) parseUpdatedProjectResourceID(id string, news resource.PropertyMap, n int) (organizationSlug string, project sentry.Project, parts []string, err error) {
+	projectSlug := stringFromPropertyValue(news["projectSlug"])
+	organizationSlug, project, parts, err = k.parseProjectResourceID(id, projectSlug, n)
+	if err != nil {
+		return "", sentry.Project{}, nil, err
+	}
+	if *project.Slug != projectSlug {
+		return "", sentry.Project{}, nil, fmt.Errorf("%v belongs to project %v and can't be moved to project %v, replace it instead", id, *project.Slug, projectSlug)
+	}
+	return organizationSlug, project, parts, nil
+}
+
+// getProject fetches the project with the given Pulumi ID.  Sentry API
+// addresses projects by their slugs, so we try slugHint (usually the slug
+// from stack state) first, and look the project up by its numeric ID only if
+// it was renamed outside of Pulumi.
+func (k *sentryProvider) getProject(id, slugHint string) (organizationSlug string, project sentry.Project, err error) {
+	organizationSlug, projectID, legacySlug, err := parseProjectID(id)
+	if err != nil {
+		return "", sentry.Project{}, err
+	}
+	org := sentry.Organization{Slug: &organizationSlug}
+
+	if projectID == "" {
+		// There's nothing to verify the project against with legacy IDs.
+		if slugHint == "" {
+			slugHint = legacySlug
+		}
+		project, err = k.sentryClient.GetProject(org, slugHint)
+		return organizationSlug, project, err
+	}
+
+	if slugHint != "" {
+		project, err = k.sentryClient.GetProject(org, slugHint)
+		if err == nil && project.ID == projectID {
+			return organizationSlug, project, nil
+		}
+		if err != nil && !isNotFound(err) {
+			return "", sentry.Project{}, err
+		}
+	}
+
+	found, err := k.sentryClient.GetProjectByID(org, projectID)
+	if err != nil {
+		return "", sentry.Project{}, err
 	}
-	return parts[0], parts[1], nil
+	project, err = k.sentryClient.GetProject(org, *found.Slug)
+	return organizationSlug, project, err
+}
+
+// projectPropertyMap returns the properties of a fetched project, filling in
+// its teams and default key DSN which require separate API calls.
+func (k *sentryProvider) projectPropertyMap(organizationSlug string, project sentry.Project) (resource.PropertyMap, error) {
+	slug := *project.Slug
+	teams, err := k.sentryClient.GetProjectTeams(sentry.Organization{Slug: &organizationSlug}, sentry.Project{Slug: &slug})
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProjectTeams for %v: %v", slug, err)
+	}
+	teamSlugs := make([]string, 0, len(teams))
+	for _, team := range teams {
+		teamSlugs = append(teamSlugs, *team.Slug)
+	}
+	defaultKey, err := getDefaultClientKey(k.sentryClient, organizationSlug, slug)
+	if err != nil {
+		return nil, fmt.Errorf("could not get default ClientKey for %v: %v", slug, err)
+	}
+	settings, err := k.sentryClient.GetProjectSettings(sentry.Organization{Slug: &organizationSlug}, sentry.Project{Slug: &slug})
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProjectSettings for %v: %v", slug, err)
+	}
+	properties := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"allowedDomains":            settings.AllowedDomains,
+		"dataScrubber":              settings.DataScrubber,
+		"dataScrubberDefaults":      settings.DataScrubberDefaults,
+		"defaultClientKeyDSNPublic": defaultKey.DSN.Public,
+		"defaultEnvironment":        project.DefaultEnvironment,
+		"organizationSlug":          organizationSlug,
+		"name":                      project.Name,
+		"resolveAge":                settings.ResolveAge,
+		"safeFields":                settings.SafeFields,
+		"scrapeJavaScript":          settings.ScrapeJavaScript,
+		"scrubIPAddresses":          settings.ScrubIPAddresses,
+		"securityToken":             settings.SecurityToken,
+		"sensitiveFields":           settings.SensitiveFields,
+		"slug":                      slug,
+		"subjectPrefix":             project.SubjectPrefix,
+		"subjectTemplate":           project.SubjectTemplate,
+		"teamSlugs":                 teamSlugs,
+	})
+	if defaultKey.ID != "" {
+		// Keys listed by GetClientKeys lack their rate limits.
+		key, err := k.sentryClient.GetClientKeyDetails(sentry.Organization{Slug: &organizationSlug}, sentry.Project{Slug: &slug}, defaultKey.ID)
+		if err != nil {
+			return nil, fmt.Errorf("could not GetClientKeyDetails %v for %v: %v", defaultKey.ID, slug, err)
+		}
+		if key.RateLimit != nil {
+			properties["defaultClientKeyRateLimitCount"] = resource.NewNumberProperty(float64(key.RateLimit.Count))
+			properties["defaultClientKeyRateLimitWindow"] = resource.NewNumberProperty(float64(key.RateLimit.Window))
+		}
+	}
+	if project.Team != nil {
+		properties["teamSlug"] = resource.NewStringProperty(*project.Team.Slug)
+	} else if len(teamSlugs) > 0 {
+		properties["teamSlug"] = resource.NewStringProperty(teamSlugs[0])
+	}
+	return properties, nil
 }
 
 func getDefaultClientKey(sentryClient sentryClientAPI, organizationSlug, slug string) (sentry.Key, error) {
@@ -305,3 +563,122 @@ func getDefaultClientKey(sentryClient sentryClientAPI, organizationSlug, slug st
 
 	return sentry.Key{}, nil
 }
+
+// updateDefaultClientKeyRateLimit changes the rate limit of the default client
+// key of a project, keeping the rest of the key as it is.
+func (k *sentryProvider) updateDefaultClientKeyRateLimit(organizationSlug, slug string, rateLimit *clientKeyRateLimit) error {
+	defaultKey, err := getDefaultClientKey(k.sentryClient, organizationSlug, slug)
+	if err != nil {
+		return fmt.Errorf("could not get default ClientKey for %v: %v", slug, err)
+	}
+	if defaultKey.ID == "" {
+		return fmt.Errorf("project %v has no default ClientKey to rate limit", slug)
+	}
+	org := sentry.Organization{Slug: &organizationSlug}
+	project := sentry.Project{Slug: &slug}
+	key, err := k.sentryClient.GetClientKeyDetails(org, project, defaultKey.ID)
+	if err != nil {
+		return fmt.Errorf("could not GetClientKeyDetails %v for %v: %v", defaultKey.ID, slug, err)
+	}
+	key.RateLimit = rateLimit
+	if _, err := k.sentryClient.UpdateClientKeyDetails(org, project, key); err != nil {
+		return fmt.Errorf("could not UpdateClientKeyDetails %v for %v: %v", defaultKey.ID, slug, err)
+	}
+	return nil
+}
+
+// projectDefaultClientKeyRateLimit returns the rate limit of the default
+// client key set in project inputs, or nil when it is not set.
+func projectDefaultClientKeyRateLimit(inputs resource.PropertyMap) *clientKeyRateLimit {
+	count, window := inputs["defaultClientKeyRateLimitCount"], inputs["defaultClientKeyRateLimitWindow"]
+	if count.IsNull() || window.IsNull() {
+		return nil
+	}
+	return &clientKeyRateLimit{
+		Window: int(window.NumberValue()),
+		Count:  int(count.NumberValue()),
+	}
+}
+
+// checkProjectTeams checks that the project is given teams either with
+// teamSlugs or with the deprecated teamSlug, but not both.
+func checkProjectTeams(failures *[]*rpc.CheckFailure, props resource.PropertyMap) {
+	teamSlug, teamSlugs := props["teamSlug"], props["teamSlugs"]
+	switch {
+	case !teamSlug.IsNull() && !teamSlugs.IsNull():
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "teamSlug",
+			Reason:   "teamSlug is deprecated and can't be used together with teamSlugs",
+		})
+	case teamSlug.IsNull() && (teamSlugs.IsNull() || (teamSlugs.IsArray() && len(teamSlugs.ArrayValue()) == 0)):
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "teamSlugs",
+			Reason:   "at least one team must have access to the project",
+		})
+	}
+}
+
+// projectTeamSlugs returns slugs of the teams with access to the project,
+// either from teamSlugs or from the deprecated teamSlug.
+func projectTeamSlugs(props resource.PropertyMap) []string {
+	if teamSlugs := props["teamSlugs"]; teamSlugs.IsArray() {
+		ret := make([]string, 0, len(teamSlugs.ArrayValue()))
+		for _, teamSlug := range teamSlugs.ArrayValue() {
+			ret = append(ret, teamSlug.StringValue())
+		}
+		return ret
+	}
+	if teamSlug := props["teamSlug"]; teamSlug.IsString() {
+		return []string{teamSlug.StringValue()}
+	}
+	return nil
+}
+
+// normalizeProjectTeams returns a copy of project properties with the
+// deprecated teamSlug folded into teamSlugs, so that switching from one to the
+// other, or reordering teams, is not reported as a change.
+func normalizeProjectTeams(props resource.PropertyMap) resource.PropertyMap {
+	ret := props.Copy()
+	delete(ret, "teamSlug")
+	if props["teamSlugs"].ContainsUnknowns() {
+		return ret
+	}
+	if props["teamSlugs"].IsNull() && props["teamSlug"].ContainsUnknowns() {
+		ret["teamSlugs"] = props["teamSlug"]
+		return ret
+	}
+	teamSlugs := projectTeamSlugs(props)
+	if teamSlugs == nil {
+		return ret
+	}
+	sorted := append([]string{}, teamSlugs...)
+	sort.Strings(sorted)
+	ret["teamSlugs"] = resource.NewPropertyValue(sorted)
+	return ret
+}
+
+// projectSettingsFromInputs returns the data scrubbing and security settings
+// set in project inputs, and whether any of them is set.
+func projectSettingsFromInputs(inputs resource.PropertyMap) (projectSettings, bool) {
+	settings := projectSettings{
+		AllowedDomains:       stringsPtrFromPropertyValue(inputs["allowedDomains"]),
+		DataScrubber:         boolPtrFromPropertyValue(inputs["dataScrubber"]),
+		DataScrubberDefaults: boolPtrFromPropertyValue(inputs["dataScrubberDefaults"]),
+		ResolveAge:           intPtrFromPropertyValue(inputs["resolveAge"]),
+		SafeFields:           stringsPtrFromPropertyValue(inputs["safeFields"]),
+		ScrapeJavaScript:     boolPtrFromPropertyValue(inputs["scrapeJavaScript"]),
+		ScrubIPAddresses:     boolPtrFromPropertyValue(inputs["scrubIPAddresses"]),
+		SecurityToken:        stringPtrFromPropertyValue(inputs["securityToken"]),
+		SensitiveFields:      stringsPtrFromPropertyValue(inputs["sensitiveFields"]),
+	}
+	return settings, settings != projectSettings{}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -22,29 +22,106 @@ func TestProjectCheck(t *testing.T) {
 				{Property: "name", Reason: "this input must be a non-empty string"},
 				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
 				{Property: "slug", Reason: "this input must be a non-empty string"},
-				{Property: "teamSlug", Reason: "this input must be a non-empty string"},
+				{Property: "teamSlugs", Reason: "at least one team must have access to the project"},
 			},
 		},
 		"wrong type": {
 			news: resource.PropertyMap{
-				"defaultEnvironment": resource.NewPropertyValue(1),
-				"name":               resource.NewPropertyValue(1),
-				"organizationSlug":   resource.NewPropertyValue(1),
-				"slug":               resource.NewPropertyValue(1),
-				"subjectPrefix":      resource.NewPropertyValue(1),
-				"subjectTemplate":    resource.NewPropertyValue(1),
-				"teamSlug":           resource.NewPropertyValue(1),
+				"allowedDomains":       resource.NewPropertyValue("*"),
+				"dataScrubber":         resource.NewPropertyValue("yes"),
+				"dataScrubberDefaults": resource.NewPropertyValue(1),
+				"defaultEnvironment":   resource.NewPropertyValue(1),
+				"name":                 resource.NewPropertyValue(1),
+				"organizationSlug":     resource.NewPropertyValue(1),
+				"resolveAge":           resource.NewPropertyValue(-1),
+				"safeFields":           resource.NewPropertyValue([]interface{}{""}),
+				"scrapeJavaScript":     resource.NewPropertyValue("no"),
+				"scrubIPAddresses":     resource.NewPropertyValue(0),
+				"securityToken":        resource.NewPropertyValue(1),
+				"sensitiveFields":      resource.NewPropertyValue([]interface{}{1}),
+				"slug":                 resource.NewPropertyValue(1),
+				"subjectPrefix":        resource.NewPropertyValue(1),
+				"subjectTemplate":      resource.NewPropertyValue(1),
+				"teamSlugs":            resource.NewPropertyValue([]interface{}{1}),
 			},
 			wantFailures: []*rpc.CheckFailure{
+				{Property: "allowedDomains", Reason: "this input must be a list of non-empty strings"},
+				{Property: "dataScrubber", Reason: "this input must be a boolean"},
+				{Property: "dataScrubberDefaults", Reason: "this input must be a boolean"},
 				{Property: "defaultEnvironment", Reason: "this input must be a string"},
 				{Property: "name", Reason: "this input must be a non-empty string"},
 				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "resolveAge", Reason: "this input must be a non-negative integer"},
+				{Property: "safeFields", Reason: "this input must be a list of non-empty strings"},
+				{Property: "scrapeJavaScript", Reason: "this input must be a boolean"},
+				{Property: "scrubIPAddresses", Reason: "this input must be a boolean"},
+				{Property: "securityToken", Reason: "this input must be a string"},
+				{Property: "sensitiveFields", Reason: "this input must be a list of non-empty strings"},
 				{Property: "slug", Reason: "this input must be a non-empty string"},
 				{Property: "subjectPrefix", Reason: "this input must be a string"},
 				{Property: "subjectTemplate", Reason: "this input must be a string"},
-				{Property: "teamSlug", Reason: "this input must be a non-empty string"},
+				{Property: "teamSlugs", Reason: "this input must be a list of non-empty strings"},
 			},
 		},
+		"wrong type of deprecated teamSlug": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"slug":             resource.NewPropertyValue("slug"),
+				"teamSlug":         resource.NewPropertyValue(1),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "teamSlug", Reason: "this input must be a string"},
+			},
+		},
+		"empty teamSlugs": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"slug":             resource.NewPropertyValue("slug"),
+				"teamSlugs":        resource.NewPropertyValue([]string{}),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "teamSlugs", Reason: "at least one team must have access to the project"},
+			},
+		},
+		"both teamSlug and teamSlugs": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"slug":             resource.NewPropertyValue("slug"),
+				"teamSlug":         resource.NewPropertyValue("team-slug"),
+				"teamSlugs":        resource.NewPropertyValue([]string{"team-slug"}),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "teamSlug", Reason: "teamSlug is deprecated and can't be used together with teamSlugs"},
+			},
+		},
+		"rate limit count without window": {
+			news: resource.PropertyMap{
+				"defaultClientKeyRateLimitCount": resource.NewPropertyValue(100),
+				"name":                           resource.NewPropertyValue("a name"),
+				"organizationSlug":               resource.NewPropertyValue("org-slug"),
+				"slug":                           resource.NewPropertyValue("slug"),
+				"teamSlugs":                      resource.NewPropertyValue([]string{"team-slug"}),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "defaultClientKeyRateLimitCount", Reason: "defaultClientKeyRateLimitCount and defaultClientKeyRateLimitWindow must be set together"},
+				{Property: "defaultClientKeyRateLimitWindow", Reason: "defaultClientKeyRateLimitCount and defaultClientKeyRateLimitWindow must be set together"},
+			},
+		},
+		"computed inputs": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.MakeComputed(resource.NewStringProperty("")),
+				"slug":             resource.NewPropertyValue("slug"),
+				"teamSlugs": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewStringProperty("team-slug"),
+					resource.MakeComputed(resource.NewStringProperty("")),
+				}),
+			},
+			wantFailures: nil,
+		},
 		// TODO: slug validation
 		//
 		// "non-slugs": {
@@ -62,6 +139,15 @@ func TestProjectCheck(t *testing.T) {
 		// 	},
 		// },
 		"correct minimal": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"slug":             resource.NewPropertyValue("slug"),
+				"teamSlugs":        resource.NewPropertyValue([]string{"team-slug"}),
+			},
+			wantFailures: nil,
+		},
+		"correct minimal with deprecated teamSlug": {
 			news: resource.PropertyMap{
 				"name":             resource.NewPropertyValue("a name"),
 				"organizationSlug": resource.NewPropertyValue("org-slug"),
@@ -78,7 +164,7 @@ func TestProjectCheck(t *testing.T) {
 				"slug":               resource.NewPropertyValue("slug"),
 				"subjectPrefix":      resource.NewPropertyValue("subject prefix"),
 				"subjectTemplate":    resource.NewPropertyValue("subject template"),
-				"teamSlug":           resource.NewPropertyValue("team-slug"),
+				"teamSlugs":          resource.NewPropertyValue([]string{"team-slug", "other-team-slug"}),
 			},
 			wantFailures: nil,
 		},
@@ -98,6 +184,49 @@ func TestProjectCheck(t *testing.T) {
 	}
 }
 
+func TestProjectCheckDefaultOrganization(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{defaultOrganization: "default-org"}
+	news := resource.PropertyMap{
+		"name":      resource.NewPropertyValue("a name"),
+		"slug":      resource.NewPropertyValue("slug"),
+		"teamSlugs": resource.NewPropertyValue([]string{"team-slug"}),
+	}
+
+	resp, err := prov.projectCheck(ctx, &rpc.CheckRequest{
+		Urn:  "urn:pulumi:fake::fake::fake::fake",
+		News: mustMarshalProperties(news),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Failures)
+	filled := mustUnmarshalProperties(resp.GetInputs())
+	assert.Equal(t, filled["organizationSlug"], resource.NewPropertyValue("default-org"))
+
+	resp, err = prov.projectCheck(ctx, &rpc.CheckRequest{
+		Urn: "urn:pulumi:fake::fake::fake::fake",
+		News: mustMarshalProperties(propertyMapWithOverrides(news, resource.PropertyMap{
+			"organizationSlug": resource.NewPropertyValue("default-org"),
+		})),
+	})
+	assert.Nil(t, err)
+	explicit := mustUnmarshalProperties(resp.GetInputs())
+
+	// Switching between the default and the same explicit organization is
+	// not a change.
+	diff, err := prov.projectDiff(filled, explicit)
+	assert.Nil(t, err)
+	assert.Equal(t, *diff, rpc.DiffResponse{})
+
+	resp, err = prov.projectCheck(ctx, &rpc.CheckRequest{
+		Urn: "urn:pulumi:fake::fake::fake::fake",
+		News: mustMarshalProperties(propertyMapWithOverrides(news, resource.PropertyMap{
+			"organizationSlug": resource.NewPropertyValue("other-org"),
+		})),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs())["organizationSlug"], resource.NewPropertyValue("other-org"))
+}
+
 func TestProjectDiff(t *testing.T) {
 	baseOlds := resource.PropertyMap{
 		"defaultEnvironment": resource.NewPropertyValue("base env name"),
@@ -122,28 +251,94 @@ func TestProjectDiff(t *testing.T) {
 			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
 				"defaultEnvironment": resource.NewPropertyValue("new env name"),
 				"name":               resource.NewPropertyValue("new name"),
+				"slug":               resource.NewPropertyValue("new-slug"),
 				"subjectPrefix":      resource.NewPropertyValue("new subject prefix"),
 				"subjectTemplate":    resource.NewPropertyValue("new subject template"),
 			}),
 			wantResponse: rpc.DiffResponse{
 				Changes: rpc.DiffResponse_DIFF_SOME,
-				Diffs:   []string{"defaultEnvironment", "name", "subjectPrefix", "subjectTemplate"},
+				Diffs:   []string{"defaultEnvironment", "name", "slug", "subjectPrefix", "subjectTemplate"},
+			},
+		},
+		"settings left out": {
+			olds: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"dataScrubber":    resource.NewPropertyValue(true),
+				"resolveAge":      resource.NewPropertyValue(0),
+				"sensitiveFields": resource.NewPropertyValue([]string{"card_number"}),
+			}),
+			news:         baseOlds,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"settings updates": {
+			olds: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"dataScrubber":    resource.NewPropertyValue(true),
+				"resolveAge":      resource.NewPropertyValue(0),
+				"sensitiveFields": resource.NewPropertyValue([]string{"card_number"}),
+			}),
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"allowedDomains":  resource.NewPropertyValue([]string{"example.com"}),
+				"dataScrubber":    resource.NewPropertyValue(true),
+				"resolveAge":      resource.NewPropertyValue(720),
+				"sensitiveFields": resource.NewPropertyValue([]string{"card_number", "ssn"}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"allowedDomains", "resolveAge", "sensitiveFields"},
 			},
 		},
 		"replacement": {
 			olds: baseOlds,
 			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
 				"organizationSlug": resource.NewPropertyValue("new-org-slug"),
-				"slug":             resource.NewPropertyValue("new-slug"),
-				"teamSlug":         resource.NewPropertyValue("new-team-slug"),
 			}),
 			wantResponse: rpc.DiffResponse{
 				Changes:             rpc.DiffResponse_DIFF_SOME,
-				Diffs:               []string{"organizationSlug", "slug", "teamSlug"},
-				Replaces:            []string{"organizationSlug", "slug", "teamSlug"},
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
 				DeleteBeforeReplace: true,
 			},
 		},
+		"team change with deprecated teamSlug": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"teamSlug": resource.NewPropertyValue("new-team-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"teamSlugs"},
+			},
+		},
+		"switch from teamSlug to teamSlugs": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"teamSlug":  resource.NewNullProperty(),
+				"teamSlugs": resource.NewPropertyValue([]string{"base-team-slug"}),
+			}),
+			wantResponse: rpc.DiffResponse{},
+		},
+		"teams reordered": {
+			olds: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"teamSlugs": resource.NewPropertyValue([]string{"team-a", "team-b"}),
+			}),
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"teamSlug":  resource.NewNullProperty(),
+				"teamSlugs": resource.NewPropertyValue([]string{"team-b", "team-a"}),
+			}),
+			wantResponse: rpc.DiffResponse{},
+		},
+		"teams added": {
+			olds: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"teamSlugs": resource.NewPropertyValue([]string{"base-team-slug"}),
+			}),
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"teamSlug":  resource.NewNullProperty(),
+				"teamSlugs": resource.NewPropertyValue([]string{"base-team-slug", "new-team-slug"}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"teamSlugs"},
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -159,6 +354,7 @@ func TestProjectCreate(t *testing.T) {
 	ctx := context.Background()
 	createCalled := false
 	updateCalled := false
+	var addedTeams []string
 	prov := sentryProvider{
 		sentryClient: &sentryClientMock{
 			createProject: func(org sentry.Organization, team sentry.Team, name string, slug *string) (sentry.Project, error) {
@@ -168,10 +364,18 @@ func TestProjectCreate(t *testing.T) {
 				assert.Equal(t, *slug, "slug")
 				createCalled = true
 				return sentry.Project{
+					ID:   "42",
 					Name: "name-from-create",
 					Slug: stringPtr("slug-from-create"),
 				}, nil
 			},
+			addProjectTeam: func(org sentry.Organization, proj sentry.Project, teamSlug string) error {
+				assert.True(t, createCalled)
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, *proj.Slug, "slug-from-create")
+				addedTeams = append(addedTeams, teamSlug)
+				return nil
+			},
 			updateProject: func(org sentry.Organization, proj sentry.Project) error {
 				assert.True(t, createCalled)
 				assert.Equal(t, *proj.DefaultEnvironment, "env name")
@@ -195,18 +399,20 @@ func TestProjectCreate(t *testing.T) {
 		"slug":               resource.NewPropertyValue("slug"),
 		"subjectPrefix":      resource.NewPropertyValue("subject prefix"),
 		"subjectTemplate":    resource.NewPropertyValue("subject template"),
-		"teamSlug":           resource.NewPropertyValue("the-team"),
+		"teamSlugs":          resource.NewPropertyValue([]string{"the-team", "other-team", "third-team"}),
 	}
 	resp, err := prov.projectCreate(ctx, &rpc.CreateRequest{}, inputs)
 	assert.Nil(t, err)
 	assert.True(t, updateCalled)
-	assert.Equal(t, resp.GetId(), "the-org/slug-from-create")
+	assert.Equal(t, addedTeams, []string{"other-team", "third-team"})
+	assert.Equal(t, resp.GetId(), "the-org/42")
 	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
 		"defaultEnvironment":        resource.NewPropertyValue("env name"),
 		"name":                      resource.NewPropertyValue("name-from-create"),
 		"organizationSlug":          resource.NewPropertyValue("the-org"),
 		"slug":                      resource.NewPropertyValue("slug-from-create"),
 		"teamSlug":                  resource.NewPropertyValue("the-team"),
+		"teamSlugs":                 resource.NewPropertyValue([]string{"the-team", "other-team", "third-team"}),
 		"subjectPrefix":             resource.NewPropertyValue("subject prefix"),
 		"subjectTemplate":           resource.NewPropertyValue("subject template"),
 		"defaultClientKeyDSNPublic": resource.NewPropertyValue("public-dsn"),
@@ -226,6 +432,7 @@ func TestProjectRead(t *testing.T) {
 				assert.Equal(t, *org.Slug, "org-slug")
 				assert.Equal(t, projslug, "proj-slug")
 				return sentry.Project{
+					ID:                 "42",
 					DefaultEnvironment: stringPtr("default-env-from-read"),
 					Name:               "name-from-read",
 					Slug:               stringPtr("slug-from-read"),
@@ -236,20 +443,191 @@ func TestProjectRead(t *testing.T) {
 					},
 				}, nil
 			},
+			getProjectTeams: func(org sentry.Organization, proj sentry.Project) ([]sentry.Team, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "slug-from-read")
+				return []sentry.Team{
+					{Slug: stringPtr("team-slug-from-read")},
+					{Slug: stringPtr("other-team-slug-from-read")},
+				}, nil
+			},
+			getProjectSettings: func(org sentry.Organization, proj sentry.Project) (projectSettings, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "slug-from-read")
+				return projectSettings{
+					AllowedDomains:       &[]string{"*"},
+					DataScrubber:         boolPtr(true),
+					DataScrubberDefaults: boolPtr(true),
+					SensitiveFields:      &[]string{"card_number"},
+					SafeFields:           &[]string{},
+					ScrubIPAddresses:     boolPtr(false),
+					ScrapeJavaScript:     boolPtr(true),
+					SecurityToken:        stringPtr("security-token-from-read"),
+					ResolveAge:           intPtr(0),
+				}, nil
+			},
 		},
 	}
+	// Reading a project with a legacy <orgSlug>/<slug> ID migrates it.
 	resp, err := prov.projectRead(ctx, &rpc.ReadRequest{Id: "org-slug/proj-slug"})
 	assert.Nil(t, err)
-	assert.Equal(t, resp.GetId(), "org-slug/slug-from-read")
+	assert.Equal(t, resp.GetId(), "org-slug/42")
 	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"allowedDomains":            resource.NewPropertyValue([]string{"*"}),
+		"dataScrubber":              resource.NewPropertyValue(true),
+		"dataScrubberDefaults":      resource.NewPropertyValue(true),
 		"defaultEnvironment":        resource.NewPropertyValue("default-env-from-read"),
 		"defaultClientKeyDSNPublic": resource.NewPropertyValue("public-dsn"),
 		"name":                      resource.NewPropertyValue("name-from-read"),
 		"organizationSlug":          resource.NewPropertyValue("org-slug"),
+		"resolveAge":                resource.NewPropertyValue(0),
+		"safeFields":                emptyList,
+		"scrapeJavaScript":          resource.NewPropertyValue(true),
+		"scrubIPAddresses":          resource.NewPropertyValue(false),
+		"securityToken":             resource.NewPropertyValue("security-token-from-read"),
+		"sensitiveFields":           resource.NewPropertyValue([]string{"card_number"}),
 		"slug":                      resource.NewPropertyValue("slug-from-read"),
 		"subjectPrefix":             resource.NewPropertyValue("subject-prefix-from-read"),
 		"subjectTemplate":           resource.NewPropertyValue("subject-template-from-read"),
 		"teamSlug":                  resource.NewPropertyValue("team-slug-from-read"),
+		"teamSlugs":                 resource.NewPropertyValue([]string{"team-slug-from-read", "other-team-slug-from-read"}),
+	})
+}
+
+func TestProjectImport(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getClientKeys: func(o sentry.Organization, p sentry.Project) ([]sentry.Key, error) {
+				return []sentry.Key{
+					{Label: "Default", DSN: sentry.DSN{Public: "public-dsn"}},
+				}, nil
+			},
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, projslug, "proj-slug")
+				return sentry.Project{
+					ID:              "42",
+					Name:            "Project",
+					Slug:            stringPtr("proj-slug"),
+					SubjectPrefix:   stringPtr(""),
+					SubjectTemplate: stringPtr("$shortID - $title"),
+					Team:            &sentry.Team{Slug: stringPtr("team-b")},
+				}, nil
+			},
+			getProjectTeams: func(org sentry.Organization, proj sentry.Project) ([]sentry.Team, error) {
+				return []sentry.Team{
+					{Slug: stringPtr("team-b")},
+					{Slug: stringPtr("team-a")},
+				}, nil
+			},
+			getProjectSettings: func(org sentry.Organization, proj sentry.Project) (projectSettings, error) {
+				// What Sentry has for new projects.
+				return projectSettings{
+					AllowedDomains:       &[]string{"*"},
+					DataScrubber:         boolPtr(true),
+					DataScrubberDefaults: boolPtr(true),
+					SensitiveFields:      &[]string{},
+					SafeFields:           &[]string{},
+					ScrubIPAddresses:     boolPtr(false),
+					ScrapeJavaScript:     boolPtr(true),
+					SecurityToken:        stringPtr("security-token"),
+					ResolveAge:           intPtr(0),
+				}, nil
+			},
+		},
+	}
+
+	// `pulumi import` reads the resource by the ID given on the command line,
+	// without any state.
+	resp, err := prov.projectRead(ctx, &rpc.ReadRequest{Id: "org-slug/proj-slug"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42")
+	inputs := mustUnmarshalProperties(resp.GetInputs())
+	assert.Equal(t, inputs, resource.PropertyMap{
+		"allowedDomains":       resource.NewPropertyValue([]string{"*"}),
+		"dataScrubber":         resource.NewPropertyValue(true),
+		"dataScrubberDefaults": resource.NewPropertyValue(true),
+		"name":                 resource.NewPropertyValue("Project"),
+		"organizationSlug":     resource.NewPropertyValue("org-slug"),
+		"resolveAge":           resource.NewPropertyValue(0),
+		"safeFields":           emptyList,
+		"scrapeJavaScript":     resource.NewPropertyValue(true),
+		"scrubIPAddresses":     resource.NewPropertyValue(false),
+		"securityToken":        resource.NewPropertyValue("security-token"),
+		"sensitiveFields":      emptyList,
+		"slug":                 resource.NewPropertyValue("proj-slug"),
+		"subjectTemplate":      resource.NewPropertyValue("$shortID - $title"),
+		"teamSlugs":            resource.NewPropertyValue([]string{"team-b", "team-a"}),
+	})
+
+	// The program declaring the imported project must not see any diff, even
+	// though it leaves the data scrubbing and security settings out.
+	checked, err := prov.projectCheck(ctx, &rpc.CheckRequest{
+		Urn: "urn:pulumi:fake::fake::fake::fake",
+		News: mustMarshalProperties(resource.PropertyMap{
+			"name":             resource.NewPropertyValue("Project"),
+			"organizationSlug": resource.NewPropertyValue("org-slug"),
+			"slug":             resource.NewPropertyValue("proj-slug"),
+			"subjectTemplate":  resource.NewPropertyValue("$shortID - $title"),
+			"teamSlugs":        resource.NewPropertyValue([]string{"team-a", "team-b"}),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, checked.GetFailures())
+	diff, err := prov.projectDiff(inputs, mustUnmarshalProperties(checked.GetInputs()))
+	assert.Nil(t, err)
+	assert.Equal(t, *diff, rpc.DiffResponse{})
+
+	// Later updates diff against the state, which has the outputs too and
+	// Sentry's empty subjectPrefix.
+	state := mustUnmarshalProperties(resp.GetProperties())
+	assert.Equal(t, state["subjectPrefix"], resource.NewPropertyValue(""))
+	diff, err = prov.projectDiff(state, mustUnmarshalProperties(checked.GetInputs()))
+	assert.Nil(t, err)
+	assert.Equal(t, diff.GetChanges(), rpc.DiffResponse_DIFF_NONE)
+	assert.Nil(t, diff.GetDiffs())
+}
+
+func TestProjectReadDefaultClientKeyRateLimit(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getClientKeys: func(o sentry.Organization, p sentry.Project) ([]sentry.Key, error) {
+				return []sentry.Key{
+					{ID: "default-key", Label: "Default", DSN: sentry.DSN{Public: "public-dsn"}},
+				}, nil
+			},
+			getClientKeyDetails: func(o sentry.Organization, p sentry.Project, id string) (clientKey, error) {
+				assert.Equal(t, id, "default-key")
+				return clientKey{ID: id, Name: "Default", IsActive: true, RateLimit: &clientKeyRateLimit{Window: 3600, Count: 500}}, nil
+			},
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				return sentry.Project{ID: "42", Name: "a name", Slug: stringPtr(projslug)}, nil
+			},
+			getProjectTeams: func(org sentry.Organization, proj sentry.Project) ([]sentry.Team, error) {
+				return []sentry.Team{{Slug: stringPtr("the-team")}}, nil
+			},
+			getProjectSettings: func(org sentry.Organization, proj sentry.Project) (projectSettings, error) {
+				return projectSettings{}, nil
+			},
+		},
+	}
+	resp, err := prov.projectRead(ctx, &rpc.ReadRequest{
+		Id: "org-slug/42",
+		Properties: mustMarshalProperties(resource.PropertyMap{
+			"slug": resource.NewPropertyValue("proj-slug"),
+		}),
+	})
+	assert.Nil(t, err)
+	// The rate limit changed outside of Pulumi shows up as a difference.
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), resource.PropertyMap{
+		"defaultClientKeyRateLimitCount":  resource.NewPropertyValue(500),
+		"defaultClientKeyRateLimitWindow": resource.NewPropertyValue(3600),
+		"name":                            resource.NewPropertyValue("a name"),
+		"organizationSlug":                resource.NewPropertyValue("org-slug"),
+		"slug":                            resource.NewPropertyValue("proj-slug"),
+		"teamSlugs":                       resource.NewPropertyValue([]string{"the-team"}),
 	})
 }
 
@@ -268,11 +646,82 @@ func TestProjectRead404(t *testing.T) {
 	assert.Nil(t, resp.GetProperties())
 }
 
+func TestProjectReadRenamed(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getClientKeys: func(o sentry.Organization, p sentry.Project) ([]sentry.Key, error) {
+				return []sentry.Key{}, nil
+			},
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				calls = append(calls, "get "+projslug)
+				if projslug == "old-slug" {
+					return sentry.Project{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+				}
+				return sentry.Project{ID: "42", Name: "a name", Slug: stringPtr(projslug)}, nil
+			},
+			getProjectByID: func(org sentry.Organization, id string) (sentry.Project, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				calls = append(calls, "get by ID "+id)
+				return sentry.Project{ID: id, Slug: stringPtr("new-slug")}, nil
+			},
+			getProjectTeams: func(org sentry.Organization, proj sentry.Project) ([]sentry.Team, error) {
+				return []sentry.Team{{Slug: stringPtr("the-team")}}, nil
+			},
+			getProjectSettings: func(org sentry.Organization, proj sentry.Project) (projectSettings, error) {
+				return projectSettings{}, nil
+			},
+		},
+	}
+	resp, err := prov.projectRead(ctx, &rpc.ReadRequest{
+		Id: "org-slug/42",
+		Properties: mustMarshalProperties(resource.PropertyMap{
+			"slug": resource.NewPropertyValue("old-slug"),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calls, []string{"get old-slug", "get by ID 42", "get new-slug"})
+	assert.Equal(t, resp.GetId(), "org-slug/42")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties())["slug"], resource.NewPropertyValue("new-slug"))
+}
+
+func TestProjectReadSlugReused(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				// Another project took over the slug of the one we manage.
+				return sentry.Project{ID: "43", Slug: stringPtr(projslug)}, nil
+			},
+			getProjectByID: func(org sentry.Organization, id string) (sentry.Project, error) {
+				assert.Equal(t, id, "42")
+				return sentry.Project{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.projectRead(ctx, &rpc.ReadRequest{
+		Id: "org-slug/42",
+		Properties: mustMarshalProperties(resource.PropertyMap{
+			"slug": resource.NewPropertyValue("proj-slug"),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
 func TestProjectDelete(t *testing.T) {
 	ctx := context.Background()
 	deleteCalled := false
 	prov := sentryProvider{
 		sentryClient: &sentryClientMock{
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, projslug, "the-proj")
+				return sentry.Project{ID: "42", Slug: stringPtr("the-proj")}, nil
+			},
 			deleteProject: func(org sentry.Organization, proj sentry.Project) error {
 				assert.Equal(t, *org.Slug, "the-org")
 				assert.Equal(t, *proj.Slug, "the-proj")
@@ -281,7 +730,12 @@ func TestProjectDelete(t *testing.T) {
 			},
 		},
 	}
-	_, err := prov.projectDelete(ctx, &rpc.DeleteRequest{Id: "the-org/the-proj"})
+	_, err := prov.projectDelete(ctx, &rpc.DeleteRequest{
+		Id: "the-org/42",
+		Properties: mustMarshalProperties(resource.PropertyMap{
+			"slug": resource.NewPropertyValue("the-proj"),
+		}),
+	})
 	assert.Nil(t, err)
 	assert.True(t, deleteCalled)
 }
@@ -291,6 +745,11 @@ func TestProjectUpdate(t *testing.T) {
 	updateCalled := false
 	prov := sentryProvider{
 		sentryClient: &sentryClientMock{
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, projslug, "proj-slug")
+				return sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}, nil
+			},
 			updateProject: func(org sentry.Organization, proj sentry.Project) error {
 				assert.Equal(t, *org.Slug, "org-slug")
 				assert.Equal(t, *proj.DefaultEnvironment, "new env name")
@@ -320,11 +779,274 @@ func TestProjectUpdate(t *testing.T) {
 		"subjectTemplate":    resource.NewPropertyValue("new subject template"),
 	})
 	resp, err := prov.projectUpdate(ctx, &rpc.UpdateRequest{
-		Id:   "org-slug/proj-slug",
+		Id:   "org-slug/42",
 		News: mustMarshalProperties(news),
 		Olds: mustMarshalProperties(olds),
 	})
 	assert.Nil(t, err)
 	assert.True(t, updateCalled)
-	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(news, resource.PropertyMap{
+		"teamSlugs": resource.NewPropertyValue([]string{"the-team"}),
+	}))
+}
+
+func TestProjectUpdateSettings(t *testing.T) {
+	ctx := context.Background()
+	var settingsUpdated projectSettings
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				return sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}, nil
+			},
+			updateProject: func(org sentry.Organization, proj sentry.Project) error {
+				return nil
+			},
+			updateProjectSettings: func(org sentry.Organization, proj sentry.Project, settings projectSettings) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				settingsUpdated = settings
+				return nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"dataScrubber":     resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"securityToken":    resource.NewPropertyValue("security-token"),
+		"slug":             resource.NewPropertyValue("proj-slug"),
+		"teamSlugs":        resource.NewPropertyValue([]string{"the-team"}),
+	}
+	news := resource.PropertyMap{
+		"dataScrubber":     resource.NewPropertyValue(true),
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"safeFields":       resource.NewPropertyValue([]string{"order_id"}),
+		"scrubIPAddresses": resource.NewPropertyValue(true),
+		"slug":             resource.NewPropertyValue("proj-slug"),
+		"teamSlugs":        resource.NewPropertyValue([]string{"the-team"}),
+	}
+	resp, err := prov.projectUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	// The security token left out is not changed.
+	assert.Equal(t, settingsUpdated, projectSettings{
+		DataScrubber:     boolPtr(true),
+		SafeFields:       &[]string{"order_id"},
+		ScrubIPAddresses: boolPtr(true),
+	})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(news, resource.PropertyMap{
+		"teamSlug": resource.NewPropertyValue("the-team"),
+	}))
+}
+
+func TestProjectUpdateDefaultClientKeyRateLimit(t *testing.T) {
+	ctx := context.Background()
+	var keyUpdated clientKey
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				return sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}, nil
+			},
+			updateProject: func(org sentry.Organization, proj sentry.Project) error {
+				return nil
+			},
+			getClientKeys: func(o sentry.Organization, p sentry.Project) ([]sentry.Key, error) {
+				return []sentry.Key{
+					{ID: "other-key", Label: "Other"},
+					{ID: "default-key", Label: "Default"},
+				}, nil
+			},
+			getClientKeyDetails: func(o sentry.Organization, p sentry.Project, id string) (clientKey, error) {
+				assert.Equal(t, *o.Slug, "org-slug")
+				assert.Equal(t, *p.Slug, "proj-slug")
+				assert.Equal(t, id, "default-key")
+				return clientKey{ID: id, Name: "Default", IsActive: true}, nil
+			},
+			updateClientKeyDetails: func(o sentry.Organization, p sentry.Project, k clientKey) (clientKey, error) {
+				keyUpdated = k
+				return k, nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"defaultClientKeyDSNPublic": resource.NewPropertyValue("public-dsn"),
+		"name":                      resource.NewPropertyValue("a name"),
+		"organizationSlug":          resource.NewPropertyValue("org-slug"),
+		"slug":                      resource.NewPropertyValue("proj-slug"),
+		"teamSlugs":                 resource.NewPropertyValue([]string{"the-team"}),
+	}
+	news := resource.PropertyMap{
+		"defaultClientKeyRateLimitCount":  resource.NewPropertyValue(1000),
+		"defaultClientKeyRateLimitWindow": resource.NewPropertyValue(60),
+		"name":                            resource.NewPropertyValue("a name"),
+		"organizationSlug":                resource.NewPropertyValue("org-slug"),
+		"slug":                            resource.NewPropertyValue("proj-slug"),
+		"teamSlugs":                       resource.NewPropertyValue([]string{"the-team"}),
+	}
+	resp, err := prov.projectUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	// The name and active flag of the key are kept.
+	assert.Equal(t, keyUpdated, clientKey{
+		ID:        "default-key",
+		Name:      "Default",
+		IsActive:  true,
+		RateLimit: &clientKeyRateLimit{Window: 60, Count: 1000},
+	})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(news, resource.PropertyMap{
+		"defaultClientKeyDSNPublic": resource.NewPropertyValue("public-dsn"),
+		"teamSlug":                  resource.NewPropertyValue("the-team"),
+	}))
+}
+
+func TestProjectUpdateTeams(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, projslug, "proj-slug")
+				return sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}, nil
+			},
+			addProjectTeam: func(org sentry.Organization, proj sentry.Project, teamSlug string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				calls = append(calls, "add "+teamSlug)
+				return nil
+			},
+			removeProjectTeam: func(org sentry.Organization, proj sentry.Project, teamSlug string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				calls = append(calls, "remove "+teamSlug)
+				return nil
+			},
+			updateProject: func(org sentry.Organization, proj sentry.Project) error {
+				calls = append(calls, "update")
+				return nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"defaultClientKeyDSNPublic": resource.NewPropertyValue("public-dsn"),
+		"name":                      resource.NewPropertyValue("a name"),
+		"organizationSlug":          resource.NewPropertyValue("org-slug"),
+		"slug":                      resource.NewPropertyValue("proj-slug"),
+		"teamSlug":                  resource.NewPropertyValue("old-team"),
+		"teamSlugs":                 resource.NewPropertyValue([]string{"old-team", "kept-team"}),
+	}
+	news := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"slug":             resource.NewPropertyValue("proj-slug"),
+		"teamSlugs":        resource.NewPropertyValue([]string{"kept-team", "new-team"}),
+	}
+	resp, err := prov.projectUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calls, []string{"add new-team", "remove old-team", "update"})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"defaultClientKeyDSNPublic": resource.NewPropertyValue("public-dsn"),
+		"name":                      resource.NewPropertyValue("a name"),
+		"organizationSlug":          resource.NewPropertyValue("org-slug"),
+		"slug":                      resource.NewPropertyValue("proj-slug"),
+		"teamSlug":                  resource.NewPropertyValue("kept-team"),
+		"teamSlugs":                 resource.NewPropertyValue([]string{"kept-team", "new-team"}),
+	})
+}
+
+func TestProjectUpdateSlug(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getProject: func(org sentry.Organization, projslug string) (sentry.Project, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, projslug, "proj-slug")
+				return sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}, nil
+			},
+			renameProject: func(org sentry.Organization, proj sentry.Project, newSlug string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				calls = append(calls, "rename "+newSlug)
+				return nil
+			},
+			updateProject: func(org sentry.Organization, proj sentry.Project) error {
+				calls = append(calls, "update "+*proj.Slug)
+				return nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"slug":             resource.NewPropertyValue("proj-slug"),
+		"teamSlugs":        resource.NewPropertyValue([]string{"the-team"}),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"slug": resource.NewPropertyValue("new-slug"),
+	})
+	resp, err := prov.projectUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, calls, []string{"rename new-slug", "update new-slug"})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties())["slug"], resource.NewPropertyValue("new-slug"))
+}
+
+// withProjects mocks the lookups of the given projects, by slug and by ID,
+// for tests of resources belonging to projects.
+func withProjects(m *sentryClientMock, projects ...sentry.Project) *sentryClientMock {
+	m.getProject = func(org sentry.Organization, projslug string) (sentry.Project, error) {
+		for _, project := range projects {
+			if *project.Slug == projslug {
+				return project, nil
+			}
+		}
+		return sentry.Project{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+	}
+	m.getProjectByID = func(org sentry.Organization, id string) (sentry.Project, error) {
+		for _, project := range projects {
+			if project.ID == id {
+				return project, nil
+			}
+		}
+		return sentry.Project{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+	}
+	return m
+}
+
+func TestParseUpdatedProjectResourceID(t *testing.T) {
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{},
+			sentry.Project{ID: "42", Slug: stringPtr("renamed-proj")},
+			sentry.Project{ID: "43", Slug: stringPtr("other-proj")},
+		),
+	}
+
+	organizationSlug, project, parts, err := prov.parseUpdatedProjectResourceID("org-slug/42/key-id", resource.PropertyMap{
+		"projectSlug": resource.NewPropertyValue("renamed-proj"),
+	}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, organizationSlug, "org-slug")
+	assert.Equal(t, project.ID, "42")
+	assert.Equal(t, parts, []string{"key-id"})
+
+	_, _, _, err = prov.parseUpdatedProjectResourceID("org-slug/42/key-id", resource.PropertyMap{
+		"projectSlug": resource.NewPropertyValue("other-proj"),
+	}, 1)
+	assert.NotNil(t, err)
+	assert.Equal(t, err.Error(), "org-slug/42/key-id belongs to project renamed-proj and can't be moved to project other-proj, replace it instead")
 }
@@ -16,9 +16,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
 
-	"github.com/marcin-ro/go-sentry-api"
 	"github.com/pulumi/pulumi/pkg/v2/resource/provider"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
@@ -32,22 +34,55 @@ type sentryProvider struct {
 	host    *provider.HostClient
 	name    string
 	version string
+	schema  []byte
 
 	sentryClient sentryClientAPI
+	// defaultOrganization is the sentry:organization config, used for
+	// resources that don't set their organizationSlug.
+	defaultOrganization string
 }
 
-func makeProvider(host *provider.HostClient, name, version string) (rpc.ResourceProviderServer, error) {
+func makeProvider(host *provider.HostClient, name, version string, schema []byte) (rpc.ResourceProviderServer, error) {
 	// Return the new provider
 	return &sentryProvider{
 		host:    host,
 		name:    name,
 		version: version,
+		schema:  schema,
 	}, nil
 }
 
 // CheckConfig validates the configuration for this provider.
 func (k *sentryProvider) CheckConfig(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
-	return &rpc.CheckResponse{Inputs: req.GetNews()}, nil
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.CheckConfig(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	// Secrets are unwrapped here, the token is usually one.
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed CheckConfig because of malformed configuration: %w", err)
+	}
+
+	// Environment variables are only consulted here, so that their values
+	// don't end up in the provider's inputs saved in the stack state.
+	if news["token"].IsNull() && os.Getenv(authTokenEnvVar) != "" {
+		news["token"] = resource.NewStringProperty(os.Getenv(authTokenEnvVar))
+	}
+	if news["apiURL"].IsNull() && os.Getenv(urlEnvVar) != "" {
+		news["apiURL"] = resource.NewStringProperty(apiURLFromSentryURL(os.Getenv(urlEnvVar)))
+	}
+
+	var failures []*rpc.CheckFailure
+	checkNonEmptyString(&failures, news, "token")
+	checkHTTPURL(&failures, news, "apiURL")
+
+	return &rpc.CheckResponse{Inputs: req.GetNews(), Failures: failures}, nil
 }
 
 // DiffConfig diffs the configuration for this provider.
@@ -70,11 +105,10 @@ func (k *sentryProvider) DiffConfig(ctx context.Context, req *rpc.DiffRequest) (
 	}
 
 	var diffs []string
-	if olds["sentryToken"] != news["sentryToken"] {
-		diffs = append(diffs, "sentryToken")
-	}
-	if olds["sentryApiURL"] != news["sentryApiURL"] {
-		diffs = append(diffs, "sentryApiURL")
+	for _, key := range []resource.PropertyKey{"apiURL", "organization", "token"} {
+		if !olds[key].DeepEquals(news[key]) {
+			diffs = append(diffs, string(key))
+		}
 	}
 	if len(diffs) > 0 {
 		return &rpc.DiffResponse{
@@ -91,19 +125,46 @@ func (k *sentryProvider) Configure(ctx context.Context, req *rpc.ConfigureReques
 	vars := req.GetVariables()
 	logger.V(9).Infof("vars %v", vars)
 
-	apiURL := vars["sentry:config:apiURL"]
-	var err error
-	k.sentryClient, err = sentry.NewClient(vars["sentry:config:token"], &apiURL, nil)
+	apiURL := configOrEnv(vars["sentry:config:apiURL"], apiURLFromSentryURL(os.Getenv(urlEnvVar)))
+	token := configOrEnv(vars["sentry:config:token"], os.Getenv(authTokenEnvVar))
+	client, err := newAPIClient(token, &apiURL)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize a sentry API client: %v", err)
 	}
 
+	skipCredentialsValidation := false
+	if value, ok := vars["sentry:config:skipCredentialsValidation"]; ok {
+		skipCredentialsValidation, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("sentry:skipCredentialsValidation must be a boolean, got %q", value)
+		}
+	}
+	// During previews the configuration might depend on other resources.
+	configKnown := token != plugin.UnknownStringValue && apiURL != plugin.UnknownStringValue
+	if !skipCredentialsValidation && configKnown {
+		if err := client.VerifyCredentials(); err != nil {
+			return nil, err
+		}
+	}
+	k.sentryClient = client
+	k.defaultOrganization = configOrEnv(vars["sentry:config:organization"], os.Getenv(organizationEnvVar))
+
 	return &rpc.ConfigureResponse{}, nil
 }
 
 // Invoke dynamically executes a built-in function in the provider.
-func (k *sentryProvider) Invoke(_ context.Context, req *rpc.InvokeRequest) (*rpc.InvokeResponse, error) {
+func (k *sentryProvider) Invoke(ctx context.Context, req *rpc.InvokeRequest) (*rpc.InvokeResponse, error) {
 	tok := req.GetTok()
+	switch tok {
+	case "sentry:index:getOrganization":
+		return k.getOrganizationInvoke(ctx, req)
+	case "sentry:index:getTeam":
+		return k.getTeamInvoke(ctx, req)
+	case "sentry:index:getProject":
+		return k.getProjectInvoke(ctx, req)
+	case "sentry:index:getOrganizationIntegration":
+		return k.getOrganizationIntegrationInvoke(ctx, req)
+	}
 	return nil, fmt.Errorf("Unknown Invoke token '%s'", tok)
 }
 
@@ -126,6 +187,40 @@ func (k *sentryProvider) Check(ctx context.Context, req *rpc.CheckRequest) (*rpc
 	switch ty {
 	case "sentry:index:Project":
 		return k.projectCheck(ctx, req)
+	case "sentry:index:Team":
+		return k.teamCheck(ctx, req)
+	case "sentry:index:ClientKey":
+		return k.clientKeyCheck(ctx, req)
+	case "sentry:index:IssueAlertRule":
+		return k.issueAlertRuleCheck(ctx, req)
+	case "sentry:index:MetricAlertRule":
+		return k.metricAlertRuleCheck(ctx, req)
+	case "sentry:index:ProjectInboundFilters":
+		return k.projectInboundFiltersCheck(ctx, req)
+	case "sentry:index:ProjectOwnership":
+		return k.projectOwnershipCheck(ctx, req)
+	case "sentry:index:OrganizationMember":
+		return k.organizationMemberCheck(ctx, req)
+	case "sentry:index:TeamMember":
+		return k.teamMemberCheck(ctx, req)
+	case "sentry:index:ProjectEnvironment":
+		return k.projectEnvironmentCheck(ctx, req)
+	case "sentry:index:Release":
+		return k.releaseCheck(ctx, req)
+	case "sentry:index:Deploy":
+		return k.deployCheck(ctx, req)
+	case "sentry:index:Monitor":
+		return k.monitorCheck(ctx, req)
+	case "sentry:index:ServiceHook":
+		return k.serviceHookCheck(ctx, req)
+	case "sentry:index:OrganizationSettings":
+		return k.organizationSettingsCheck(ctx, req)
+	case "sentry:index:SavedSearch":
+		return k.savedSearchCheck(ctx, req)
+	case "sentry:index:Dashboard":
+		return k.dashboardCheck(ctx, req)
+	case "sentry:index:OrganizationRepository":
+		return k.organizationRepositoryCheck(ctx, req)
 	}
 	return nil, fmt.Errorf("Unknown resource type '%s'", ty)
 }
@@ -148,6 +243,40 @@ func (k *sentryProvider) Diff(ctx context.Context, req *rpc.DiffRequest) (*rpc.D
 	switch ty {
 	case "sentry:index:Project":
 		return k.projectDiff(olds, news)
+	case "sentry:index:Team":
+		return k.teamDiff(olds, news)
+	case "sentry:index:ClientKey":
+		return k.clientKeyDiff(olds, news)
+	case "sentry:index:IssueAlertRule":
+		return k.issueAlertRuleDiff(olds, news)
+	case "sentry:index:MetricAlertRule":
+		return k.metricAlertRuleDiff(olds, news)
+	case "sentry:index:ProjectInboundFilters":
+		return k.projectInboundFiltersDiff(olds, news)
+	case "sentry:index:ProjectOwnership":
+		return k.projectOwnershipDiff(olds, news)
+	case "sentry:index:OrganizationMember":
+		return k.organizationMemberDiff(olds, news)
+	case "sentry:index:TeamMember":
+		return k.teamMemberDiff(olds, news)
+	case "sentry:index:ProjectEnvironment":
+		return k.projectEnvironmentDiff(olds, news)
+	case "sentry:index:Release":
+		return k.releaseDiff(olds, news)
+	case "sentry:index:Deploy":
+		return k.deployDiff(olds, news)
+	case "sentry:index:Monitor":
+		return k.monitorDiff(olds, news)
+	case "sentry:index:ServiceHook":
+		return k.serviceHookDiff(olds, news)
+	case "sentry:index:OrganizationSettings":
+		return k.organizationSettingsDiff(olds, news)
+	case "sentry:index:SavedSearch":
+		return k.savedSearchDiff(olds, news)
+	case "sentry:index:Dashboard":
+		return k.dashboardDiff(olds, news)
+	case "sentry:index:OrganizationRepository":
+		return k.organizationRepositoryDiff(olds, news)
 	}
 
 	return nil, fmt.Errorf("Unknown resource type '%s'", ty)
@@ -165,6 +294,40 @@ func (k *sentryProvider) Create(ctx context.Context, req *rpc.CreateRequest) (*r
 	switch ty {
 	case "sentry:index:Project":
 		return k.projectCreate(ctx, req, inputs)
+	case "sentry:index:Team":
+		return k.teamCreate(ctx, req, inputs)
+	case "sentry:index:ClientKey":
+		return k.clientKeyCreate(ctx, req, inputs)
+	case "sentry:index:IssueAlertRule":
+		return k.issueAlertRuleCreate(ctx, req, inputs)
+	case "sentry:index:MetricAlertRule":
+		return k.metricAlertRuleCreate(ctx, req, inputs)
+	case "sentry:index:ProjectInboundFilters":
+		return k.projectInboundFiltersCreate(ctx, req, inputs)
+	case "sentry:index:ProjectOwnership":
+		return k.projectOwnershipCreate(ctx, req, inputs)
+	case "sentry:index:OrganizationMember":
+		return k.organizationMemberCreate(ctx, req, inputs)
+	case "sentry:index:TeamMember":
+		return k.teamMemberCreate(ctx, req, inputs)
+	case "sentry:index:ProjectEnvironment":
+		return k.projectEnvironmentCreate(ctx, req, inputs)
+	case "sentry:index:Release":
+		return k.releaseCreate(ctx, req, inputs)
+	case "sentry:index:Deploy":
+		return k.deployCreate(ctx, req, inputs)
+	case "sentry:index:Monitor":
+		return k.monitorCreate(ctx, req, inputs)
+	case "sentry:index:ServiceHook":
+		return k.serviceHookCreate(ctx, req, inputs)
+	case "sentry:index:OrganizationSettings":
+		return k.organizationSettingsCreate(ctx, req, inputs)
+	case "sentry:index:SavedSearch":
+		return k.savedSearchCreate(ctx, req, inputs)
+	case "sentry:index:Dashboard":
+		return k.dashboardCreate(ctx, req, inputs)
+	case "sentry:index:OrganizationRepository":
+		return k.organizationRepositoryCreate(ctx, req, inputs)
 	}
 	return nil, fmt.Errorf("Unknown resource type '%s'", ty)
 }
@@ -176,6 +339,40 @@ func (k *sentryProvider) Read(ctx context.Context, req *rpc.ReadRequest) (*rpc.R
 	switch ty {
 	case "sentry:index:Project":
 		return k.projectRead(ctx, req)
+	case "sentry:index:Team":
+		return k.teamRead(ctx, req)
+	case "sentry:index:ClientKey":
+		return k.clientKeyRead(ctx, req)
+	case "sentry:index:IssueAlertRule":
+		return k.issueAlertRuleRead(ctx, req)
+	case "sentry:index:MetricAlertRule":
+		return k.metricAlertRuleRead(ctx, req)
+	case "sentry:index:ProjectInboundFilters":
+		return k.projectInboundFiltersRead(ctx, req)
+	case "sentry:index:ProjectOwnership":
+		return k.projectOwnershipRead(ctx, req)
+	case "sentry:index:OrganizationMember":
+		return k.organizationMemberRead(ctx, req)
+	case "sentry:index:TeamMember":
+		return k.teamMemberRead(ctx, req)
+	case "sentry:index:ProjectEnvironment":
+		return k.projectEnvironmentRead(ctx, req)
+	case "sentry:index:Release":
+		return k.releaseRead(ctx, req)
+	case "sentry:index:Deploy":
+		return k.deployRead(ctx, req)
+	case "sentry:index:Monitor":
+		return k.monitorRead(ctx, req)
+	case "sentry:index:ServiceHook":
+		return k.serviceHookRead(ctx, req)
+	case "sentry:index:OrganizationSettings":
+		return k.organizationSettingsRead(ctx, req)
+	case "sentry:index:SavedSearch":
+		return k.savedSearchRead(ctx, req)
+	case "sentry:index:Dashboard":
+		return k.dashboardRead(ctx, req)
+	case "sentry:index:OrganizationRepository":
+		return k.organizationRepositoryRead(ctx, req)
 	}
 
 	return nil, fmt.Errorf("Unknown resource type '%s'", ty)
@@ -189,6 +386,40 @@ func (k *sentryProvider) Update(ctx context.Context, req *rpc.UpdateRequest) (*r
 	switch ty {
 	case "sentry:index:Project":
 		return k.projectUpdate(ctx, req)
+	case "sentry:index:Team":
+		return k.teamUpdate(ctx, req)
+	case "sentry:index:ClientKey":
+		return k.clientKeyUpdate(ctx, req)
+	case "sentry:index:IssueAlertRule":
+		return k.issueAlertRuleUpdate(ctx, req)
+	case "sentry:index:MetricAlertRule":
+		return k.metricAlertRuleUpdate(ctx, req)
+	case "sentry:index:ProjectInboundFilters":
+		return k.projectInboundFiltersUpdate(ctx, req)
+	case "sentry:index:ProjectOwnership":
+		return k.projectOwnershipUpdate(ctx, req)
+	case "sentry:index:OrganizationMember":
+		return k.organizationMemberUpdate(ctx, req)
+	case "sentry:index:TeamMember":
+		return k.teamMemberUpdate(ctx, req)
+	case "sentry:index:ProjectEnvironment":
+		return k.projectEnvironmentUpdate(ctx, req)
+	case "sentry:index:Release":
+		return k.releaseUpdate(ctx, req)
+	case "sentry:index:Deploy":
+		return k.deployUpdate(ctx, req)
+	case "sentry:index:Monitor":
+		return k.monitorUpdate(ctx, req)
+	case "sentry:index:ServiceHook":
+		return k.serviceHookUpdate(ctx, req)
+	case "sentry:index:OrganizationSettings":
+		return k.organizationSettingsUpdate(ctx, req)
+	case "sentry:index:SavedSearch":
+		return k.savedSearchUpdate(ctx, req)
+	case "sentry:index:Dashboard":
+		return k.dashboardUpdate(ctx, req)
+	case "sentry:index:OrganizationRepository":
+		return k.organizationRepositoryUpdate(ctx, req)
 	}
 
 	return nil, fmt.Errorf("Unknown resource type '%s'", ty)
@@ -202,6 +433,40 @@ func (k *sentryProvider) Delete(ctx context.Context, req *rpc.DeleteRequest) (*p
 	switch ty {
 	case "sentry:index:Project":
 		return k.projectDelete(ctx, req)
+	case "sentry:index:Team":
+		return k.teamDelete(ctx, req)
+	case "sentry:index:ClientKey":
+		return k.clientKeyDelete(ctx, req)
+	case "sentry:index:IssueAlertRule":
+		return k.issueAlertRuleDelete(ctx, req)
+	case "sentry:index:MetricAlertRule":
+		return k.metricAlertRuleDelete(ctx, req)
+	case "sentry:index:ProjectInboundFilters":
+		return k.projectInboundFiltersDelete(ctx, req)
+	case "sentry:index:ProjectOwnership":
+		return k.projectOwnershipDelete(ctx, req)
+	case "sentry:index:OrganizationMember":
+		return k.organizationMemberDelete(ctx, req)
+	case "sentry:index:TeamMember":
+		return k.teamMemberDelete(ctx, req)
+	case "sentry:index:ProjectEnvironment":
+		return k.projectEnvironmentDelete(ctx, req)
+	case "sentry:index:Release":
+		return k.releaseDelete(ctx, req)
+	case "sentry:index:Deploy":
+		return k.deployDelete(ctx, req)
+	case "sentry:index:Monitor":
+		return k.monitorDelete(ctx, req)
+	case "sentry:index:ServiceHook":
+		return k.serviceHookDelete(ctx, req)
+	case "sentry:index:OrganizationSettings":
+		return k.organizationSettingsDelete(ctx, req)
+	case "sentry:index:SavedSearch":
+		return k.savedSearchDelete(ctx, req)
+	case "sentry:index:Dashboard":
+		return k.dashboardDelete(ctx, req)
+	case "sentry:index:OrganizationRepository":
+		return k.organizationRepositoryDelete(ctx, req)
 	}
 	return nil, fmt.Errorf("Unknown resource type '%s'", ty)
 
@@ -221,7 +486,26 @@ func (k *sentryProvider) GetPluginInfo(context.Context, *pbempty.Empty) (*rpc.Pl
 
 // GetSchema returns the JSON-serialized schema for the provider.
 func (k *sentryProvider) GetSchema(ctx context.Context, req *rpc.GetSchemaRequest) (*rpc.GetSchemaResponse, error) {
-	return &rpc.GetSchemaResponse{}, nil
+	if v := req.GetVersion(); v != 0 {
+		return nil, fmt.Errorf("unsupported schema version %d", v)
+	}
+
+	// schema.json only has a placeholder version, stamp the one of this build
+	// instead.
+	var schema map[string]json.RawMessage
+	if err := json.Unmarshal(k.schema, &schema); err != nil {
+		return nil, fmt.Errorf("could not parse the embedded schema: %v", err)
+	}
+	version, err := json.Marshal(k.version)
+	if err != nil {
+		return nil, err
+	}
+	schema["version"] = version
+	stamped, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.GetSchemaResponse{Schema: string(stamped)}, nil
 }
 
 // Cancel signals the provider to gracefully shut down and abort any ongoing resource operations.
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestCheckConfig(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		env          map[string]string
+		wantFailures []*rpc.CheckFailure
+	}{
+		"missing": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "apiURL", Reason: "this input must be an absolute http(s) URL"},
+				{Property: "token", Reason: "this input must be a non-empty string"},
+			},
+		},
+		"ill-formed": {
+			news: resource.PropertyMap{
+				"apiURL": resource.NewPropertyValue("sentry.io/api/0/"),
+				"token":  resource.MakeSecret(resource.NewPropertyValue("")),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "apiURL", Reason: "this input must be an absolute http(s) URL"},
+				{Property: "token", Reason: "this input must be a non-empty string"},
+			},
+		},
+		"correct": {
+			news: resource.PropertyMap{
+				"apiURL": resource.NewPropertyValue("https://sentry.io/api/0/"),
+				"token":  resource.MakeSecret(resource.NewPropertyValue("the-token")),
+			},
+			wantFailures: nil,
+		},
+		"from environment": {
+			news: resource.PropertyMap{},
+			env: map[string]string{
+				"SENTRY_AUTH_TOKEN": "the-token",
+				"SENTRY_URL":        "https://sentry.example.com/",
+			},
+			wantFailures: nil,
+		},
+		"ill-formed in environment": {
+			news: resource.PropertyMap{
+				"token": resource.MakeSecret(resource.NewPropertyValue("the-token")),
+			},
+			env:          map[string]string{"SENTRY_URL": "sentry.example.com"},
+			wantFailures: []*rpc.CheckFailure{{Property: "apiURL", Reason: "this input must be an absolute http(s) URL"}},
+		},
+		"computed": {
+			news: resource.PropertyMap{
+				"apiURL": resource.MakeComputed(resource.NewStringProperty("")),
+				"token":  resource.MakeComputed(resource.NewStringProperty("")),
+			},
+			wantFailures: nil,
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{"SENTRY_AUTH_TOKEN", "SENTRY_URL"} {
+				setenv(t, key, tc.env[key])
+			}
+			prov := sentryProvider{}
+			resp, err := prov.CheckConfig(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::pulumi:providers:sentry::default",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, r.URL.Path, "/api/0/organizations/")
+		if r.Header.Get("Authorization") != "Bearer good-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"detail": "Invalid token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"slug": "org"}]`))
+	}))
+	defer server.Close()
+	serverURL := server.URL + "/"
+	apiURL := server.URL + "/api/0/"
+
+	tests := map[string]struct {
+		vars                    map[string]string
+		env                     map[string]string
+		wantRequests            int
+		wantErr                 string
+		wantDefaultOrganization string
+	}{
+		"valid token": {
+			vars:         map[string]string{"sentry:config:apiURL": apiURL, "sentry:config:token": "good-token"},
+			wantRequests: 1,
+		},
+		"config takes precedence over environment": {
+			vars: map[string]string{
+				"sentry:config:apiURL":       apiURL,
+				"sentry:config:token":        "good-token",
+				"sentry:config:organization": "config-org",
+			},
+			env: map[string]string{
+				"SENTRY_AUTH_TOKEN": "bad-token",
+				"SENTRY_URL":        "http://127.0.0.1:1/",
+				"SENTRY_ORG":        "env-org",
+			},
+			wantRequests:            1,
+			wantDefaultOrganization: "config-org",
+		},
+		"environment fallback": {
+			vars: map[string]string{},
+			env: map[string]string{
+				"SENTRY_AUTH_TOKEN": "good-token",
+				"SENTRY_URL":        serverURL,
+				"SENTRY_ORG":        "env-org",
+			},
+			wantRequests:            1,
+			wantDefaultOrganization: "env-org",
+		},
+		"invalid token": {
+			vars:         map[string]string{"sentry:config:apiURL": apiURL, "sentry:config:token": "bad-token"},
+			wantRequests: 1,
+			wantErr:      "authentication to Sentry at " + apiURL + " failed",
+		},
+		"skipped validation": {
+			vars: map[string]string{
+				"sentry:config:apiURL":                    apiURL,
+				"sentry:config:token":                     "bad-token",
+				"sentry:config:skipCredentialsValidation": "true",
+			},
+			wantRequests: 0,
+		},
+		"unknown token": {
+			vars:         map[string]string{"sentry:config:apiURL": apiURL, "sentry:config:token": plugin.UnknownStringValue},
+			wantRequests: 0,
+		},
+		"malformed skipCredentialsValidation": {
+			vars: map[string]string{
+				"sentry:config:apiURL":                    apiURL,
+				"sentry:config:token":                     "good-token",
+				"sentry:config:skipCredentialsValidation": "maybe",
+			},
+			wantErr: "sentry:skipCredentialsValidation must be a boolean",
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			requests = 0
+			for _, key := range []string{"SENTRY_AUTH_TOKEN", "SENTRY_URL", "SENTRY_ORG"} {
+				setenv(t, key, tc.env[key])
+			}
+			prov := sentryProvider{}
+			_, err := prov.Configure(ctx, &rpc.ConfigureRequest{Variables: tc.vars})
+			assert.Equal(t, requests, tc.wantRequests)
+			if tc.wantErr == "" {
+				assert.Nil(t, err)
+				assert.NotNil(t, prov.sentryClient)
+				assert.Equal(t, prov.defaultOrganization, tc.wantDefaultOrganization)
+			} else {
+				assert.NotNil(t, err)
+				assert.True(t, strings.Contains(err.Error(), tc.wantErr), err.Error())
+				assert.Nil(t, prov.sentryClient)
+			}
+		})
+	}
+}
+
+func TestDiffConfig(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{}
+	olds := resource.PropertyMap{
+		"apiURL": resource.NewPropertyValue("https://sentry.io/api/0/"),
+		"token":  resource.NewPropertyValue("the-token"),
+	}
+	resp, err := prov.DiffConfig(ctx, &rpc.DiffRequest{
+		Olds: mustMarshalProperties(olds),
+		News: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, *resp, rpc.DiffResponse{})
+
+	resp, err = prov.DiffConfig(ctx, &rpc.DiffRequest{
+		Olds: mustMarshalProperties(olds),
+		News: mustMarshalProperties(propertyMapWithOverrides(olds, resource.PropertyMap{
+			"organization": resource.NewPropertyValue("the-org"),
+			"token":        resource.NewPropertyValue("new-token"),
+		})),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, *resp, rpc.DiffResponse{
+		Changes: rpc.DiffResponse_DIFF_SOME,
+		Diffs:   []string{"organization", "token"},
+	})
+}
+
+func TestGetSchema(t *testing.T) {
+	ctx := context.Background()
+	schema, err := ioutil.ReadFile("../../schema.json")
+	assert.Nil(t, err)
+	prov := sentryProvider{version: "1.2.3", schema: schema}
+
+	resp, err := prov.GetSchema(ctx, &rpc.GetSchemaRequest{})
+	assert.Nil(t, err)
+	var served, want map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(resp.GetSchema()), &served))
+	assert.Nil(t, json.Unmarshal(schema, &want))
+	assert.Equal(t, served["version"], "1.2.3")
+	want["version"] = "1.2.3"
+	assert.Equal(t, served, want)
+
+	_, err = prov.GetSchema(ctx, &rpc.GetSchemaRequest{Version: 1})
+	assert.NotNil(t, err)
+}
+
+// setenv sets an environment variable for the duration of the test, an empty
+// value unsets it.
+func setenv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	if value == "" {
+		os.Unsetenv(key)
+	} else {
+		os.Setenv(key, value)
+	}
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var releaseProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"organizationSlug": true,
+		"version":          true,
+	},
+	changedByUpdate: map[string]bool{
+		// Sentry can't unset the release date, see releaseDiff.
+		"dateReleased": true,
+		// Sentry adds projects to existing releases, but can't remove them,
+		// see releaseDiff.
+		"projectSlugs": true,
+		"ref":          true,
+		"refs":         true,
+		"url":          true,
+	},
+	outputs: map[string]bool{
+		"dateCreated": true,
+	},
+}
+
+func (k *sentryProvider) releaseCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkOptionalTime(&failures, news, "dateReleased")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkOptionalStringArray(&failures, news, "projectSlugs")
+	if projectSlugs := news["projectSlugs"]; projectSlugs.IsNull() || (projectSlugs.IsArray() && len(projectSlugs.ArrayValue()) == 0) {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "projectSlugs",
+			Reason:   "a release must be in at least one project",
+		})
+	}
+	checkOptionalString(&failures, news, "ref")
+	checkReleaseRefs(&failures, news)
+	if !news["url"].IsNull() {
+		checkHTTPURL(&failures, news, "url")
+	}
+	checkReleaseVersion(&failures, news)
+
+	news["dateReleased"] = normalizedTime(news["dateReleased"])
+	news["projectSlugs"] = sortedStrings(news["projectSlugs"])
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+// checkReleaseVersion checks versions the way Sentry does, which also keeps
+// slashes out of the IDs of releases.
+func checkReleaseVersion(failures *[]*rpc.CheckFailure, props resource.PropertyMap) {
+	checkNonEmptyString(failures, props, "version")
+	value := props["version"]
+	if !value.IsString() || value.StringValue() == "" {
+		return
+	}
+	version := value.StringValue()
+	if len(version) > 200 || strings.ContainsAny(version, "/\r\n\t") || version == "." || version == ".." || version == "latest" {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "version",
+			Reason:   `this input must be at most 200 characters, without slashes, tabs or new lines, and not ".", ".." or "latest"`,
+		})
+	}
+}
+
+func checkReleaseRefs(failures *[]*rpc.CheckFailure, props resource.PropertyMap) {
+	value := props["refs"]
+	if value.IsNull() || value.IsComputed() {
+		return
+	}
+	if !value.IsArray() {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "refs",
+			Reason:   "this input must be a list of refs",
+		})
+		return
+	}
+	for i, element := range value.ArrayValue() {
+		if element.IsComputed() {
+			continue
+		}
+		path := fmt.Sprintf("refs[%d]", i)
+		if !element.IsObject() {
+			*failures = append(*failures, &rpc.CheckFailure{Property: path, Reason: "this input must be an object"})
+			continue
+		}
+		var refFailures []*rpc.CheckFailure
+		checkNonEmptyString(&refFailures, element.ObjectValue(), "commit")
+		checkOptionalString(&refFailures, element.ObjectValue(), "previousCommit")
+		checkNonEmptyString(&refFailures, element.ObjectValue(), "repository")
+		for _, failure := range refFailures {
+			failure.Property = path + "." + failure.Property
+		}
+		*failures = append(*failures, refFailures...)
+	}
+}
+
+// releaseDiff replaces releases for the changes Sentry can't apply in place:
+// it adds projects to existing releases, but can't remove them, and ignores
+// empty release dates.
+func (k *sentryProvider) releaseDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	resp, err := releaseProperties.diff(olds, news)
+	if err != nil {
+		return nil, err
+	}
+	var replaces []string
+	if releaseProjectRemoved(olds, news) {
+		replaces = append(replaces, "projectSlugs")
+	}
+	if !olds["dateReleased"].IsNull() && news["dateReleased"].IsNull() {
+		replaces = append(replaces, "dateReleased")
+	}
+	if len(replaces) > 0 {
+		resp.Replaces = append(resp.Replaces, replaces...)
+		sort.Strings(resp.Replaces)
+		resp.DeleteBeforeReplace = true
+	}
+	return resp, nil
+}
+
+// releaseProjectRemoved tells whether a project of olds is missing from news.
+func releaseProjectRemoved(olds, news resource.PropertyMap) bool {
+	if news["projectSlugs"].ContainsUnknowns() {
+		return false
+	}
+	projectSlugs := stringsFromPropertyValue(news["projectSlugs"])
+	for _, projectSlug := range stringsFromPropertyValue(olds["projectSlugs"]) {
+		if !containsString(projectSlugs, projectSlug) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *sentryProvider) releaseCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	version := inputs["version"].StringValue()
+
+	r := releaseFromInputs(inputs)
+	r.Projects = stringsFromPropertyValue(inputs["projectSlugs"])
+	created, err := k.sentryClient.CreateRelease(sentry.Organization{Slug: &organizationSlug}, r)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateRelease %v: %w", version, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		releasePropertyMap(organizationSlug, created, inputs["refs"]),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildID(organizationSlug, created.Version),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) releaseUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, version, err := parseReleaseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed releaseUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed releaseUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := releaseProperties.checkUpdatable("releaseUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	org := sentry.Organization{Slug: &organizationSlug}
+	if !olds["projectSlugs"].DeepEquals(news["projectSlugs"]) {
+		// Creating an existing release adds the new projects to it, removed
+		// projects replace the release, see releaseDiff.
+		projectSlugs := stringsFromPropertyValue(news["projectSlugs"])
+		if _, err := k.sentryClient.CreateRelease(org, release{Version: version, Projects: projectSlugs}); err != nil {
+			return nil, fmt.Errorf("could not add projects to release %v: %w", version, err)
+		}
+	}
+
+	r := releaseFromInputs(news)
+	r.Version = version
+	updated, err := k.sentryClient.UpdateRelease(org, r)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateRelease %v: %w", version, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		releasePropertyMap(organizationSlug, updated, news["refs"]),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) releaseRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, version, err := parseReleaseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	olds, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed releaseRead because of malformed resource state: %w", err)
+	}
+	r, err := k.sentryClient.GetRelease(sentry.Organization{Slug: &organizationSlug}, version)
+	if err != nil {
+		if isNotFound(err) {
+			// The release is not there, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetRelease %v: %w", req.GetId(), err)
+	}
+	// Sentry does not return refs, keep them as they were set.
+	properties := releasePropertyMap(organizationSlug, r, olds["refs"])
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(releaseProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         req.GetId(),
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) releaseDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, version, err := parseReleaseID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteRelease(sentry.Organization{Slug: &organizationSlug}, version)
+	if isNotFound(err) {
+		// The release is already gone.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+// parseReleaseID parses IDs of releases: <orgSlug>/<version>.
+func parseReleaseID(id string) (organizationSlug, version string, err error) {
+	parts, err := parseID(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// releaseFromInputs returns the release to send to Sentry, without its
+// projects which UpdateRelease can't change.
+func releaseFromInputs(inputs resource.PropertyMap) release {
+	r := release{
+		Version:      stringFromPropertyValue(inputs["version"]),
+		Ref:          stringPtrFromPropertyValue(inputs["ref"]),
+		URL:          stringPtrFromPropertyValue(inputs["url"]),
+		DateReleased: timePtrFromPropertyValue(inputs["dateReleased"]),
+	}
+	for _, ref := range objectsFromPropertyValue(inputs["refs"]) {
+		repository, _ := ref["repository"].(string)
+		commit, _ := ref["commit"].(string)
+		previousCommit, _ := ref["previousCommit"].(string)
+		r.Refs = append(r.Refs, releaseRef{Repository: repository, Commit: commit, PreviousCommit: previousCommit})
+	}
+	return r
+}
+
+func releasePropertyMap(organizationSlug string, r release, refs resource.PropertyValue) resource.PropertyMap {
+	projectSlugs := append([]string{}, r.Projects...)
+	sort.Strings(projectSlugs)
+	properties := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"organizationSlug": organizationSlug,
+		"projectSlugs":     projectSlugs,
+		"ref":              r.Ref,
+		"url":              r.URL,
+		"version":          r.Version,
+	})
+	if r.DateCreated != nil {
+		properties["dateCreated"] = resource.NewStringProperty(formatTime(*r.DateCreated))
+	}
+	if r.DateReleased != nil {
+		properties["dateReleased"] = resource.NewStringProperty(formatTime(*r.DateReleased))
+	}
+	if refs.IsArray() {
+		properties["refs"] = refs
+	}
+	return properties
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestReleaseCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlugs", Reason: "a release must be in at least one project"},
+				{Property: "version", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{},
+		},
+		"wrong values": {
+			news: resource.PropertyMap{
+				"dateReleased":     resource.NewPropertyValue("yesterday"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-slug"}),
+				"refs": resource.NewPropertyValue([]interface{}{
+					map[string]interface{}{"repository": "org/repo"},
+					"abc",
+				}),
+				"url":     resource.NewPropertyValue("ftp://example.com"),
+				"version": resource.NewPropertyValue("feature/1"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "dateReleased", Reason: "this input must be a date and time in RFC 3339 format, e.g. 2020-12-01T10:00:00Z"},
+				{Property: "refs[0].commit", Reason: "this input must be a non-empty string"},
+				{Property: "refs[1]", Reason: "this input must be an object"},
+				{Property: "url", Reason: "this input must be an absolute http(s) URL"},
+				{Property: "version", Reason: `this input must be at most 200 characters, without slashes, tabs or new lines, and not ".", ".." or "latest"`},
+			},
+			wantInputs: resource.PropertyMap{
+				"dateReleased":     resource.NewPropertyValue("yesterday"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-slug"}),
+				"refs": resource.NewPropertyValue([]interface{}{
+					map[string]interface{}{"repository": "org/repo"},
+					"abc",
+				}),
+				"url":     resource.NewPropertyValue("ftp://example.com"),
+				"version": resource.NewPropertyValue("feature/1"),
+			},
+		},
+		"normalized": {
+			news: resource.PropertyMap{
+				"dateReleased":     resource.NewPropertyValue("2020-12-01T11:00:00+01:00"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-b", "proj-a"}),
+				"version":          resource.NewPropertyValue("my-app@1.2.3"),
+			},
+			wantInputs: resource.PropertyMap{
+				"dateReleased":     resource.NewPropertyValue("2020-12-01T10:00:00Z"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-a", "proj-b"}),
+				"version":          resource.NewPropertyValue("my-app@1.2.3"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.releaseCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestReleaseDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"dateCreated":      resource.NewPropertyValue("2020-12-01T09:00:00.123456Z"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-slug"}),
+		"version":          resource.NewPropertyValue("1.2.3"),
+	}
+	baseNews := releaseProperties.inputs(baseOlds)
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{Changes: rpc.DiffResponse_DIFF_NONE},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"dateReleased": resource.NewPropertyValue("2020-12-01T10:00:00Z"),
+				"ref":          resource.NewPropertyValue("v1.2.3"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"dateReleased", "ref"},
+			},
+		},
+		"project added": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"projectSlugs": resource.NewPropertyValue([]interface{}{"other-proj", "proj-slug"}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"projectSlugs"},
+			},
+		},
+		"release date removed": {
+			olds: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"dateReleased": resource.NewPropertyValue("2020-12-01T10:00:00Z"),
+			}),
+			news: baseNews,
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"dateReleased"},
+				Replaces:            []string{"dateReleased"},
+				DeleteBeforeReplace: true,
+			},
+		},
+		"project removed": {
+			olds: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"projectSlugs": resource.NewPropertyValue([]interface{}{"other-proj", "proj-slug"}),
+			}),
+			news: baseNews,
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"projectSlugs"},
+				Replaces:            []string{"projectSlugs"},
+				DeleteBeforeReplace: true,
+			},
+		},
+		"project removed and version changed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"projectSlugs": resource.NewPropertyValue([]interface{}{"other-proj"}),
+				"version":      resource.NewPropertyValue("1.2.4"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"projectSlugs", "version"},
+				Replaces:            []string{"projectSlugs", "version"},
+				DeleteBeforeReplace: true,
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"version": resource.NewPropertyValue("1.2.4"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"version"},
+				Replaces:            []string{"version"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.releaseDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestReleaseCreate(t *testing.T) {
+	ctx := context.Background()
+	dateCreated := time.Date(2020, 12, 1, 9, 0, 0, 123456000, time.UTC)
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createRelease: func(org sentry.Organization, r release) (release, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, r.Version, "1.2.3")
+				assert.Equal(t, r.Projects, []string{"proj-slug"})
+				assert.Equal(t, r.Refs, []releaseRef{{Repository: "org/repo", Commit: "abc"}})
+				r.DateCreated = &dateCreated
+				return r, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-slug"}),
+		"refs": resource.NewPropertyValue([]interface{}{
+			map[string]interface{}{"repository": "org/repo", "commit": "abc"},
+		}),
+		"version": resource.NewPropertyValue("1.2.3"),
+	}
+	resp, err := prov.releaseCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/1.2.3")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(inputs, resource.PropertyMap{
+		"dateCreated": resource.NewPropertyValue("2020-12-01T09:00:00.123456Z"),
+	}))
+}
+
+func TestReleaseRead(t *testing.T) {
+	ctx := context.Background()
+	dateReleased := time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getRelease: func(org sentry.Organization, version string) (release, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, version, "1.2.3")
+				return release{Version: version, Projects: []string{"proj-b", "proj-a"}, DateReleased: &dateReleased}, nil
+			},
+		},
+	}
+	resp, err := prov.releaseRead(ctx, &rpc.ReadRequest{Id: "org-slug/1.2.3"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/1.2.3")
+	want := resource.PropertyMap{
+		"dateReleased":     resource.NewPropertyValue("2020-12-01T10:00:00Z"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-a", "proj-b"}),
+		"version":          resource.NewPropertyValue("1.2.3"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+}
+
+func TestReleaseRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getRelease: func(org sentry.Organization, version string) (release, error) {
+				return release{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.releaseRead(ctx, &rpc.ReadRequest{Id: "org-slug/1.2.3"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestReleaseUpdate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			updateRelease: func(org sentry.Organization, r release) (release, error) {
+				assert.Equal(t, r.Version, "1.2.3")
+				assert.Equal(t, *r.Ref, "v1.2.3")
+				r.Projects = []string{"proj-slug"}
+				return r, nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-slug"}),
+		"version":          resource.NewPropertyValue("1.2.3"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"ref": resource.NewPropertyValue("v1.2.3"),
+	})
+	resp, err := prov.releaseUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/1.2.3",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
+
+func TestReleaseUpdateProjects(t *testing.T) {
+	ctx := context.Background()
+	var added []string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createRelease: func(org sentry.Organization, r release) (release, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, r.Version, "1.2.3")
+				added = r.Projects
+				return r, nil
+			},
+			updateRelease: func(org sentry.Organization, r release) (release, error) {
+				r.Projects = []string{"other-proj", "proj-slug"}
+				return r, nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlugs":     resource.NewPropertyValue([]interface{}{"proj-slug"}),
+		"version":          resource.NewPropertyValue("1.2.3"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"projectSlugs": resource.NewPropertyValue([]interface{}{"other-proj", "proj-slug"}),
+	})
+	resp, err := prov.releaseUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/1.2.3",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, added, []string{"other-proj", "proj-slug"})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
+
+func TestReleaseDelete(t *testing.T) {
+	ctx := context.Background()
+	var deleted string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			deleteRelease: func(org sentry.Organization, version string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				deleted = version
+				return nil
+			},
+		},
+	}
+	_, err := prov.releaseDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/1.2.3"})
+	assert.Nil(t, err)
+	assert.Equal(t, deleted, "1.2.3")
+}
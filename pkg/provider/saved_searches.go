@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// Defaults applied by Sentry when creating saved searches.
+const (
+	savedSearchDefaultSort       = "date"
+	savedSearchDefaultVisibility = "organization"
+)
+
+var savedSearchProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		// A search can't be moved between organizations.
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"name":       true,
+		"pinned":     true,
+		"query":      true,
+		"sort":       true,
+		"visibility": true,
+	},
+	outputs: map[string]bool{},
+}
+
+var (
+	// savedSearchSorts are the orders Sentry can list issues in: by last
+	// seen, first seen, priority, trend, number of events, number of users
+	// and date added to the inbox.
+	savedSearchSorts = []string{"date", "new", "priority", "trends", "freq", "user", "inbox"}
+
+	// savedSearchVisibilities are who saved searches are listed for: the
+	// whole organization, or only the user of the API token.
+	savedSearchVisibilities = []string{"organization", "owner"}
+)
+
+func (k *sentryProvider) savedSearchCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkNonEmptyString(&failures, news, "name")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkOptionalBool(&failures, news, "pinned")
+	checkSavedSearchQuery(&failures, news)
+	checkOptionalOneOf(&failures, news, "sort", savedSearchSorts...)
+	checkOptionalOneOf(&failures, news, "visibility", savedSearchVisibilities...)
+
+	// Fill in what Sentry defaults to, so that Read does not report a
+	// difference against inputs that skip it.
+	if news["pinned"].IsNull() {
+		news["pinned"] = resource.NewBoolProperty(false)
+	}
+	if news["sort"].IsNull() {
+		news["sort"] = resource.NewStringProperty(savedSearchDefaultSort)
+	}
+	if news["visibility"].IsNull() {
+		news["visibility"] = resource.NewStringProperty(savedSearchDefaultVisibility)
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+// checkSavedSearchQuery checks that the query of a saved search is a
+// non-empty string Sentry can parse.
+func checkSavedSearchQuery(failures *[]*rpc.CheckFailure, props resource.PropertyMap) {
+	value := props["query"]
+	if value.ContainsUnknowns() {
+		return
+	}
+	if !value.IsString() || strings.TrimSpace(value.StringValue()) == "" {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "query",
+			Reason:   "this input must be a non-empty string",
+		})
+		return
+	}
+	if problem := searchQueryProblem(value.StringValue()); problem != "" {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "query",
+			Reason:   "this input must be a valid search query: " + problem,
+		})
+	}
+}
+
+// searchQueryProblem describes what's wrong with the syntax of a search
+// query, such as `is:unresolved level:error !assigned:me "Timed out"`, or
+// returns an empty string if there is nothing wrong with it.  Only the
+// basics are checked: quotes, parentheses, and that filters have both a key
+// and a value; Sentry checks the keys and values themselves.
+func searchQueryProblem(query string) string {
+	var terms []string
+	var term strings.Builder
+	depth := 0
+	quoted := false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quoted && c == '\\' && i+1 < len(query):
+			term.WriteByte(c)
+			i++
+			c = query[i]
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return "a ) has no matching ("
+			}
+		case c == ' ' || c == '\t' || c == '\n':
+			if term.Len() > 0 {
+				terms = append(terms, term.String())
+				term.Reset()
+			}
+			continue
+		}
+		term.WriteByte(c)
+	}
+	if term.Len() > 0 {
+		terms = append(terms, term.String())
+	}
+	switch {
+	case quoted:
+		return "a quote is not closed"
+	case depth > 0:
+		return "a ( has no matching )"
+	}
+
+	for _, term := range terms {
+		term = strings.TrimRight(strings.TrimLeft(term, "("), ")")
+		colon := strings.Index(term, ":")
+		if colon < 0 || strings.Contains(term[:colon], `"`) {
+			// Free text, possibly quoted.
+			continue
+		}
+		key, value := strings.TrimPrefix(term[:colon], "!"), term[colon+1:]
+		if key == "" {
+			return fmt.Sprintf("the filter %q has no key", term)
+		}
+		if value == "" {
+			return fmt.Sprintf("the filter %q has no value", term)
+		}
+	}
+	return ""
+}
+
+func (k *sentryProvider) savedSearchDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return savedSearchProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) savedSearchCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	org := sentry.Organization{Slug: &organizationSlug}
+
+	wanted := savedSearchFromInputs(inputs)
+	search, err := k.sentryClient.CreateSavedSearch(org, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateSavedSearch %v: %w", wanted.Name, err)
+	}
+	if wanted.IsPinned {
+		if err := k.sentryClient.PinSavedSearch(org, search); err != nil {
+			return nil, fmt.Errorf("could not PinSavedSearch %v: %w", search.ID, err)
+		}
+		search.IsPinned = true
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		savedSearchPropertyMap(organizationSlug, search),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildID(organizationSlug, search.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) savedSearchUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, id, err := parseSavedSearchID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed savedSearchUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed savedSearchUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := savedSearchProperties.checkUpdatable("savedSearchUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	org := sentry.Organization{Slug: &organizationSlug}
+	wanted := savedSearchFromInputs(news)
+	wanted.ID = id
+	search, err := k.sentryClient.UpdateSavedSearch(org, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateSavedSearch %v: %w", id, err)
+	}
+	// The pinned search is a copy of the query and sort, so it is pinned
+	// again after they change.
+	switch {
+	case wanted.IsPinned:
+		if err := k.sentryClient.PinSavedSearch(org, search); err != nil {
+			return nil, fmt.Errorf("could not PinSavedSearch %v: %w", id, err)
+		}
+	case boolFromPropertyValue(olds["pinned"]):
+		if err := k.sentryClient.UnpinSavedSearch(org); err != nil {
+			return nil, fmt.Errorf("could not UnpinSavedSearch %v: %w", id, err)
+		}
+	}
+	search.IsPinned = wanted.IsPinned
+
+	outputProperties, err := plugin.MarshalProperties(
+		savedSearchPropertyMap(organizationSlug, search),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) savedSearchRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, id, err := parseSavedSearchID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	search, err := k.sentryClient.GetSavedSearch(sentry.Organization{Slug: &organizationSlug}, id)
+	if err != nil {
+		if isNotFound(err) {
+			// The search was removed, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetSavedSearch %v: %w", req.GetId(), err)
+	}
+	properties := savedSearchPropertyMap(organizationSlug, search)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(savedSearchProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         buildID(organizationSlug, search.ID),
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) savedSearchDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, id, err := parseSavedSearchID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteSavedSearch(sentry.Organization{Slug: &organizationSlug}, id)
+	if isNotFound(err) {
+		// The search is already gone.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+// parseSavedSearchID parses IDs of saved searches: <orgSlug>/<searchID>.
+func parseSavedSearchID(id string) (organizationSlug, searchID string, err error) {
+	parts, err := parseID(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+func savedSearchFromInputs(inputs resource.PropertyMap) savedSearch {
+	return savedSearch{
+		Name:       stringFromPropertyValue(inputs["name"]),
+		Query:      stringFromPropertyValue(inputs["query"]),
+		Sort:       stringFromPropertyValue(inputs["sort"]),
+		Visibility: stringFromPropertyValue(inputs["visibility"]),
+		IsPinned:   boolFromPropertyValue(inputs["pinned"]),
+	}
+}
+
+func savedSearchPropertyMap(organizationSlug string, search savedSearch) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"name":             search.Name,
+		"organizationSlug": organizationSlug,
+		"pinned":           search.IsPinned,
+		"query":            search.Query,
+		"sort":             search.Sort,
+		"visibility":       search.Visibility,
+	})
+}
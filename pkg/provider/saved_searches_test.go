@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestSavedSearchCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "query", Reason: "this input must be a non-empty string"},
+			},
+			wantInputs: resource.PropertyMap{
+				"pinned":     resource.NewPropertyValue(false),
+				"sort":       resource.NewPropertyValue("date"),
+				"visibility": resource.NewPropertyValue("organization"),
+			},
+		},
+		"wrong values": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("Unassigned P1"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"pinned":           resource.NewPropertyValue("yes"),
+				"query":            resource.NewPropertyValue(`is:unresolved message:"timed out`),
+				"sort":             resource.NewPropertyValue("alphabetical"),
+				"visibility":       resource.NewPropertyValue("everyone"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "pinned", Reason: "this input must be a boolean"},
+				{Property: "query", Reason: "this input must be a valid search query: a quote is not closed"},
+				{Property: "sort", Reason: "this input must be one of: date, new, priority, trends, freq, user, inbox"},
+				{Property: "visibility", Reason: "this input must be one of: organization, owner"},
+			},
+			wantInputs: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("Unassigned P1"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"pinned":           resource.NewPropertyValue("yes"),
+				"query":            resource.NewPropertyValue(`is:unresolved message:"timed out`),
+				"sort":             resource.NewPropertyValue("alphabetical"),
+				"visibility":       resource.NewPropertyValue("everyone"),
+			},
+		},
+		"correct full": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("Unassigned P1"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"pinned":           resource.NewPropertyValue(true),
+				"query":            resource.NewPropertyValue(`is:unresolved !has:assignee level:fatal environment:production`),
+				"sort":             resource.NewPropertyValue("freq"),
+				"visibility":       resource.NewPropertyValue("owner"),
+			},
+			wantInputs: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("Unassigned P1"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"pinned":           resource.NewPropertyValue(true),
+				"query":            resource.NewPropertyValue(`is:unresolved !has:assignee level:fatal environment:production`),
+				"sort":             resource.NewPropertyValue("freq"),
+				"visibility":       resource.NewPropertyValue("owner"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.savedSearchCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestSearchQueryProblem(t *testing.T) {
+	tests := map[string]string{
+		`is:unresolved`:                              "",
+		`is:unresolved !assigned:me "Timed out"`:     "",
+		`message:"key: value" (level:error)`:         "",
+		`message:"an \"escaped\" quote"`:             "",
+		`timed out after 10s`:                        "",
+		`"http://example.com"`:                       "",
+		`message:"timed out`:                         "a quote is not closed",
+		`(level:error`:                               "a ( has no matching )",
+		`level:error)`:                               "a ) has no matching (",
+		`is:unresolved level:`:                       `the filter "level:" has no value`,
+		`:error`:                                     `the filter ":error" has no key`,
+		`!:error`:                                    `the filter "!:error" has no key`,
+		`environment:production release:"1.0" user:`: `the filter "user:" has no value`,
+	}
+	for query, want := range tests {
+		t.Run(query, func(t *testing.T) {
+			assert.Equal(t, searchQueryProblem(query), want)
+		})
+	}
+}
+
+func TestSavedSearchDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Unassigned P1"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"pinned":           resource.NewPropertyValue(false),
+		"query":            resource.NewPropertyValue("is:unresolved !has:assignee"),
+		"sort":             resource.NewPropertyValue("date"),
+		"visibility":       resource.NewPropertyValue("organization"),
+	}
+	baseNews := savedSearchProperties.inputs(baseOlds)
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"pinned": resource.NewPropertyValue(true),
+				"query":  resource.NewPropertyValue("is:unresolved !has:assignee level:fatal"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"pinned", "query"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("other-org"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.savedSearchDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestSavedSearchCreate(t *testing.T) {
+	ctx := context.Background()
+	var pinned savedSearch
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createSavedSearch: func(org sentry.Organization, s savedSearch) (savedSearch, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, s, savedSearch{
+					Name:       "Unassigned P1",
+					Query:      "is:unresolved !has:assignee",
+					Sort:       "date",
+					Visibility: "organization",
+					IsPinned:   true,
+				})
+				s.ID = "17"
+				s.IsPinned = false
+				return s, nil
+			},
+			pinSavedSearch: func(org sentry.Organization, s savedSearch) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				pinned = s
+				return nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Unassigned P1"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"pinned":           resource.NewPropertyValue(true),
+		"query":            resource.NewPropertyValue("is:unresolved !has:assignee"),
+		"sort":             resource.NewPropertyValue("date"),
+		"visibility":       resource.NewPropertyValue("organization"),
+	}
+	resp, err := prov.savedSearchCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, pinned.Query, "is:unresolved !has:assignee")
+	assert.Equal(t, resp.GetId(), "org-slug/17")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), inputs)
+}
+
+func TestSavedSearchRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getSavedSearch: func(org sentry.Organization, id string) (savedSearch, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, id, "17")
+				return savedSearch{
+					ID:         id,
+					Name:       "Unassigned P1",
+					Query:      "is:unresolved",
+					Sort:       "date",
+					Visibility: "organization",
+				}, nil
+			},
+		},
+	}
+	resp, err := prov.savedSearchRead(ctx, &rpc.ReadRequest{Id: "org-slug/17"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/17")
+	want := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Unassigned P1"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"pinned":           resource.NewPropertyValue(false),
+		"query":            resource.NewPropertyValue("is:unresolved"),
+		"sort":             resource.NewPropertyValue("date"),
+		"visibility":       resource.NewPropertyValue("organization"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+}
+
+func TestSavedSearchRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getSavedSearch: func(org sentry.Organization, id string) (savedSearch, error) {
+				return savedSearch{}, sentry.APIError{Detail: "no saved search with ID 17", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.savedSearchRead(ctx, &rpc.ReadRequest{Id: "org-slug/17"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestSavedSearchUpdate(t *testing.T) {
+	ctx := context.Background()
+	unpinned := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			updateSavedSearch: func(org sentry.Organization, s savedSearch) (savedSearch, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, s, savedSearch{
+					ID:         "17",
+					Name:       "Unassigned P1",
+					Query:      "is:unresolved !has:assignee level:fatal",
+					Sort:       "freq",
+					Visibility: "organization",
+				})
+				return s, nil
+			},
+			unpinSavedSearch: func(org sentry.Organization) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				unpinned = true
+				return nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("Unassigned P1"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"pinned":           resource.NewPropertyValue(true),
+		"query":            resource.NewPropertyValue("is:unresolved !has:assignee"),
+		"sort":             resource.NewPropertyValue("date"),
+		"visibility":       resource.NewPropertyValue("organization"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"pinned": resource.NewPropertyValue(false),
+		"query":  resource.NewPropertyValue("is:unresolved !has:assignee level:fatal"),
+		"sort":   resource.NewPropertyValue("freq"),
+	})
+	resp, err := prov.savedSearchUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/17",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.True(t, unpinned)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
+
+func TestSavedSearchDelete(t *testing.T) {
+	ctx := context.Background()
+	var deleted string
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			deleteSavedSearch: func(org sentry.Organization, id string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				deleted = id
+				return nil
+			},
+		},
+	}
+	_, err := prov.savedSearchDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/17"})
+	assert.Nil(t, err)
+	assert.Equal(t, deleted, "17")
+}
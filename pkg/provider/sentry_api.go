@@ -1,42 +1,202 @@
 package provider
 
-import "github.com/marcin-ro/go-sentry-api"
+import (
+	"errors"
+
+	"github.com/marcin-ro/go-sentry-api"
+)
 
 // sentryClientAPI is an interface that covers all the functionality we need
-// from sentry.Client.
+// from sentry.Client, extended by apiClient.
 type sentryClientAPI interface {
 	CreateProject(o sentry.Organization, t sentry.Team, name string, slug *string) (sentry.Project, error)
 	GetProject(o sentry.Organization, projslug string) (sentry.Project, error)
 	UpdateProject(o sentry.Organization, p sentry.Project) error
 	DeleteProject(o sentry.Organization, p sentry.Project) error
+	GetProjectByID(o sentry.Organization, id string) (sentry.Project, error)
+	RenameProject(o sentry.Organization, p sentry.Project, newSlug string) error
+	GetProjectTeams(o sentry.Organization, p sentry.Project) ([]sentry.Team, error)
+	AddProjectTeam(o sentry.Organization, p sentry.Project, teamSlug string) error
+	RemoveProjectTeam(o sentry.Organization, p sentry.Project, teamSlug string) error
+	GetProjectSettings(o sentry.Organization, p sentry.Project) (projectSettings, error)
+	UpdateProjectSettings(o sentry.Organization, p sentry.Project, settings projectSettings) error
+
+	GetProjectInboundFilters(o sentry.Organization, p sentry.Project) ([]projectInboundFilter, error)
+	UpdateProjectInboundFilter(o sentry.Organization, p sentry.Project, id string, active bool) error
+	UpdateProjectLegacyBrowsersFilter(o sentry.Organization, p sentry.Project, browsers []string) error
+	UpdateProjectOptions(o sentry.Organization, p sentry.Project, options map[string]interface{}) error
+
+	GetProjectOwnership(o sentry.Organization, p sentry.Project) (projectOwnership, error)
+	UpdateProjectOwnership(o sentry.Organization, p sentry.Project, ownership projectOwnership) (projectOwnership, error)
+
+	GetProjectEnvironment(o sentry.Organization, p sentry.Project, name string) (projectEnvironment, error)
+	UpdateProjectEnvironment(o sentry.Organization, p sentry.Project, name string, hidden bool) (projectEnvironment, error)
 
 	CreateClientKey(o sentry.Organization, p sentry.Project, name string) (sentry.Key, error)
 	DeleteClientKey(o sentry.Organization, p sentry.Project, k sentry.Key) error
 	UpdateClientKey(o sentry.Organization, p sentry.Project, k sentry.Key, name string) (sentry.Key, error)
 	GetClientKeys(o sentry.Organization, p sentry.Project) ([]sentry.Key, error)
+	GetClientKeyDetails(o sentry.Organization, p sentry.Project, id string) (clientKey, error)
+	UpdateClientKeyDetails(o sentry.Organization, p sentry.Project, k clientKey) (clientKey, error)
 
 	GetOrganization(orgslug string) (sentry.Organization, error)
 
+	CreateTeam(o sentry.Organization, name string, slug *string) (sentry.Team, error)
 	GetTeam(o sentry.Organization, teamSlug string) (sentry.Team, error)
+	UpdateTeam(o sentry.Organization, t sentry.Team) error
+	DeleteTeam(o sentry.Organization, t sentry.Team) error
+
+	CreateOrganizationMember(o sentry.Organization, m organizationMember) (organizationMember, error)
+	GetOrganizationMember(o sentry.Organization, id string) (organizationMember, error)
+	UpdateOrganizationMember(o sentry.Organization, m organizationMember) (organizationMember, error)
+	DeleteOrganizationMember(o sentry.Organization, id string) error
+	AddTeamMember(o sentry.Organization, memberID, teamSlug string) error
+	RemoveTeamMember(o sentry.Organization, memberID, teamSlug string) error
+
+	CreateIssueAlertRule(o sentry.Organization, p sentry.Project, r issueAlertRule) (issueAlertRule, error)
+	GetIssueAlertRule(o sentry.Organization, p sentry.Project, id string) (issueAlertRule, error)
+	UpdateIssueAlertRule(o sentry.Organization, p sentry.Project, r issueAlertRule) (issueAlertRule, error)
+	DeleteIssueAlertRule(o sentry.Organization, p sentry.Project, id string) error
+
+	CreateMetricAlertRule(o sentry.Organization, r metricAlertRule) (metricAlertRule, error)
+	GetMetricAlertRule(o sentry.Organization, id string) (metricAlertRule, error)
+	UpdateMetricAlertRule(o sentry.Organization, r metricAlertRule) (metricAlertRule, error)
+	DeleteMetricAlertRule(o sentry.Organization, id string) error
+
+	CreateRelease(o sentry.Organization, r release) (release, error)
+	GetRelease(o sentry.Organization, version string) (release, error)
+	UpdateRelease(o sentry.Organization, r release) (release, error)
+	DeleteRelease(o sentry.Organization, version string) error
+	CreateReleaseDeploy(o sentry.Organization, version string, d releaseDeploy) (releaseDeploy, error)
+	GetReleaseDeploys(o sentry.Organization, version string) ([]releaseDeploy, error)
+
+	CreateMonitor(o sentry.Organization, mon monitor) (monitor, error)
+	GetMonitor(o sentry.Organization, slug string) (monitor, error)
+	UpdateMonitor(o sentry.Organization, mon monitor) (monitor, error)
+	DeleteMonitor(o sentry.Organization, slug string) error
+
+	CreateServiceHook(o sentry.Organization, p sentry.Project, h serviceHook) (serviceHook, error)
+	GetServiceHook(o sentry.Organization, p sentry.Project, id string) (serviceHook, error)
+	UpdateServiceHook(o sentry.Organization, p sentry.Project, h serviceHook) (serviceHook, error)
+	DeleteServiceHook(o sentry.Organization, p sentry.Project, id string) error
+
+	GetOrganizationSettings(o sentry.Organization) (organizationSettings, error)
+	UpdateOrganizationSettings(o sentry.Organization, settings organizationSettings) (organizationSettings, error)
+
+	CreateSavedSearch(o sentry.Organization, s savedSearch) (savedSearch, error)
+	GetSavedSearch(o sentry.Organization, id string) (savedSearch, error)
+	UpdateSavedSearch(o sentry.Organization, s savedSearch) (savedSearch, error)
+	DeleteSavedSearch(o sentry.Organization, id string) error
+	PinSavedSearch(o sentry.Organization, s savedSearch) error
+	UnpinSavedSearch(o sentry.Organization) error
+
+	CreateDashboard(o sentry.Organization, d dashboard) (dashboard, error)
+	GetDashboard(o sentry.Organization, id string) (dashboard, error)
+	UpdateDashboard(o sentry.Organization, d dashboard) (dashboard, error)
+	DeleteDashboard(o sentry.Organization, id string) error
+
+	GetOrganizationIntegrations(o sentry.Organization, providerKey string) ([]organizationIntegration, error)
+
+	CreateRepository(o sentry.Organization, i organizationIntegration, name string) (repository, error)
+	GetRepository(o sentry.Organization, id string) (repository, error)
+	DeleteRepository(o sentry.Organization, id string) error
 }
 
 // sentryClientMock mocks sentry.Client for tests.
 type sentryClientMock struct {
 	sentryClientAPI
 
-	createProject func(o sentry.Organization, t sentry.Team, name string, slug *string) (sentry.Project, error)
-	getProject    func(o sentry.Organization, projslug string) (sentry.Project, error)
-	updateProject func(o sentry.Organization, p sentry.Project) error
-	deleteProject func(o sentry.Organization, p sentry.Project) error
+	createProject         func(o sentry.Organization, t sentry.Team, name string, slug *string) (sentry.Project, error)
+	getProject            func(o sentry.Organization, projslug string) (sentry.Project, error)
+	updateProject         func(o sentry.Organization, p sentry.Project) error
+	deleteProject         func(o sentry.Organization, p sentry.Project) error
+	getProjectByID        func(o sentry.Organization, id string) (sentry.Project, error)
+	renameProject         func(o sentry.Organization, p sentry.Project, newSlug string) error
+	getProjectTeams       func(o sentry.Organization, p sentry.Project) ([]sentry.Team, error)
+	addProjectTeam        func(o sentry.Organization, p sentry.Project, teamSlug string) error
+	removeProjectTeam     func(o sentry.Organization, p sentry.Project, teamSlug string) error
+	getProjectSettings    func(o sentry.Organization, p sentry.Project) (projectSettings, error)
+	updateProjectSettings func(o sentry.Organization, p sentry.Project, settings projectSettings) error
 
-	createClientKey func(o sentry.Organization, p sentry.Project, name string) (sentry.Key, error)
-	deleteClientKey func(o sentry.Organization, p sentry.Project, k sentry.Key) error
-	updateClientKey func(o sentry.Organization, p sentry.Project, k sentry.Key, name string) (sentry.Key, error)
-	getClientKeys   func(o sentry.Organization, p sentry.Project) ([]sentry.Key, error)
+	getProjectInboundFilters          func(o sentry.Organization, p sentry.Project) ([]projectInboundFilter, error)
+	updateProjectInboundFilter        func(o sentry.Organization, p sentry.Project, id string, active bool) error
+	updateProjectLegacyBrowsersFilter func(o sentry.Organization, p sentry.Project, browsers []string) error
+	updateProjectOptions              func(o sentry.Organization, p sentry.Project, options map[string]interface{}) error
+
+	getProjectOwnership    func(o sentry.Organization, p sentry.Project) (projectOwnership, error)
+	updateProjectOwnership func(o sentry.Organization, p sentry.Project, ownership projectOwnership) (projectOwnership, error)
+
+	getProjectEnvironment    func(o sentry.Organization, p sentry.Project, name string) (projectEnvironment, error)
+	updateProjectEnvironment func(o sentry.Organization, p sentry.Project, name string, hidden bool) (projectEnvironment, error)
+
+	createClientKey        func(o sentry.Organization, p sentry.Project, name string) (sentry.Key, error)
+	deleteClientKey        func(o sentry.Organization, p sentry.Project, k sentry.Key) error
+	updateClientKey        func(o sentry.Organization, p sentry.Project, k sentry.Key, name string) (sentry.Key, error)
+	getClientKeys          func(o sentry.Organization, p sentry.Project) ([]sentry.Key, error)
+	getClientKeyDetails    func(o sentry.Organization, p sentry.Project, id string) (clientKey, error)
+	updateClientKeyDetails func(o sentry.Organization, p sentry.Project, k clientKey) (clientKey, error)
 
 	getOrganization func(orgslug string) (sentry.Organization, error)
 
-	getTeam func(o sentry.Organization, teamSlug string) (sentry.Team, error)
+	createTeam func(o sentry.Organization, name string, slug *string) (sentry.Team, error)
+	getTeam    func(o sentry.Organization, teamSlug string) (sentry.Team, error)
+	updateTeam func(o sentry.Organization, t sentry.Team) error
+	deleteTeam func(o sentry.Organization, t sentry.Team) error
+
+	createOrganizationMember func(o sentry.Organization, m organizationMember) (organizationMember, error)
+	getOrganizationMember    func(o sentry.Organization, id string) (organizationMember, error)
+	updateOrganizationMember func(o sentry.Organization, m organizationMember) (organizationMember, error)
+	deleteOrganizationMember func(o sentry.Organization, id string) error
+	addTeamMember            func(o sentry.Organization, memberID, teamSlug string) error
+	removeTeamMember         func(o sentry.Organization, memberID, teamSlug string) error
+
+	createIssueAlertRule func(o sentry.Organization, p sentry.Project, r issueAlertRule) (issueAlertRule, error)
+	getIssueAlertRule    func(o sentry.Organization, p sentry.Project, id string) (issueAlertRule, error)
+	updateIssueAlertRule func(o sentry.Organization, p sentry.Project, r issueAlertRule) (issueAlertRule, error)
+	deleteIssueAlertRule func(o sentry.Organization, p sentry.Project, id string) error
+
+	createMetricAlertRule func(o sentry.Organization, r metricAlertRule) (metricAlertRule, error)
+	getMetricAlertRule    func(o sentry.Organization, id string) (metricAlertRule, error)
+	updateMetricAlertRule func(o sentry.Organization, r metricAlertRule) (metricAlertRule, error)
+	deleteMetricAlertRule func(o sentry.Organization, id string) error
+
+	createRelease       func(o sentry.Organization, r release) (release, error)
+	getRelease          func(o sentry.Organization, version string) (release, error)
+	updateRelease       func(o sentry.Organization, r release) (release, error)
+	deleteRelease       func(o sentry.Organization, version string) error
+	createReleaseDeploy func(o sentry.Organization, version string, d releaseDeploy) (releaseDeploy, error)
+	getReleaseDeploys   func(o sentry.Organization, version string) ([]releaseDeploy, error)
+
+	createMonitor func(o sentry.Organization, mon monitor) (monitor, error)
+	getMonitor    func(o sentry.Organization, slug string) (monitor, error)
+	updateMonitor func(o sentry.Organization, mon monitor) (monitor, error)
+	deleteMonitor func(o sentry.Organization, slug string) error
+
+	createServiceHook func(o sentry.Organization, p sentry.Project, h serviceHook) (serviceHook, error)
+	getServiceHook    func(o sentry.Organization, p sentry.Project, id string) (serviceHook, error)
+	updateServiceHook func(o sentry.Organization, p sentry.Project, h serviceHook) (serviceHook, error)
+	deleteServiceHook func(o sentry.Organization, p sentry.Project, id string) error
+
+	getOrganizationSettings    func(o sentry.Organization) (organizationSettings, error)
+	updateOrganizationSettings func(o sentry.Organization, settings organizationSettings) (organizationSettings, error)
+
+	createSavedSearch func(o sentry.Organization, s savedSearch) (savedSearch, error)
+	getSavedSearch    func(o sentry.Organization, id string) (savedSearch, error)
+	updateSavedSearch func(o sentry.Organization, s savedSearch) (savedSearch, error)
+	deleteSavedSearch func(o sentry.Organization, id string) error
+	pinSavedSearch    func(o sentry.Organization, s savedSearch) error
+	unpinSavedSearch  func(o sentry.Organization) error
+
+	createDashboard func(o sentry.Organization, d dashboard) (dashboard, error)
+	getDashboard    func(o sentry.Organization, id string) (dashboard, error)
+	updateDashboard func(o sentry.Organization, d dashboard) (dashboard, error)
+	deleteDashboard func(o sentry.Organization, id string) error
+
+	getOrganizationIntegrations func(o sentry.Organization, providerKey string) ([]organizationIntegration, error)
+
+	createRepository func(o sentry.Organization, i organizationIntegration, name string) (repository, error)
+	getRepository    func(o sentry.Organization, id string) (repository, error)
+	deleteRepository func(o sentry.Organization, id string) error
 }
 
 func (m *sentryClientMock) CreateProject(o sentry.Organization, t sentry.Team, name string, slug *string) (sentry.Project, error) {
@@ -55,6 +215,66 @@ func (m *sentryClientMock) DeleteProject(o sentry.Organization, p sentry.Project
 	return m.deleteProject(o, p)
 }
 
+func (m *sentryClientMock) GetProjectByID(o sentry.Organization, id string) (sentry.Project, error) {
+	return m.getProjectByID(o, id)
+}
+
+func (m *sentryClientMock) RenameProject(o sentry.Organization, p sentry.Project, newSlug string) error {
+	return m.renameProject(o, p, newSlug)
+}
+
+func (m *sentryClientMock) GetProjectTeams(o sentry.Organization, p sentry.Project) ([]sentry.Team, error) {
+	return m.getProjectTeams(o, p)
+}
+
+func (m *sentryClientMock) AddProjectTeam(o sentry.Organization, p sentry.Project, teamSlug string) error {
+	return m.addProjectTeam(o, p, teamSlug)
+}
+
+func (m *sentryClientMock) RemoveProjectTeam(o sentry.Organization, p sentry.Project, teamSlug string) error {
+	return m.removeProjectTeam(o, p, teamSlug)
+}
+
+func (m *sentryClientMock) GetProjectSettings(o sentry.Organization, p sentry.Project) (projectSettings, error) {
+	return m.getProjectSettings(o, p)
+}
+
+func (m *sentryClientMock) UpdateProjectSettings(o sentry.Organization, p sentry.Project, settings projectSettings) error {
+	return m.updateProjectSettings(o, p, settings)
+}
+
+func (m *sentryClientMock) GetProjectInboundFilters(o sentry.Organization, p sentry.Project) ([]projectInboundFilter, error) {
+	return m.getProjectInboundFilters(o, p)
+}
+
+func (m *sentryClientMock) UpdateProjectInboundFilter(o sentry.Organization, p sentry.Project, id string, active bool) error {
+	return m.updateProjectInboundFilter(o, p, id, active)
+}
+
+func (m *sentryClientMock) UpdateProjectLegacyBrowsersFilter(o sentry.Organization, p sentry.Project, browsers []string) error {
+	return m.updateProjectLegacyBrowsersFilter(o, p, browsers)
+}
+
+func (m *sentryClientMock) UpdateProjectOptions(o sentry.Organization, p sentry.Project, options map[string]interface{}) error {
+	return m.updateProjectOptions(o, p, options)
+}
+
+func (m *sentryClientMock) GetProjectOwnership(o sentry.Organization, p sentry.Project) (projectOwnership, error) {
+	return m.getProjectOwnership(o, p)
+}
+
+func (m *sentryClientMock) UpdateProjectOwnership(o sentry.Organization, p sentry.Project, ownership projectOwnership) (projectOwnership, error) {
+	return m.updateProjectOwnership(o, p, ownership)
+}
+
+func (m *sentryClientMock) GetProjectEnvironment(o sentry.Organization, p sentry.Project, name string) (projectEnvironment, error) {
+	return m.getProjectEnvironment(o, p, name)
+}
+
+func (m *sentryClientMock) UpdateProjectEnvironment(o sentry.Organization, p sentry.Project, name string, hidden bool) (projectEnvironment, error) {
+	return m.updateProjectEnvironment(o, p, name, hidden)
+}
+
 func (m *sentryClientMock) CreateClientKey(o sentry.Organization, p sentry.Project, name string) (sentry.Key, error) {
 	return m.createClientKey(o, p, name)
 }
@@ -71,10 +291,213 @@ func (m *sentryClientMock) GetClientKeys(o sentry.Organization, p sentry.Project
 	return m.getClientKeys(o, p)
 }
 
+func (m *sentryClientMock) GetClientKeyDetails(o sentry.Organization, p sentry.Project, id string) (clientKey, error) {
+	return m.getClientKeyDetails(o, p, id)
+}
+
+func (m *sentryClientMock) UpdateClientKeyDetails(o sentry.Organization, p sentry.Project, k clientKey) (clientKey, error) {
+	return m.updateClientKeyDetails(o, p, k)
+}
+
 func (m *sentryClientMock) GetOrganization(orgslug string) (sentry.Organization, error) {
 	return m.getOrganization(orgslug)
 }
 
+func (m *sentryClientMock) CreateTeam(o sentry.Organization, name string, slug *string) (sentry.Team, error) {
+	return m.createTeam(o, name, slug)
+}
+
 func (m *sentryClientMock) GetTeam(o sentry.Organization, teamSlug string) (sentry.Team, error) {
 	return m.getTeam(o, teamSlug)
 }
+
+func (m *sentryClientMock) UpdateTeam(o sentry.Organization, t sentry.Team) error {
+	return m.updateTeam(o, t)
+}
+
+func (m *sentryClientMock) DeleteTeam(o sentry.Organization, t sentry.Team) error {
+	return m.deleteTeam(o, t)
+}
+
+func (m *sentryClientMock) CreateOrganizationMember(o sentry.Organization, member organizationMember) (organizationMember, error) {
+	return m.createOrganizationMember(o, member)
+}
+
+func (m *sentryClientMock) GetOrganizationMember(o sentry.Organization, id string) (organizationMember, error) {
+	return m.getOrganizationMember(o, id)
+}
+
+func (m *sentryClientMock) UpdateOrganizationMember(o sentry.Organization, member organizationMember) (organizationMember, error) {
+	return m.updateOrganizationMember(o, member)
+}
+
+func (m *sentryClientMock) DeleteOrganizationMember(o sentry.Organization, id string) error {
+	return m.deleteOrganizationMember(o, id)
+}
+
+func (m *sentryClientMock) AddTeamMember(o sentry.Organization, memberID, teamSlug string) error {
+	return m.addTeamMember(o, memberID, teamSlug)
+}
+
+func (m *sentryClientMock) RemoveTeamMember(o sentry.Organization, memberID, teamSlug string) error {
+	return m.removeTeamMember(o, memberID, teamSlug)
+}
+
+func (m *sentryClientMock) CreateIssueAlertRule(o sentry.Organization, p sentry.Project, r issueAlertRule) (issueAlertRule, error) {
+	return m.createIssueAlertRule(o, p, r)
+}
+
+func (m *sentryClientMock) GetIssueAlertRule(o sentry.Organization, p sentry.Project, id string) (issueAlertRule, error) {
+	return m.getIssueAlertRule(o, p, id)
+}
+
+func (m *sentryClientMock) UpdateIssueAlertRule(o sentry.Organization, p sentry.Project, r issueAlertRule) (issueAlertRule, error) {
+	return m.updateIssueAlertRule(o, p, r)
+}
+
+func (m *sentryClientMock) DeleteIssueAlertRule(o sentry.Organization, p sentry.Project, id string) error {
+	return m.deleteIssueAlertRule(o, p, id)
+}
+
+func (m *sentryClientMock) CreateMetricAlertRule(o sentry.Organization, r metricAlertRule) (metricAlertRule, error) {
+	return m.createMetricAlertRule(o, r)
+}
+
+func (m *sentryClientMock) GetMetricAlertRule(o sentry.Organization, id string) (metricAlertRule, error) {
+	return m.getMetricAlertRule(o, id)
+}
+
+func (m *sentryClientMock) UpdateMetricAlertRule(o sentry.Organization, r metricAlertRule) (metricAlertRule, error) {
+	return m.updateMetricAlertRule(o, r)
+}
+
+func (m *sentryClientMock) DeleteMetricAlertRule(o sentry.Organization, id string) error {
+	return m.deleteMetricAlertRule(o, id)
+}
+
+func (m *sentryClientMock) CreateRelease(o sentry.Organization, r release) (release, error) {
+	return m.createRelease(o, r)
+}
+
+func (m *sentryClientMock) GetRelease(o sentry.Organization, version string) (release, error) {
+	return m.getRelease(o, version)
+}
+
+func (m *sentryClientMock) UpdateRelease(o sentry.Organization, r release) (release, error) {
+	return m.updateRelease(o, r)
+}
+
+func (m *sentryClientMock) DeleteRelease(o sentry.Organization, version string) error {
+	return m.deleteRelease(o, version)
+}
+
+func (m *sentryClientMock) CreateReleaseDeploy(o sentry.Organization, version string, d releaseDeploy) (releaseDeploy, error) {
+	return m.createReleaseDeploy(o, version, d)
+}
+
+func (m *sentryClientMock) GetReleaseDeploys(o sentry.Organization, version string) ([]releaseDeploy, error) {
+	return m.getReleaseDeploys(o, version)
+}
+
+func (m *sentryClientMock) CreateMonitor(o sentry.Organization, mon monitor) (monitor, error) {
+	return m.createMonitor(o, mon)
+}
+
+func (m *sentryClientMock) GetMonitor(o sentry.Organization, slug string) (monitor, error) {
+	return m.getMonitor(o, slug)
+}
+
+func (m *sentryClientMock) UpdateMonitor(o sentry.Organization, mon monitor) (monitor, error) {
+	return m.updateMonitor(o, mon)
+}
+
+func (m *sentryClientMock) DeleteMonitor(o sentry.Organization, slug string) error {
+	return m.deleteMonitor(o, slug)
+}
+
+func (m *sentryClientMock) CreateServiceHook(o sentry.Organization, p sentry.Project, h serviceHook) (serviceHook, error) {
+	return m.createServiceHook(o, p, h)
+}
+
+func (m *sentryClientMock) GetServiceHook(o sentry.Organization, p sentry.Project, id string) (serviceHook, error) {
+	return m.getServiceHook(o, p, id)
+}
+
+func (m *sentryClientMock) UpdateServiceHook(o sentry.Organization, p sentry.Project, h serviceHook) (serviceHook, error) {
+	return m.updateServiceHook(o, p, h)
+}
+
+func (m *sentryClientMock) DeleteServiceHook(o sentry.Organization, p sentry.Project, id string) error {
+	return m.deleteServiceHook(o, p, id)
+}
+
+func (m *sentryClientMock) GetOrganizationSettings(o sentry.Organization) (organizationSettings, error) {
+	return m.getOrganizationSettings(o)
+}
+
+func (m *sentryClientMock) UpdateOrganizationSettings(o sentry.Organization, settings organizationSettings) (organizationSettings, error) {
+	return m.updateOrganizationSettings(o, settings)
+}
+
+func (m *sentryClientMock) CreateSavedSearch(o sentry.Organization, s savedSearch) (savedSearch, error) {
+	return m.createSavedSearch(o, s)
+}
+
+func (m *sentryClientMock) GetSavedSearch(o sentry.Organization, id string) (savedSearch, error) {
+	return m.getSavedSearch(o, id)
+}
+
+func (m *sentryClientMock) UpdateSavedSearch(o sentry.Organization, s savedSearch) (savedSearch, error) {
+	return m.updateSavedSearch(o, s)
+}
+
+func (m *sentryClientMock) DeleteSavedSearch(o sentry.Organization, id string) error {
+	return m.deleteSavedSearch(o, id)
+}
+
+func (m *sentryClientMock) PinSavedSearch(o sentry.Organization, s savedSearch) error {
+	return m.pinSavedSearch(o, s)
+}
+
+func (m *sentryClientMock) UnpinSavedSearch(o sentry.Organization) error {
+	return m.unpinSavedSearch(o)
+}
+
+func (m *sentryClientMock) CreateDashboard(o sentry.Organization, d dashboard) (dashboard, error) {
+	return m.createDashboard(o, d)
+}
+
+func (m *sentryClientMock) GetDashboard(o sentry.Organization, id string) (dashboard, error) {
+	return m.getDashboard(o, id)
+}
+
+func (m *sentryClientMock) UpdateDashboard(o sentry.Organization, d dashboard) (dashboard, error) {
+	return m.updateDashboard(o, d)
+}
+
+func (m *sentryClientMock) DeleteDashboard(o sentry.Organization, id string) error {
+	return m.deleteDashboard(o, id)
+}
+
+func (m *sentryClientMock) GetOrganizationIntegrations(o sentry.Organization, providerKey string) ([]organizationIntegration, error) {
+	return m.getOrganizationIntegrations(o, providerKey)
+}
+
+func (m *sentryClientMock) CreateRepository(o sentry.Organization, i organizationIntegration, name string) (repository, error) {
+	return m.createRepository(o, i, name)
+}
+
+func (m *sentryClientMock) GetRepository(o sentry.Organization, id string) (repository, error) {
+	return m.getRepository(o, id)
+}
+
+func (m *sentryClientMock) DeleteRepository(o sentry.Organization, id string) error {
+	return m.deleteRepository(o, id)
+}
+
+// isNotFound checks for the error returned by Sentry for missing resources,
+// even when wrapped.
+func isNotFound(err error) bool {
+	var apiError sentry.APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == 404
+}
@@ -0,0 +1,976 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// apiClient extends sentry.Client with the endpoints and fields that the
+// go-sentry-api library does not support yet.
+type apiClient struct {
+	*sentry.Client
+}
+
+func newAPIClient(authToken string, endpoint *string) (*apiClient, error) {
+	client, err := sentry.NewClient(authToken, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &apiClient{Client: client}, nil
+}
+
+// do sends a request to the Sentry API the same way sentry.Client does it:
+// in is encoded as the JSON body if not nil, the JSON response is decoded
+// into out if not nil, and non-2xx responses are returned as sentry.APIError.
+func (c *apiClient) do(method, endpoint string, out, in interface{}) error {
+	_, err := c.send(method, endpoint, out, in)
+	return err
+}
+
+// send is do returning the headers of the response too.
+func (c *apiClient) send(method, endpoint string, out, in interface{}) (http.Header, error) {
+	var body io.Reader
+	if in != nil {
+		encoded, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	path, query := endpoint, ""
+	if i := strings.Index(endpoint, "?"); i >= 0 {
+		path, query = endpoint[:i], endpoint[i:]
+	}
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+	req, err := http.NewRequest(method, c.Endpoint+path+query, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.AuthToken))
+	req.Header.Add("Accept", "application/json")
+	req.Close = true
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(resp.Body)
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiError := sentry.APIError{StatusCode: resp.StatusCode}
+		if err := json.Unmarshal(respBody, &apiError); err != nil {
+			apiError.Detail = string(respBody)
+		}
+		return nil, apiError
+	}
+	if out != nil && len(respBody) > 0 {
+		return resp.Header, json.Unmarshal(respBody, out)
+	}
+	return resp.Header, nil
+}
+
+// list fetches all the pages of a list endpoint into out, a pointer to a
+// slice.  Sentry returns at most 100 results per page, and the cursor of the
+// next page in the Link header.
+func (c *apiClient) list(endpoint string, out interface{}) error {
+	var all []json.RawMessage
+	for endpoint != "" {
+		var page []json.RawMessage
+		header, err := c.send(http.MethodGet, endpoint, &page, nil)
+		if err != nil {
+			return err
+		}
+		all = append(all, page...)
+		endpoint = nextPageEndpoint(endpoint, header.Get("Link"))
+	}
+	if len(all) == 0 {
+		return nil
+	}
+	encoded, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, out)
+}
+
+// nextPageEndpoint returns the endpoint of the page following the one
+// fetched from endpoint, or "" if it was the last one.  Sentry's Link headers
+// look like:
+//
+//	<https://sentry.io/api/0/...&cursor=100:0:1>; rel="previous"; results="false"; cursor="100:0:1",
+//	<https://sentry.io/api/0/...&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"
+func nextPageEndpoint(endpoint, link string) string {
+	for _, l := range strings.Split(link, ",") {
+		attrs := map[string]string{}
+		for _, attr := range strings.Split(l, ";")[1:] {
+			if i := strings.Index(attr, "="); i >= 0 {
+				attrs[strings.TrimSpace(attr[:i])] = strings.Trim(strings.TrimSpace(attr[i+1:]), `"`)
+			}
+		}
+		if attrs["rel"] != "next" || attrs["results"] != "true" || attrs["cursor"] == "" {
+			continue
+		}
+		path, query := endpoint, url.Values{}
+		if i := strings.Index(endpoint, "?"); i >= 0 {
+			path = endpoint[:i]
+			query, _ = url.ParseQuery(endpoint[i+1:])
+		}
+		query.Set("cursor", attrs["cursor"])
+		return path + "?" + query.Encode()
+	}
+	return ""
+}
+
+// VerifyCredentials checks that the Sentry API can be reached and accepts the
+// auth token, so that misconfiguration is reported before any resource
+// operation.
+func (c *apiClient) VerifyCredentials() error {
+	orgs := make([]sentry.Organization, 0)
+	err := c.do(http.MethodGet, "organizations", &orgs, nil)
+	if apiError, ok := err.(sentry.APIError); ok && (apiError.StatusCode == http.StatusUnauthorized || apiError.StatusCode == http.StatusForbidden) {
+		return fmt.Errorf("authentication to Sentry at %s failed, check the sentry:token configuration: %v", c.Endpoint, err)
+	}
+	if err != nil {
+		return fmt.Errorf("could not connect to Sentry at %s: %v", c.Endpoint, err)
+	}
+	return nil
+}
+
+// GetProjectByID finds a project in the organization by its numeric ID,
+// returning a 404 sentry.APIError if there is no such project.
+func (c *apiClient) GetProjectByID(o sentry.Organization, id string) (sentry.Project, error) {
+	projects := make([]sentry.Project, 0)
+	query := url.Values{"query": {"id:" + id}}
+	err := c.do(http.MethodGet, fmt.Sprintf("organizations/%s/projects?%s", *o.Slug, query.Encode()), &projects, nil)
+	if err != nil {
+		return sentry.Project{}, err
+	}
+	for _, project := range projects {
+		if project.ID == id {
+			return project, nil
+		}
+	}
+	return sentry.Project{}, sentry.APIError{StatusCode: http.StatusNotFound, Detail: fmt.Sprintf("no project with ID %s", id)}
+}
+
+// RenameProject changes the slug of a project.
+func (c *apiClient) RenameProject(o sentry.Organization, p sentry.Project, newSlug string) error {
+	req := struct {
+		Slug string `json:"slug"`
+	}{newSlug}
+	return c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s", *o.Slug, *p.Slug), nil, &req)
+}
+
+// projectOwnership is the ownership rules of a project, and how they are used
+// to assign issues.
+type projectOwnership struct {
+	Raw            string `json:"raw"`
+	Fallthrough    bool   `json:"fallthrough"`
+	AutoAssignment bool   `json:"autoAssignment"`
+}
+
+// GetProjectOwnership fetches the ownership rules of a project.
+func (c *apiClient) GetProjectOwnership(o sentry.Organization, p sentry.Project) (projectOwnership, error) {
+	var ownership projectOwnership
+	err := c.do(http.MethodGet, fmt.Sprintf("projects/%s/%s/ownership", *o.Slug, *p.Slug), &ownership, nil)
+	return ownership, err
+}
+
+// UpdateProjectOwnership replaces the ownership rules of a project.
+func (c *apiClient) UpdateProjectOwnership(o sentry.Organization, p sentry.Project, ownership projectOwnership) (projectOwnership, error) {
+	var updated projectOwnership
+	err := c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s/ownership", *o.Slug, *p.Slug), &updated, &ownership)
+	return updated, err
+}
+
+// projectSettings are the data scrubbing and security settings of a project,
+// which sentry.Project lacks.  Settings left nil are not changed by
+// UpdateProjectSettings.
+type projectSettings struct {
+	DataScrubber         *bool     `json:"dataScrubber,omitempty"`
+	DataScrubberDefaults *bool     `json:"dataScrubberDefaults,omitempty"`
+	SensitiveFields      *[]string `json:"sensitiveFields,omitempty"`
+	SafeFields           *[]string `json:"safeFields,omitempty"`
+	ScrubIPAddresses     *bool     `json:"scrubIPAddresses,omitempty"`
+	ScrapeJavaScript     *bool     `json:"scrapeJavaScript,omitempty"`
+	AllowedDomains       *[]string `json:"allowedDomains,omitempty"`
+	SecurityToken        *string   `json:"securityToken,omitempty"`
+	ResolveAge           *int      `json:"resolveAge,omitempty"`
+}
+
+// GetProjectSettings fetches the data scrubbing and security settings of a
+// project.
+func (c *apiClient) GetProjectSettings(o sentry.Organization, p sentry.Project) (projectSettings, error) {
+	var settings projectSettings
+	err := c.do(http.MethodGet, fmt.Sprintf("projects/%s/%s", *o.Slug, *p.Slug), &settings, nil)
+	return settings, err
+}
+
+// UpdateProjectSettings changes the data scrubbing and security settings of
+// a project.
+func (c *apiClient) UpdateProjectSettings(o sentry.Organization, p sentry.Project, settings projectSettings) error {
+	return c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s", *o.Slug, *p.Slug), nil, &settings)
+}
+
+// organizationSettings are the settings of an organization, which
+// sentry.Organization lacks.  Settings left nil are not changed by
+// UpdateOrganizationSettings.
+type organizationSettings struct {
+	Name                 *string   `json:"name,omitempty"`
+	DefaultRole          *string   `json:"defaultRole,omitempty"`
+	OpenMembership       *bool     `json:"openMembership,omitempty"`
+	Require2FA           *bool     `json:"require2FA,omitempty"`
+	DataScrubber         *bool     `json:"dataScrubber,omitempty"`
+	DataScrubberDefaults *bool     `json:"dataScrubberDefaults,omitempty"`
+	SensitiveFields      *[]string `json:"sensitiveFields,omitempty"`
+	SafeFields           *[]string `json:"safeFields,omitempty"`
+	ScrubIPAddresses     *bool     `json:"scrubIPAddresses,omitempty"`
+	AllowSharedIssues    *bool     `json:"allowSharedIssues,omitempty"`
+	EnhancedPrivacy      *bool     `json:"enhancedPrivacy,omitempty"`
+}
+
+// GetOrganizationSettings fetches the settings of an organization.
+func (c *apiClient) GetOrganizationSettings(o sentry.Organization) (organizationSettings, error) {
+	var settings organizationSettings
+	err := c.do(http.MethodGet, fmt.Sprintf("organizations/%s", *o.Slug), &settings, nil)
+	return settings, err
+}
+
+// UpdateOrganizationSettings changes the settings of an organization,
+// returning all of them.
+func (c *apiClient) UpdateOrganizationSettings(o sentry.Organization, settings organizationSettings) (organizationSettings, error) {
+	var updated organizationSettings
+	err := c.do(http.MethodPut, fmt.Sprintf("organizations/%s", *o.Slug), &updated, &settings)
+	return updated, err
+}
+
+// clientKeyRateLimit is the number of events a client key can send in a
+// window of seconds.
+type clientKeyRateLimit struct {
+	Window int `json:"window"`
+	Count  int `json:"count"`
+}
+
+// clientKeyDSN is sentry.DSN with the security endpoint added.
+type clientKeyDSN struct {
+	Public   string `json:"public,omitempty"`
+	Secret   string `json:"secret,omitempty"`
+	CSP      string `json:"csp,omitempty"`
+	Security string `json:"security,omitempty"`
+}
+
+// clientKey is sentry.Key with the settings that can be changed on it.
+type clientKey struct {
+	ID        string              `json:"id,omitempty"`
+	Name      string              `json:"name"`
+	IsActive  bool                `json:"isActive"`
+	RateLimit *clientKeyRateLimit `json:"rateLimit"`
+	DSN       clientKeyDSN        `json:"dsn"`
+}
+
+// GetClientKeyDetails fetches a single client key of a project.
+func (c *apiClient) GetClientKeyDetails(o sentry.Organization, p sentry.Project, id string) (clientKey, error) {
+	var key clientKey
+	err := c.do(http.MethodGet, fmt.Sprintf("projects/%s/%s/keys/%s", *o.Slug, *p.Slug, id), &key, nil)
+	return key, err
+}
+
+// UpdateClientKeyDetails updates the name, active flag and rate limit of a
+// client key; unlike sentry.Client.UpdateClientKey, which only changes the
+// name.
+func (c *apiClient) UpdateClientKeyDetails(o sentry.Organization, p sentry.Project, k clientKey) (clientKey, error) {
+	req := struct {
+		Name      string              `json:"name"`
+		IsActive  bool                `json:"isActive"`
+		RateLimit *clientKeyRateLimit `json:"rateLimit"`
+	}{k.Name, k.IsActive, k.RateLimit}
+	var key clientKey
+	err := c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s/keys/%s", *o.Slug, *p.Slug, k.ID), &key, &req)
+	return key, err
+}
+
+// GetProjectTeams fetches the teams with access to a project.
+func (c *apiClient) GetProjectTeams(o sentry.Organization, p sentry.Project) ([]sentry.Team, error) {
+	teams := make([]sentry.Team, 0)
+	err := c.list(fmt.Sprintf("projects/%s/%s/teams", *o.Slug, *p.Slug), &teams)
+	return teams, err
+}
+
+// AddProjectTeam gives a team access to a project.
+func (c *apiClient) AddProjectTeam(o sentry.Organization, p sentry.Project, teamSlug string) error {
+	return c.do(http.MethodPost, fmt.Sprintf("projects/%s/%s/teams/%s", *o.Slug, *p.Slug, teamSlug), nil, nil)
+}
+
+// RemoveProjectTeam revokes a team's access to a project.
+func (c *apiClient) RemoveProjectTeam(o sentry.Organization, p sentry.Project, teamSlug string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("projects/%s/%s/teams/%s", *o.Slug, *p.Slug, teamSlug), nil, nil)
+}
+
+// projectEnvironment is an environment of a project, created by Sentry when
+// events are first sent from it.
+type projectEnvironment struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	IsHidden bool   `json:"isHidden"`
+}
+
+// GetProjectEnvironment fetches an environment of a project by name.
+func (c *apiClient) GetProjectEnvironment(o sentry.Organization, p sentry.Project, name string) (projectEnvironment, error) {
+	var environment projectEnvironment
+	err := c.do(http.MethodGet, fmt.Sprintf("projects/%s/%s/environments/%s", *o.Slug, *p.Slug, url.PathEscape(name)), &environment, nil)
+	return environment, err
+}
+
+// UpdateProjectEnvironment hides an environment of a project, or shows it
+// again.
+func (c *apiClient) UpdateProjectEnvironment(o sentry.Organization, p sentry.Project, name string, hidden bool) (projectEnvironment, error) {
+	req := struct {
+		IsHidden bool `json:"isHidden"`
+	}{hidden}
+	var environment projectEnvironment
+	err := c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s/environments/%s", *o.Slug, *p.Slug, url.PathEscape(name)), &environment, &req)
+	return environment, err
+}
+
+// organizationMember is a user of an organization, or an invitation to join
+// it while Pending.
+type organizationMember struct {
+	ID      string   `json:"id,omitempty"`
+	Email   string   `json:"email"`
+	Role    string   `json:"role"`
+	Teams   []string `json:"teams,omitempty"`
+	Pending bool     `json:"pending,omitempty"`
+}
+
+// CreateOrganizationMember invites a user to an organization by email.
+func (c *apiClient) CreateOrganizationMember(o sentry.Organization, m organizationMember) (organizationMember, error) {
+	var member organizationMember
+	err := c.do(http.MethodPost, fmt.Sprintf("organizations/%s/members", *o.Slug), &member, &m)
+	return member, err
+}
+
+// GetOrganizationMember fetches a member of an organization, with the slugs
+// of its teams.
+func (c *apiClient) GetOrganizationMember(o sentry.Organization, id string) (organizationMember, error) {
+	var member organizationMember
+	err := c.do(http.MethodGet, fmt.Sprintf("organizations/%s/members/%s", *o.Slug, id), &member, nil)
+	return member, err
+}
+
+// UpdateOrganizationMember changes the role of a member, and its teams unless
+// they are nil.
+func (c *apiClient) UpdateOrganizationMember(o sentry.Organization, m organizationMember) (organizationMember, error) {
+	req := struct {
+		Role  string    `json:"role"`
+		Teams *[]string `json:"teams,omitempty"`
+	}{Role: m.Role}
+	if m.Teams != nil {
+		req.Teams = &m.Teams
+	}
+	var member organizationMember
+	err := c.do(http.MethodPut, fmt.Sprintf("organizations/%s/members/%s", *o.Slug, m.ID), &member, &req)
+	return member, err
+}
+
+// DeleteOrganizationMember removes a member from an organization, or cancels
+// its invitation.
+func (c *apiClient) DeleteOrganizationMember(o sentry.Organization, id string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/members/%s", *o.Slug, id), nil, nil)
+}
+
+// AddTeamMember adds a member of an organization to one of its teams.
+func (c *apiClient) AddTeamMember(o sentry.Organization, memberID, teamSlug string) error {
+	return c.do(http.MethodPost, fmt.Sprintf("organizations/%s/members/%s/teams/%s", *o.Slug, memberID, teamSlug), nil, nil)
+}
+
+// RemoveTeamMember removes a member of an organization from one of its teams.
+func (c *apiClient) RemoveTeamMember(o sentry.Organization, memberID, teamSlug string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/members/%s/teams/%s", *o.Slug, memberID, teamSlug), nil, nil)
+}
+
+// release is a version of the code deployed in projects of an organization;
+// sentry.Release lacks the projects and refs.
+type release struct {
+	Version      string       `json:"version"`
+	Projects     []string     `json:"projects"`
+	Ref          *string      `json:"ref,omitempty"`
+	URL          *string      `json:"url,omitempty"`
+	DateReleased *time.Time   `json:"dateReleased,omitempty"`
+	DateCreated  *time.Time   `json:"dateCreated,omitempty"`
+	Refs         []releaseRef `json:"refs,omitempty"`
+}
+
+// releaseRef points a release to a commit of a repository of the
+// organization, for Sentry to associate the commits since previousCommit, or
+// since the last release, with it.
+type releaseRef struct {
+	Repository     string `json:"repository"`
+	Commit         string `json:"commit"`
+	PreviousCommit string `json:"previousCommit,omitempty"`
+}
+
+// releaseResponse is how Sentry returns releases, with projects as objects.
+type releaseResponse struct {
+	release
+	Projects []struct {
+		Slug string `json:"slug"`
+	} `json:"projects"`
+}
+
+func (r releaseResponse) toRelease() release {
+	ret := r.release
+	ret.Projects = []string{}
+	for _, project := range r.Projects {
+		ret.Projects = append(ret.Projects, project.Slug)
+	}
+	return ret
+}
+
+// CreateRelease creates a release in projects of an organization.  If the
+// release exists, the projects are added to it.
+func (c *apiClient) CreateRelease(o sentry.Organization, r release) (release, error) {
+	var resp releaseResponse
+	err := c.do(http.MethodPost, fmt.Sprintf("organizations/%s/releases", *o.Slug), &resp, &r)
+	return resp.toRelease(), err
+}
+
+// GetRelease fetches a release of an organization.
+func (c *apiClient) GetRelease(o sentry.Organization, version string) (release, error) {
+	var resp releaseResponse
+	err := c.do(http.MethodGet, fmt.Sprintf("organizations/%s/releases/%s", *o.Slug, url.PathEscape(version)), &resp, nil)
+	return resp.toRelease(), err
+}
+
+// UpdateRelease changes the ref, url, release date and refs of a release;
+// projects are added by CreateRelease.
+func (c *apiClient) UpdateRelease(o sentry.Organization, r release) (release, error) {
+	req := struct {
+		Ref          *string      `json:"ref"`
+		URL          *string      `json:"url"`
+		DateReleased *time.Time   `json:"dateReleased,omitempty"`
+		Refs         []releaseRef `json:"refs,omitempty"`
+	}{r.Ref, r.URL, r.DateReleased, r.Refs}
+	var resp releaseResponse
+	err := c.do(http.MethodPut, fmt.Sprintf("organizations/%s/releases/%s", *o.Slug, url.PathEscape(r.Version)), &resp, &req)
+	return resp.toRelease(), err
+}
+
+// DeleteRelease deletes a release, which Sentry refuses for releases that
+// have events.
+func (c *apiClient) DeleteRelease(o sentry.Organization, version string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/releases/%s", *o.Slug, url.PathEscape(version)), nil, nil)
+}
+
+// releaseDeploy records a release deployed to an environment.
+type releaseDeploy struct {
+	ID           string     `json:"id,omitempty"`
+	Environment  string     `json:"environment"`
+	Name         *string    `json:"name,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	DateStarted  *time.Time `json:"dateStarted,omitempty"`
+	DateFinished *time.Time `json:"dateFinished,omitempty"`
+}
+
+// CreateReleaseDeploy records a deploy of a release.  Deploys can't be
+// changed or deleted.
+func (c *apiClient) CreateReleaseDeploy(o sentry.Organization, version string, d releaseDeploy) (releaseDeploy, error) {
+	var deploy releaseDeploy
+	err := c.do(http.MethodPost, fmt.Sprintf("organizations/%s/releases/%s/deploys", *o.Slug, url.PathEscape(version)), &deploy, &d)
+	return deploy, err
+}
+
+// GetReleaseDeploys fetches the deploys of a release.
+func (c *apiClient) GetReleaseDeploys(o sentry.Organization, version string) ([]releaseDeploy, error) {
+	deploys := make([]releaseDeploy, 0)
+	err := c.list(fmt.Sprintf("organizations/%s/releases/%s/deploys", *o.Slug, url.PathEscape(version)), &deploys)
+	return deploys, err
+}
+
+// issueAlertRule is a project's rule creating alerts about issues.
+// Conditions, filters and actions are objects with an "id" naming their type
+// in Sentry, e.g. sentry.rules.conditions.first_seen_event.FirstSeenEventCondition,
+// and settings depending on that type.
+type issueAlertRule struct {
+	ID          string                   `json:"id,omitempty"`
+	Name        string                   `json:"name"`
+	ActionMatch string                   `json:"actionMatch"`
+	FilterMatch string                   `json:"filterMatch,omitempty"`
+	Frequency   int                      `json:"frequency"`
+	Environment *string                  `json:"environment"`
+	Conditions  []map[string]interface{} `json:"conditions"`
+	Filters     []map[string]interface{} `json:"filters"`
+	Actions     []map[string]interface{} `json:"actions"`
+}
+
+// CreateIssueAlertRule creates an issue alert rule in a project.
+func (c *apiClient) CreateIssueAlertRule(o sentry.Organization, p sentry.Project, r issueAlertRule) (issueAlertRule, error) {
+	var rule issueAlertRule
+	err := c.do(http.MethodPost, fmt.Sprintf("projects/%s/%s/rules", *o.Slug, *p.Slug), &rule, &r)
+	return rule, err
+}
+
+// GetIssueAlertRule fetches a single issue alert rule of a project.
+func (c *apiClient) GetIssueAlertRule(o sentry.Organization, p sentry.Project, id string) (issueAlertRule, error) {
+	var rule issueAlertRule
+	err := c.do(http.MethodGet, fmt.Sprintf("projects/%s/%s/rules/%s", *o.Slug, *p.Slug, id), &rule, nil)
+	return rule, err
+}
+
+// UpdateIssueAlertRule replaces the settings of an issue alert rule.
+func (c *apiClient) UpdateIssueAlertRule(o sentry.Organization, p sentry.Project, r issueAlertRule) (issueAlertRule, error) {
+	var rule issueAlertRule
+	err := c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s/rules/%s", *o.Slug, *p.Slug, r.ID), &rule, &r)
+	return rule, err
+}
+
+// DeleteIssueAlertRule deletes an issue alert rule.
+func (c *apiClient) DeleteIssueAlertRule(o sentry.Organization, p sentry.Project, id string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("projects/%s/%s/rules/%s", *o.Slug, *p.Slug, id), nil, nil)
+}
+
+// metricAlertRule is an organization's rule alerting when a metric of a
+// project, e.g. the number of errors or the duration of transactions, crosses
+// the thresholds of its triggers.
+type metricAlertRule struct {
+	ID               string                   `json:"id,omitempty"`
+	Name             string                   `json:"name"`
+	Dataset          string                   `json:"dataset"`
+	Query            string                   `json:"query"`
+	Aggregate        string                   `json:"aggregate"`
+	TimeWindow       float64                  `json:"timeWindow"`
+	ThresholdType    int                      `json:"thresholdType"`
+	ResolveThreshold *float64                 `json:"resolveThreshold"`
+	Environment      *string                  `json:"environment"`
+	Projects         []string                 `json:"projects"`
+	Triggers         []metricAlertRuleTrigger `json:"triggers"`
+}
+
+// metricAlertRuleTrigger is a threshold of a metric alert rule, labelled
+// "critical" or "warning", with the actions run when it's crossed.
+type metricAlertRuleTrigger struct {
+	ID             string                         `json:"id,omitempty"`
+	Label          string                         `json:"label"`
+	AlertThreshold float64                        `json:"alertThreshold"`
+	Actions        []metricAlertRuleTriggerAction `json:"actions"`
+}
+
+// metricAlertRuleTriggerAction notifies a target, e.g. a team by email or a
+// Slack channel, when a trigger fires.
+type metricAlertRuleTriggerAction struct {
+	ID               string `json:"id,omitempty"`
+	Type             string `json:"type"`
+	TargetType       string `json:"targetType"`
+	TargetIdentifier string `json:"targetIdentifier,omitempty"`
+	IntegrationID    *int   `json:"integrationId,omitempty"`
+}
+
+// CreateMetricAlertRule creates a metric alert rule in an organization.
+func (c *apiClient) CreateMetricAlertRule(o sentry.Organization, r metricAlertRule) (metricAlertRule, error) {
+	var rule metricAlertRule
+	err := c.do(http.MethodPost, fmt.Sprintf("organizations/%s/alert-rules", *o.Slug), &rule, &r)
+	return rule, err
+}
+
+// GetMetricAlertRule fetches a single metric alert rule of an organization.
+func (c *apiClient) GetMetricAlertRule(o sentry.Organization, id string) (metricAlertRule, error) {
+	var rule metricAlertRule
+	err := c.do(http.MethodGet, fmt.Sprintf("organizations/%s/alert-rules/%s", *o.Slug, id), &rule, nil)
+	return rule, err
+}
+
+// UpdateMetricAlertRule replaces the settings of a metric alert rule.
+// Triggers and actions without an ID are created, and the ones missing from r
+// are deleted.
+func (c *apiClient) UpdateMetricAlertRule(o sentry.Organization, r metricAlertRule) (metricAlertRule, error) {
+	var rule metricAlertRule
+	err := c.do(http.MethodPut, fmt.Sprintf("organizations/%s/alert-rules/%s", *o.Slug, r.ID), &rule, &r)
+	return rule, err
+}
+
+// DeleteMetricAlertRule deletes a metric alert rule.
+func (c *apiClient) DeleteMetricAlertRule(o sentry.Organization, id string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/alert-rules/%s", *o.Slug, id), nil, nil)
+}
+
+// projectInboundFilter is the state of one of the inbound data filters of a
+// project, e.g. "localhost".  Active is a boolean, except for the
+// "legacy-browsers" filter where it's either false or the list of filtered
+// browsers.
+type projectInboundFilter struct {
+	ID     string          `json:"id"`
+	Active json.RawMessage `json:"active"`
+}
+
+// GetProjectInboundFilters fetches the state of the inbound data filters of a
+// project.
+func (c *apiClient) GetProjectInboundFilters(o sentry.Organization, p sentry.Project) ([]projectInboundFilter, error) {
+	filters := make([]projectInboundFilter, 0)
+	err := c.do(http.MethodGet, fmt.Sprintf("projects/%s/%s/filters", *o.Slug, *p.Slug), &filters, nil)
+	return filters, err
+}
+
+// UpdateProjectInboundFilter turns an inbound data filter of a project on or
+// off.
+func (c *apiClient) UpdateProjectInboundFilter(o sentry.Organization, p sentry.Project, id string, active bool) error {
+	req := struct {
+		Active bool `json:"active"`
+	}{active}
+	return c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s/filters/%s", *o.Slug, *p.Slug, id), nil, &req)
+}
+
+// UpdateProjectLegacyBrowsersFilter sets the legacy browsers filtered out of a
+// project, e.g. "ie_pre_9"; none turns the filter off.
+func (c *apiClient) UpdateProjectLegacyBrowsersFilter(o sentry.Organization, p sentry.Project, browsers []string) error {
+	req := struct {
+		Subfilters []string `json:"subfilters"`
+	}{browsers}
+	return c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s/filters/legacy-browsers", *o.Slug, *p.Slug), nil, &req)
+}
+
+// UpdateProjectOptions sets some of the options of a project, leaving the
+// others as they are.
+func (c *apiClient) UpdateProjectOptions(o sentry.Organization, p sentry.Project, options map[string]interface{}) error {
+	req := struct {
+		Options map[string]interface{} `json:"options"`
+	}{options}
+	return c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s", *o.Slug, *p.Slug), nil, &req)
+}
+
+// monitor is a cron monitor of a project, expecting check-ins from a
+// scheduled job.
+type monitor struct {
+	ID      string        `json:"id,omitempty"`
+	Slug    string        `json:"slug"`
+	Name    string        `json:"name"`
+	Type    string        `json:"type"`
+	Project string        `json:"project"`
+	Config  monitorConfig `json:"config"`
+}
+
+// monitorConfig is the schedule of a monitor: a crontab such as "0 * * * *"
+// when ScheduleType is "crontab", or an interval such as [2, "hour"] when
+// it's "interval".  Margins and runtimes are in minutes.
+type monitorConfig struct {
+	ScheduleType  string      `json:"schedule_type"`
+	Schedule      interface{} `json:"schedule"`
+	CheckinMargin *int        `json:"checkin_margin"`
+	MaxRuntime    *int        `json:"max_runtime"`
+	Timezone      string      `json:"timezone,omitempty"`
+}
+
+// monitorResponse is how Sentry returns monitors, with the project as an
+// object.
+type monitorResponse struct {
+	monitor
+	Project struct {
+		Slug string `json:"slug"`
+	} `json:"project"`
+}
+
+func (r monitorResponse) toMonitor() monitor {
+	ret := r.monitor
+	ret.Project = r.Project.Slug
+	return ret
+}
+
+// CreateMonitor creates a cron monitor in a project of an organization.
+func (c *apiClient) CreateMonitor(o sentry.Organization, m monitor) (monitor, error) {
+	var resp monitorResponse
+	err := c.do(http.MethodPost, fmt.Sprintf("organizations/%s/monitors", *o.Slug), &resp, &m)
+	return resp.toMonitor(), err
+}
+
+// GetMonitor fetches a cron monitor of an organization.
+func (c *apiClient) GetMonitor(o sentry.Organization, slug string) (monitor, error) {
+	var resp monitorResponse
+	err := c.do(http.MethodGet, fmt.Sprintf("organizations/%s/monitors/%s", *o.Slug, slug), &resp, nil)
+	return resp.toMonitor(), err
+}
+
+// UpdateMonitor replaces the name, project and schedule of a cron monitor.
+func (c *apiClient) UpdateMonitor(o sentry.Organization, m monitor) (monitor, error) {
+	var resp monitorResponse
+	err := c.do(http.MethodPut, fmt.Sprintf("organizations/%s/monitors/%s", *o.Slug, m.Slug), &resp, &m)
+	return resp.toMonitor(), err
+}
+
+// DeleteMonitor deletes a cron monitor and its check-ins.
+func (c *apiClient) DeleteMonitor(o sentry.Organization, slug string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/monitors/%s", *o.Slug, slug), nil, nil)
+}
+
+// serviceHook is a webhook of a project, posting events to a URL and signing
+// them with its secret.
+type serviceHook struct {
+	ID     string   `json:"id,omitempty"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret,omitempty"`
+}
+
+// CreateServiceHook creates a service hook in a project.
+func (c *apiClient) CreateServiceHook(o sentry.Organization, p sentry.Project, h serviceHook) (serviceHook, error) {
+	var hook serviceHook
+	err := c.do(http.MethodPost, fmt.Sprintf("projects/%s/%s/hooks", *o.Slug, *p.Slug), &hook, &h)
+	return hook, err
+}
+
+// GetServiceHook fetches a service hook of a project.
+func (c *apiClient) GetServiceHook(o sentry.Organization, p sentry.Project, id string) (serviceHook, error) {
+	var hook serviceHook
+	err := c.do(http.MethodGet, fmt.Sprintf("projects/%s/%s/hooks/%s", *o.Slug, *p.Slug, id), &hook, nil)
+	return hook, err
+}
+
+// UpdateServiceHook replaces the URL and events of a service hook.
+func (c *apiClient) UpdateServiceHook(o sentry.Organization, p sentry.Project, h serviceHook) (serviceHook, error) {
+	req := struct {
+		URL    string   `json:"url"`
+		Events []string `json:"events"`
+	}{h.URL, h.Events}
+	var hook serviceHook
+	err := c.do(http.MethodPut, fmt.Sprintf("projects/%s/%s/hooks/%s", *o.Slug, *p.Slug, h.ID), &hook, &req)
+	return hook, err
+}
+
+// DeleteServiceHook deletes a service hook.
+func (c *apiClient) DeleteServiceHook(o sentry.Organization, p sentry.Project, id string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("projects/%s/%s/hooks/%s", *o.Slug, *p.Slug, id), nil, nil)
+}
+
+// savedSearchTypeIssue is the type of saved searches of issues, as opposed to
+// searches of events.
+const savedSearchTypeIssue = 0
+
+// savedSearch is a search of issues shared in an organization.  Pinning a
+// search makes it the default issue search of the user of the API token.
+type savedSearch struct {
+	ID         string `json:"id,omitempty"`
+	Type       int    `json:"type"`
+	Name       string `json:"name"`
+	Query      string `json:"query"`
+	Sort       string `json:"sort"`
+	Visibility string `json:"visibility"`
+	IsPinned   bool   `json:"isPinned,omitempty"`
+}
+
+// CreateSavedSearch creates a saved search of issues in an organization.
+func (c *apiClient) CreateSavedSearch(o sentry.Organization, s savedSearch) (savedSearch, error) {
+	s.Type = savedSearchTypeIssue
+	var search savedSearch
+	err := c.do(http.MethodPost, fmt.Sprintf("organizations/%s/searches", *o.Slug), &search, &s)
+	return search, err
+}
+
+// GetSavedSearch finds a saved search of issues by its ID, returning a 404
+// sentry.APIError if there is no such search: Sentry only lists them.
+func (c *apiClient) GetSavedSearch(o sentry.Organization, id string) (savedSearch, error) {
+	searches := make([]savedSearch, 0)
+	query := url.Values{"type": {strconv.Itoa(savedSearchTypeIssue)}}
+	err := c.do(http.MethodGet, fmt.Sprintf("organizations/%s/searches?%s", *o.Slug, query.Encode()), &searches, nil)
+	if err != nil {
+		return savedSearch{}, err
+	}
+	for _, search := range searches {
+		if search.ID == id {
+			return search, nil
+		}
+	}
+	return savedSearch{}, sentry.APIError{StatusCode: http.StatusNotFound, Detail: fmt.Sprintf("no saved search with ID %s", id)}
+}
+
+// UpdateSavedSearch replaces the name, query, sort and visibility of a saved
+// search.
+func (c *apiClient) UpdateSavedSearch(o sentry.Organization, s savedSearch) (savedSearch, error) {
+	s.Type = savedSearchTypeIssue
+	var search savedSearch
+	err := c.do(http.MethodPut, fmt.Sprintf("organizations/%s/searches/%s", *o.Slug, s.ID), &search, &s)
+	return search, err
+}
+
+// DeleteSavedSearch deletes a saved search.
+func (c *apiClient) DeleteSavedSearch(o sentry.Organization, id string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/searches/%s", *o.Slug, id), nil, nil)
+}
+
+// PinSavedSearch pins the query and sort of a saved search, replacing the
+// search pinned before.
+func (c *apiClient) PinSavedSearch(o sentry.Organization, s savedSearch) error {
+	req := struct {
+		Type  int    `json:"type"`
+		Query string `json:"query"`
+		Sort  string `json:"sort"`
+	}{savedSearchTypeIssue, s.Query, s.Sort}
+	return c.do(http.MethodPut, fmt.Sprintf("organizations/%s/pinned-searches", *o.Slug), nil, &req)
+}
+
+// UnpinSavedSearch unpins the pinned search of issues.
+func (c *apiClient) UnpinSavedSearch(o sentry.Organization) error {
+	req := struct {
+		Type int `json:"type"`
+	}{savedSearchTypeIssue}
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/pinned-searches", *o.Slug), nil, &req)
+}
+
+// dashboard is a dashboard of an organization: a grid of widgets charting the
+// results of queries of events.
+type dashboard struct {
+	ID      string            `json:"id,omitempty"`
+	Title   string            `json:"title"`
+	Widgets []dashboardWidget `json:"widgets"`
+}
+
+// dashboardWidget is a chart, table or number of a dashboard.
+type dashboardWidget struct {
+	ID          string                 `json:"id,omitempty"`
+	Title       string                 `json:"title"`
+	DisplayType string                 `json:"displayType"`
+	Queries     []dashboardWidgetQuery `json:"queries"`
+	Layout      *dashboardWidgetLayout `json:"layout"`
+}
+
+// dashboardWidgetQuery is a query of events drawn by a widget: the fields,
+// e.g. "count()", of the events matching the conditions.
+type dashboardWidgetQuery struct {
+	ID         string   `json:"id,omitempty"`
+	Name       string   `json:"name"`
+	Fields     []string `json:"fields"`
+	Conditions string   `json:"conditions"`
+	OrderBy    string   `json:"orderby"`
+}
+
+// dashboardWidgetLayout is the position and size of a widget in the grid of
+// a dashboard, in columns and rows.
+type dashboardWidgetLayout struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// CreateDashboard creates a dashboard in an organization.
+func (c *apiClient) CreateDashboard(o sentry.Organization, d dashboard) (dashboard, error) {
+	var created dashboard
+	err := c.do(http.MethodPost, fmt.Sprintf("organizations/%s/dashboards", *o.Slug), &created, &d)
+	return created, err
+}
+
+// GetDashboard fetches a dashboard of an organization.
+func (c *apiClient) GetDashboard(o sentry.Organization, id string) (dashboard, error) {
+	var d dashboard
+	err := c.do(http.MethodGet, fmt.Sprintf("organizations/%s/dashboards/%s", *o.Slug, id), &d, nil)
+	return d, err
+}
+
+// UpdateDashboard replaces the title and widgets of a dashboard.  Widgets and
+// queries without an ID are created, and the ones missing from d are deleted.
+func (c *apiClient) UpdateDashboard(o sentry.Organization, d dashboard) (dashboard, error) {
+	var updated dashboard
+	err := c.do(http.MethodPut, fmt.Sprintf("organizations/%s/dashboards/%s", *o.Slug, d.ID), &updated, &d)
+	return updated, err
+}
+
+// DeleteDashboard deletes a dashboard.
+func (c *apiClient) DeleteDashboard(o sentry.Organization, id string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/dashboards/%s", *o.Slug, id), nil, nil)
+}
+
+// organizationIntegration is a third-party service, such as Slack, GitHub or
+// Jira, installed in an organization.
+type organizationIntegration struct {
+	ID         int                 `json:"id,string"`
+	Name       string              `json:"name"`
+	DomainName string              `json:"domainName"`
+	ExternalID string              `json:"externalId"`
+	Status     string              `json:"status"`
+	Provider   integrationProvider `json:"provider"`
+}
+
+// integrationProvider is the service of an integration, e.g. "slack".
+type integrationProvider struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// GetOrganizationIntegrations lists the integrations of an organization with
+// the given provider key, or all of them if it is empty.
+func (c *apiClient) GetOrganizationIntegrations(o sentry.Organization, providerKey string) ([]organizationIntegration, error) {
+	integrations := make([]organizationIntegration, 0)
+	endpoint := fmt.Sprintf("organizations/%s/integrations", *o.Slug)
+	if providerKey != "" {
+		endpoint += "?" + url.Values{"provider_key": {providerKey}}.Encode()
+	}
+	err := c.list(endpoint, &integrations)
+	return integrations, err
+}
+
+// repository is a code repository linked to an organization through an
+// integration, so that releases can reference its commits.
+type repository struct {
+	ID            string             `json:"id"`
+	Name          string             `json:"name"`
+	URL           string             `json:"url"`
+	IntegrationID int                `json:"integrationId,string"`
+	Provider      repositoryProvider `json:"provider"`
+}
+
+// repositoryProvider is the service hosting a repository, e.g.
+// "integrations:github".
+type repositoryProvider struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CreateRepository links the repository called name in the service of an
+// integration, e.g. "owner/repo" on GitHub, to an organization.
+func (c *apiClient) CreateRepository(o sentry.Organization, i organizationIntegration, name string) (repository, error) {
+	req := struct {
+		Provider     string `json:"provider"`
+		Installation string `json:"installation"`
+		Identifier   string `json:"identifier"`
+	}{"integrations:" + i.Provider.Key, strconv.Itoa(i.ID), name}
+	var repo repository
+	err := c.do(http.MethodPost, fmt.Sprintf("organizations/%s/repos", *o.Slug), &repo, &req)
+	return repo, err
+}
+
+// GetRepository finds an active repository by its ID, returning a 404
+// sentry.APIError if there is no such repository: Sentry only lists them.
+func (c *apiClient) GetRepository(o sentry.Organization, id string) (repository, error) {
+	repos := make([]repository, 0)
+	err := c.list(fmt.Sprintf("organizations/%s/repos", *o.Slug), &repos)
+	if err != nil {
+		return repository{}, err
+	}
+	for _, repo := range repos {
+		if repo.ID == id {
+			return repo, nil
+		}
+	}
+	return repository{}, sentry.APIError{StatusCode: http.StatusNotFound, Detail: fmt.Sprintf("no repository with ID %s", id)}
+}
+
+// DeleteRepository unlinks a repository from an organization.  Sentry hides
+// it right away and deletes its commits in the background.
+func (c *apiClient) DeleteRepository(o sentry.Organization, id string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("organizations/%s/repos/%s", *o.Slug, id), nil, nil)
+}
@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/stvp/assert"
+)
+
+// newTestAPIClient starts a server responding to the single request it
+// expects, and returns an apiClient pointed at it.
+func newTestAPIClient(t *testing.T, wantMethod, wantPath, wantBody string, status int, response string) (*apiClient, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, wantMethod)
+		assert.Equal(t, r.URL.Path, wantPath)
+		assert.Equal(t, r.Header.Get("Authorization"), "Bearer the-token")
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		if wantBody == "" {
+			assert.Equal(t, string(body), "")
+		} else {
+			assert.Equal(t, json.RawMessage(body), json.RawMessage(wantBody))
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	endpoint := server.URL + "/api/0/"
+	client, err := newAPIClient("the-token", &endpoint)
+	assert.Nil(t, err)
+	return client, server.Close
+}
+
+func TestAPIClientError(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/projects/org/proj/keys/key-id/", "", 404, `{"detail": "The requested resource does not exist"}`)
+	defer closeServer()
+
+	_, err := client.GetClientKeyDetails(sentry.Organization{Slug: stringPtr("org")}, sentry.Project{Slug: stringPtr("proj")}, "key-id")
+	assert.Equal(t, err, sentry.APIError{StatusCode: 404, Detail: "The requested resource does not exist"})
+	assert.True(t, isNotFound(err))
+}
+
+func TestAPIClientGetClientKeyDetails(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/projects/org/proj/keys/key-id/", "", 200, `{
+		"id": "key-id",
+		"name": "key name",
+		"isActive": true,
+		"rateLimit": {"window": 60, "count": 1000},
+		"dsn": {"public": "public-dsn", "secret": "secret-dsn", "csp": "csp-dsn", "security": "security-dsn"}
+	}`)
+	defer closeServer()
+
+	key, err := client.GetClientKeyDetails(sentry.Organization{Slug: stringPtr("org")}, sentry.Project{Slug: stringPtr("proj")}, "key-id")
+	assert.Nil(t, err)
+	assert.Equal(t, key, clientKey{
+		ID:        "key-id",
+		Name:      "key name",
+		IsActive:  true,
+		RateLimit: &clientKeyRateLimit{Window: 60, Count: 1000},
+		DSN: clientKeyDSN{
+			Public:   "public-dsn",
+			Secret:   "secret-dsn",
+			CSP:      "csp-dsn",
+			Security: "security-dsn",
+		},
+	})
+}
+
+func TestAPIClientUpdateClientKeyDetails(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "PUT", "/api/0/projects/org/proj/keys/key-id/",
+		`{"name":"key name","isActive":false,"rateLimit":null}`,
+		200, `{"id": "key-id", "name": "key name", "isActive": false, "rateLimit": null}`)
+	defer closeServer()
+
+	key, err := client.UpdateClientKeyDetails(sentry.Organization{Slug: stringPtr("org")}, sentry.Project{Slug: stringPtr("proj")}, clientKey{
+		ID:   "key-id",
+		Name: "key name",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, key, clientKey{ID: "key-id", Name: "key name"})
+}
+
+func TestAPIClientGetProjectByID(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/organizations/org/projects/", "", 200, `[
+		{"id": "42", "slug": "proj", "name": "Project"}
+	]`)
+	defer closeServer()
+
+	project, err := client.GetProjectByID(sentry.Organization{Slug: stringPtr("org")}, "42")
+	assert.Nil(t, err)
+	assert.Equal(t, project.ID, "42")
+	assert.Equal(t, *project.Slug, "proj")
+
+	_, err = client.GetProjectByID(sentry.Organization{Slug: stringPtr("org")}, "43")
+	assert.True(t, isNotFound(err))
+}
+
+func TestAPIClientRenameProject(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "PUT", "/api/0/projects/org/proj/", `{"slug":"new-proj"}`, 200, `{}`)
+	defer closeServer()
+
+	err := client.RenameProject(sentry.Organization{Slug: stringPtr("org")}, sentry.Project{Slug: stringPtr("proj")}, "new-proj")
+	assert.Nil(t, err)
+}
+
+func TestAPIClientCreateIssueAlertRule(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "POST", "/api/0/projects/org/proj/rules/",
+		`{"name":"rule name","actionMatch":"all","frequency":30,"environment":null,"conditions":[],"filters":[],"actions":[{"id":"sentry.rules.actions.notify_event.NotifyEventAction"}]}`,
+		201, `{
+			"id": "123",
+			"name": "rule name",
+			"actionMatch": "all",
+			"filterMatch": "all",
+			"frequency": 30,
+			"environment": null,
+			"conditions": [],
+			"filters": [],
+			"actions": [{"id": "sentry.rules.actions.notify_event.NotifyEventAction", "name": "Send a notification (for all legacy integrations)"}]
+		}`)
+	defer closeServer()
+
+	rule, err := client.CreateIssueAlertRule(sentry.Organization{Slug: stringPtr("org")}, sentry.Project{Slug: stringPtr("proj")}, issueAlertRule{
+		Name:        "rule name",
+		ActionMatch: "all",
+		Frequency:   30,
+		Conditions:  []map[string]interface{}{},
+		Filters:     []map[string]interface{}{},
+		Actions:     []map[string]interface{}{{"id": "sentry.rules.actions.notify_event.NotifyEventAction"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, rule, issueAlertRule{
+		ID:          "123",
+		Name:        "rule name",
+		ActionMatch: "all",
+		FilterMatch: "all",
+		Frequency:   30,
+		Conditions:  []map[string]interface{}{},
+		Filters:     []map[string]interface{}{},
+		Actions: []map[string]interface{}{{
+			"id":   "sentry.rules.actions.notify_event.NotifyEventAction",
+			"name": "Send a notification (for all legacy integrations)",
+		}},
+	})
+}
+
+func TestAPIClientGetMetricAlertRule(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/organizations/org/alert-rules/123/", "", 200, `{
+		"id": "123",
+		"name": "rule name",
+		"dataset": "events",
+		"query": "",
+		"aggregate": "count()",
+		"timeWindow": 60.0,
+		"thresholdType": 0,
+		"resolveThreshold": null,
+		"environment": null,
+		"projects": ["proj"],
+		"triggers": [{
+			"id": "1",
+			"alertRuleId": "123",
+			"label": "critical",
+			"alertThreshold": 100.0,
+			"actions": [{
+				"id": "2",
+				"alertRuleTriggerId": "1",
+				"type": "slack",
+				"targetType": "specific",
+				"targetIdentifier": "#alerts",
+				"integrationId": 7
+			}]
+		}]
+	}`)
+	defer closeServer()
+
+	rule, err := client.GetMetricAlertRule(sentry.Organization{Slug: stringPtr("org")}, "123")
+	assert.Nil(t, err)
+	integrationID := 7
+	assert.Equal(t, rule, metricAlertRule{
+		ID:         "123",
+		Name:       "rule name",
+		Dataset:    "events",
+		Aggregate:  "count()",
+		TimeWindow: 60,
+		Projects:   []string{"proj"},
+		Triggers: []metricAlertRuleTrigger{{
+			ID:             "1",
+			Label:          "critical",
+			AlertThreshold: 100,
+			Actions: []metricAlertRuleTriggerAction{{
+				ID:               "2",
+				Type:             "slack",
+				TargetType:       "specific",
+				TargetIdentifier: "#alerts",
+				IntegrationID:    &integrationID,
+			}},
+		}},
+	})
+}
+
+func TestAPIClientUpdateProjectLegacyBrowsersFilter(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "PUT", "/api/0/projects/org/proj/filters/legacy-browsers/",
+		`{"subfilters":["ie_pre_9","safari_pre_6"]}`, 204, "")
+	defer closeServer()
+
+	err := client.UpdateProjectLegacyBrowsersFilter(sentry.Organization{Slug: stringPtr("org")}, sentry.Project{Slug: stringPtr("proj")}, []string{"ie_pre_9", "safari_pre_6"})
+	assert.Nil(t, err)
+}
+
+func TestAPIClientUpdateProjectSettings(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "PUT", "/api/0/projects/org/proj/",
+		`{"dataScrubber":false,"safeFields":[],"resolveAge":0}`, 200, "{}")
+	defer closeServer()
+
+	err := client.UpdateProjectSettings(sentry.Organization{Slug: stringPtr("org")}, sentry.Project{Slug: stringPtr("proj")}, projectSettings{
+		DataScrubber: boolPtr(false),
+		SafeFields:   &[]string{},
+		ResolveAge:   intPtr(0),
+	})
+	assert.Nil(t, err)
+}
+
+func TestAPIClientUpdateOrganizationMember(t *testing.T) {
+	tests := map[string]struct {
+		teams    []string
+		wantBody string
+	}{
+		"teams":          {teams: []string{}, wantBody: `{"role":"admin","teams":[]}`},
+		"teams left out": {teams: nil, wantBody: `{"role":"admin"}`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, closeServer := newTestAPIClient(t, "PUT", "/api/0/organizations/org/members/42/",
+				tc.wantBody, 200, `{"id":"42","email":"alice@example.com","role":"admin","pending":false}`)
+			defer closeServer()
+
+			member, err := client.UpdateOrganizationMember(sentry.Organization{Slug: stringPtr("org")}, organizationMember{ID: "42", Role: "admin", Teams: tc.teams})
+			assert.Nil(t, err)
+			assert.Equal(t, member, organizationMember{ID: "42", Email: "alice@example.com", Role: "admin"})
+		})
+	}
+}
+
+func TestAPIClientUpdateProjectEnvironment(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "PUT", "/api/0/projects/org/proj/environments/with space/",
+		`{"isHidden":true}`, 200, `{"id":"7","name":"with space","isHidden":true}`)
+	defer closeServer()
+
+	environment, err := client.UpdateProjectEnvironment(sentry.Organization{Slug: stringPtr("org")}, sentry.Project{Slug: stringPtr("proj")}, "with space", true)
+	assert.Nil(t, err)
+	assert.Equal(t, environment, projectEnvironment{ID: "7", Name: "with space", IsHidden: true})
+}
+
+func TestAPIClientGetRelease(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/organizations/org/releases/my-app@1.2.3/", "", 200,
+		`{"version":"my-app@1.2.3","ref":"v1.2.3","projects":[{"slug":"proj-a"},{"slug":"proj-b"}]}`)
+	defer closeServer()
+
+	r, err := client.GetRelease(sentry.Organization{Slug: stringPtr("org")}, "my-app@1.2.3")
+	assert.Nil(t, err)
+	assert.Equal(t, r, release{Version: "my-app@1.2.3", Ref: stringPtr("v1.2.3"), Projects: []string{"proj-a", "proj-b"}})
+}
+
+func TestAPIClientGetMonitor(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/organizations/org/monitors/hourly-sync/", "", 200, `{
+		"id": "7",
+		"slug": "hourly-sync",
+		"name": "Hourly sync",
+		"type": "cron_job",
+		"status": "ok",
+		"project": {"id": "2", "slug": "proj"},
+		"config": {"schedule_type": "interval", "schedule": [1, "hour"], "checkin_margin": null, "max_runtime": 30, "timezone": "UTC"}
+	}`)
+	defer closeServer()
+
+	m, err := client.GetMonitor(sentry.Organization{Slug: stringPtr("org")}, "hourly-sync")
+	assert.Nil(t, err)
+	assert.Equal(t, m, monitor{
+		ID:      "7",
+		Slug:    "hourly-sync",
+		Name:    "Hourly sync",
+		Type:    "cron_job",
+		Project: "proj",
+		Config: monitorConfig{
+			ScheduleType: "interval",
+			Schedule:     []interface{}{float64(1), "hour"},
+			MaxRuntime:   intPtr(30),
+			Timezone:     "UTC",
+		},
+	})
+}
+
+func TestAPIClientGetSavedSearch(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/organizations/org/searches/", "", 200, `[
+		{"id": "16", "type": 0, "name": "Unresolved", "query": "is:unresolved", "sort": "date", "visibility": "organization", "isPinned": false},
+		{"id": "17", "type": 0, "name": "Unassigned P1", "query": "is:unresolved !has:assignee", "sort": "freq", "visibility": "owner", "isPinned": true}
+	]`)
+	defer closeServer()
+
+	search, err := client.GetSavedSearch(sentry.Organization{Slug: stringPtr("org")}, "17")
+	assert.Nil(t, err)
+	assert.Equal(t, search, savedSearch{
+		ID:         "17",
+		Name:       "Unassigned P1",
+		Query:      "is:unresolved !has:assignee",
+		Sort:       "freq",
+		Visibility: "owner",
+		IsPinned:   true,
+	})
+
+	_, err = client.GetSavedSearch(sentry.Organization{Slug: stringPtr("org")}, "18")
+	assert.True(t, isNotFound(err))
+}
+
+func TestAPIClientGetOrganizationIntegrations(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/organizations/org/integrations/", "", 200, `[
+		{"id": "3", "name": "Workspace", "domainName": "workspace.slack.com", "externalId": "T0123", "status": "active", "provider": {"key": "slack", "slug": "slack", "name": "Slack"}}
+	]`)
+	defer closeServer()
+
+	integrations, err := client.GetOrganizationIntegrations(sentry.Organization{Slug: stringPtr("org")}, "slack")
+	assert.Nil(t, err)
+	assert.Equal(t, integrations, []organizationIntegration{{
+		ID:         3,
+		Name:       "Workspace",
+		DomainName: "workspace.slack.com",
+		ExternalID: "T0123",
+		Status:     "active",
+		Provider:   integrationProvider{Key: "slack", Name: "Slack"},
+	}})
+}
+
+func TestAPIClientGetRepository(t *testing.T) {
+	client, closeServer := newTestAPIClient(t, "GET", "/api/0/organizations/org/repos/", "", 200, `[
+		{"id": "4", "name": "legacy/repo", "url": null, "provider": {"id": "github", "name": "GitHub"}, "status": "active", "integrationId": null},
+		{"id": "5", "name": "owner/repo", "url": "https://github.com/owner/repo", "provider": {"id": "integrations:github", "name": "GitHub"}, "status": "active", "integrationId": "3"}
+	]`)
+	defer closeServer()
+
+	repo, err := client.GetRepository(sentry.Organization{Slug: stringPtr("org")}, "5")
+	assert.Nil(t, err)
+	assert.Equal(t, repo, repository{
+		ID:            "5",
+		Name:          "owner/repo",
+		URL:           "https://github.com/owner/repo",
+		IntegrationID: 3,
+		Provider:      repositoryProvider{ID: "integrations:github", Name: "GitHub"},
+	})
+
+	_, err = client.GetRepository(sentry.Organization{Slug: stringPtr("org")}, "6")
+	assert.True(t, isNotFound(err))
+}
+
+func TestAPIClientGetRepositoryPaginated(t *testing.T) {
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/api/0/organizations/org/repos/")
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		if cursor == "" {
+			w.Header().Set("Link", `<https://sentry.io/api/0/organizations/org/repos/?&cursor=100:-1:1>; rel="previous"; results="false"; cursor="100:-1:1", `+
+				`<https://sentry.io/api/0/organizations/org/repos/?&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"`)
+			_, _ = w.Write([]byte(`[{"id": "4", "name": "owner/other-repo", "provider": {"id": "integrations:github", "name": "GitHub"}, "integrationId": "3"}]`))
+			return
+		}
+		w.Header().Set("Link", `<https://sentry.io/api/0/organizations/org/repos/?&cursor=100:0:1>; rel="previous"; results="true"; cursor="100:0:1", `+
+			`<https://sentry.io/api/0/organizations/org/repos/?&cursor=100:2:0>; rel="next"; results="false"; cursor="100:2:0"`)
+		_, _ = w.Write([]byte(`[{"id": "5", "name": "owner/repo", "provider": {"id": "integrations:github", "name": "GitHub"}, "integrationId": "3"}]`))
+	}))
+	defer server.Close()
+	endpoint := server.URL + "/api/0/"
+	client, err := newAPIClient("the-token", &endpoint)
+	assert.Nil(t, err)
+
+	repo, err := client.GetRepository(sentry.Organization{Slug: stringPtr("org")}, "5")
+	assert.Nil(t, err)
+	assert.Equal(t, repo.Name, "owner/repo")
+	assert.Equal(t, cursors, []string{"", "100:1:0"})
+}
+
+func TestNextPageEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		endpoint, link, want string
+	}{
+		"no link": {
+			endpoint: "organizations/org/repos",
+			want:     "",
+		},
+		"more results": {
+			endpoint: "organizations/org/integrations?provider_key=slack",
+			link:     `<https://sentry.io/api/0/organizations/org/integrations/?provider_key=slack&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"`,
+			want:     "organizations/org/integrations?cursor=100%3A1%3A0&provider_key=slack",
+		},
+		"last page": {
+			endpoint: "organizations/org/repos?cursor=100:1:0",
+			link: `<https://sentry.io/api/0/organizations/org/repos/?&cursor=100:0:1>; rel="previous"; results="true"; cursor="100:0:1", ` +
+				`<https://sentry.io/api/0/organizations/org/repos/?&cursor=100:2:0>; rel="next"; results="false"; cursor="100:2:0"`,
+			want: "",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, nextPageEndpoint(tc.endpoint, tc.link), tc.want)
+		})
+	}
+}
@@ -20,11 +20,12 @@ import (
 	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
 )
 
-// Serve launches the gRPC server for the resource provider.
-func Serve(providerName, version string) {
+// Serve launches the gRPC server for the resource provider, schema is the
+// contents of schema.json served by GetSchema.
+func Serve(providerName, version string, schema []byte) {
 	// Start gRPC service.
 	err := provider.Main(providerName, func(host *provider.HostClient) (rpc.ResourceProviderServer, error) {
-		return makeProvider(host, providerName, version)
+		return makeProvider(host, providerName, version, schema)
 	})
 	if err != nil {
 		cmdutil.ExitError(err.Error())
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var serviceHookProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		// A hook can't be moved between organizations.
+		"organizationSlug": true,
+	},
+	changedByUpdate: map[string]bool{
+		"events": true,
+		// Follows renames of the project, see buildProjectResourceID.
+		"projectSlug": true,
+		"url":         true,
+	},
+	outputs: map[string]bool{
+		"secret": true,
+	},
+}
+
+// serviceHookEvents are the events Sentry can post to service hooks.
+var serviceHookEvents = []string{"event.alert", "event.created"}
+
+func (k *sentryProvider) serviceHookCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkServiceHookEvents(&failures, news)
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "projectSlug")
+	checkHTTPURL(&failures, news, "url")
+
+	// Sentry returns events in its own order.
+	news["events"] = sortedStrings(news["events"])
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func checkServiceHookEvents(failures *[]*rpc.CheckFailure, props resource.PropertyMap) {
+	value := props["events"]
+	if value.IsComputed() {
+		return
+	}
+
+	valid := value.IsArray() && len(value.ArrayValue()) > 0
+	if valid {
+		for _, element := range value.ArrayValue() {
+			if !element.ContainsUnknowns() && (!element.IsString() || !containsString(serviceHookEvents, element.StringValue())) {
+				valid = false
+			}
+		}
+	}
+	if !valid {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: "events",
+			Reason:   fmt.Sprintf("this input must be a non-empty list of: %s", strings.Join(serviceHookEvents, ", ")),
+		})
+	}
+}
+
+func (k *sentryProvider) serviceHookDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return serviceHookProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) serviceHookCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	projectSlug := inputs["projectSlug"].StringValue()
+	org := sentry.Organization{Slug: &organizationSlug}
+	project, err := k.sentryClient.GetProject(org, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetProject %v: %w", projectSlug, err)
+	}
+
+	hook, err := k.sentryClient.CreateServiceHook(org, project, serviceHookFromInputs(inputs))
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateServiceHook %v: %w", inputs["url"].StringValue(), err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		serviceHookPropertyMap(organizationSlug, projectSlug, hook),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildProjectResourceID(organizationSlug, project, hook.ID),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) serviceHookUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed serviceHookUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed serviceHookUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := serviceHookProperties.checkUpdatable("serviceHookUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	// The events are replaced as a whole: the ones left out are no longer
+	// posted to the hook.
+	organizationSlug, project, parts, err := k.parseUpdatedProjectResourceID(req.GetId(), news, 1)
+	if err != nil {
+		return nil, err
+	}
+	wanted := serviceHookFromInputs(news)
+	wanted.ID = parts[0]
+	hook, err := k.sentryClient.UpdateServiceHook(sentry.Organization{Slug: &organizationSlug}, project, wanted)
+	if err != nil {
+		return nil, fmt.Errorf("could not UpdateServiceHook %v: %w", wanted.ID, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		serviceHookPropertyMap(organizationSlug, *project.Slug, hook),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: outputProperties}, nil
+}
+
+func (k *sentryProvider) serviceHookRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.properties", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed serviceHookRead because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, parts, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 1)
+	var hook serviceHook
+	if err == nil {
+		hook, err = k.sentryClient.GetServiceHook(sentry.Organization{Slug: &organizationSlug}, project, parts[0])
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// The hook or its project is not there, delete it from stack
+			// state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetServiceHook %v: %w", req.GetId(), err)
+	}
+	properties := serviceHookPropertyMap(organizationSlug, *project.Slug, hook)
+	newState, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(serviceHookProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		// This also migrates legacy <orgSlug>/<projectSlug>/<hookID> IDs.
+		Id:         buildProjectResourceID(organizationSlug, project, hook.ID),
+		Properties: newState,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) serviceHookDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{})
+	if err != nil {
+		return &pbempty.Empty{}, fmt.Errorf("failed serviceHookDelete because of malformed resource state: %w", err)
+	}
+	organizationSlug, project, parts, err := k.parseProjectResourceID(req.GetId(), stringFromPropertyValue(state["projectSlug"]), 1)
+	if err == nil {
+		err = k.sentryClient.DeleteServiceHook(sentry.Organization{Slug: &organizationSlug}, project, parts[0])
+	}
+	if isNotFound(err) {
+		// The hook or its project is already gone.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+func serviceHookFromInputs(inputs resource.PropertyMap) serviceHook {
+	return serviceHook{
+		URL:    stringFromPropertyValue(inputs["url"]),
+		Events: stringsFromPropertyValue(inputs["events"]),
+	}
+}
+
+func serviceHookPropertyMap(organizationSlug, projectSlug string, hook serviceHook) resource.PropertyMap {
+	events := append([]string{}, hook.Events...)
+	sort.Strings(events)
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"events":           events,
+		"organizationSlug": organizationSlug,
+		"projectSlug":      projectSlug,
+		"secret":           hook.Secret,
+		"url":              hook.URL,
+	})
+}
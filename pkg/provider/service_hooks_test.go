@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestServiceHookCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+		wantInputs   resource.PropertyMap
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "events", Reason: "this input must be a non-empty list of: event.alert, event.created"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "projectSlug", Reason: "this input must be a non-empty string"},
+				{Property: "url", Reason: "this input must be an absolute http(s) URL"},
+			},
+			wantInputs: resource.PropertyMap{},
+		},
+		"unknown event": {
+			news: resource.PropertyMap{
+				"events":           resource.NewPropertyValue([]interface{}{"event.created", "issue.resolved"}),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"url":              resource.NewPropertyValue("https://hooks.example.com/sentry"),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "events", Reason: "this input must be a non-empty list of: event.alert, event.created"},
+			},
+			wantInputs: resource.PropertyMap{
+				"events":           resource.NewPropertyValue([]interface{}{"event.created", "issue.resolved"}),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"url":              resource.NewPropertyValue("https://hooks.example.com/sentry"),
+			},
+		},
+		"sorted events": {
+			news: resource.PropertyMap{
+				"events":           resource.NewPropertyValue([]interface{}{"event.created", "event.alert"}),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"url":              resource.NewPropertyValue("https://hooks.example.com/sentry"),
+			},
+			wantInputs: resource.PropertyMap{
+				"events":           resource.NewPropertyValue([]interface{}{"event.alert", "event.created"}),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"projectSlug":      resource.NewPropertyValue("proj-slug"),
+				"url":              resource.NewPropertyValue("https://hooks.example.com/sentry"),
+			},
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.serviceHookCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.wantInputs)
+		})
+	}
+}
+
+func TestServiceHookDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"events":           resource.NewPropertyValue([]interface{}{"event.alert"}),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"secret":           resource.NewPropertyValue("s3cr3t"),
+		"url":              resource.NewPropertyValue("https://hooks.example.com/sentry"),
+	}
+	baseNews := serviceHookProperties.inputs(baseOlds)
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseNews,
+			wantResponse: rpc.DiffResponse{Changes: rpc.DiffResponse_DIFF_NONE},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"events": resource.NewPropertyValue([]interface{}{"event.alert", "event.created"}),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"events"},
+			},
+		},
+		"project renamed": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"projectSlug": resource.NewPropertyValue("other-proj"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"projectSlug"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseNews, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("other-org-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug"},
+				Replaces:            []string{"organizationSlug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.serviceHookDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestServiceHookCreate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			createServiceHook: func(org sentry.Organization, proj sentry.Project, h serviceHook) (serviceHook, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				assert.Equal(t, h, serviceHook{URL: "https://hooks.example.com/sentry", Events: []string{"event.alert"}})
+				h.ID = "abc123"
+				h.Secret = "s3cr3t"
+				return h, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	inputs := resource.PropertyMap{
+		"events":           resource.NewPropertyValue([]interface{}{"event.alert"}),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"url":              resource.NewPropertyValue("https://hooks.example.com/sentry"),
+	}
+	resp, err := prov.serviceHookCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42/abc123")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(inputs, resource.PropertyMap{
+		"secret": resource.NewPropertyValue("s3cr3t"),
+	}))
+}
+
+func TestServiceHookRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getServiceHook: func(org sentry.Organization, proj sentry.Project, id string) (serviceHook, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				assert.Equal(t, id, "abc123")
+				return serviceHook{
+					ID:     id,
+					URL:    "https://hooks.example.com/sentry",
+					Events: []string{"event.created", "event.alert"},
+					Secret: "s3cr3t",
+				}, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.serviceHookRead(ctx, &rpc.ReadRequest{Id: "org-slug/42/abc123"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/42/abc123")
+	wantInputs := resource.PropertyMap{
+		"events":           resource.NewPropertyValue([]interface{}{"event.alert", "event.created"}),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"url":              resource.NewPropertyValue("https://hooks.example.com/sentry"),
+	}
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(wantInputs, resource.PropertyMap{
+		"secret": resource.NewPropertyValue("s3cr3t"),
+	}))
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), wantInputs)
+}
+
+func TestServiceHookRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			getServiceHook: func(org sentry.Organization, proj sentry.Project, id string) (serviceHook, error) {
+				return serviceHook{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	resp, err := prov.serviceHookRead(ctx, &rpc.ReadRequest{Id: "org-slug/42/abc123"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestServiceHookUpdate(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			updateServiceHook: func(org sentry.Organization, proj sentry.Project, h serviceHook) (serviceHook, error) {
+				assert.Equal(t, h, serviceHook{
+					ID:     "abc123",
+					URL:    "https://hooks.example.com/sentry",
+					Events: []string{"event.created"},
+				})
+				h.Secret = "s3cr3t"
+				return h, nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	olds := resource.PropertyMap{
+		"events":           resource.NewPropertyValue([]interface{}{"event.alert"}),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"projectSlug":      resource.NewPropertyValue("proj-slug"),
+		"secret":           resource.NewPropertyValue("s3cr3t"),
+		"url":              resource.NewPropertyValue("https://hooks.example.com/sentry"),
+	}
+	news := propertyMapWithOverrides(serviceHookProperties.inputs(olds), resource.PropertyMap{
+		"events": resource.NewPropertyValue([]interface{}{"event.created"}),
+	})
+	resp, err := prov.serviceHookUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/42/abc123",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), propertyMapWithOverrides(news, resource.PropertyMap{
+		"secret": resource.NewPropertyValue("s3cr3t"),
+	}))
+}
+
+func TestServiceHookDelete(t *testing.T) {
+	ctx := context.Background()
+	var deleted string
+	prov := sentryProvider{
+		sentryClient: withProjects(&sentryClientMock{
+			deleteServiceHook: func(org sentry.Organization, proj sentry.Project, id string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *proj.Slug, "proj-slug")
+				deleted = id
+				return nil
+			},
+		}, sentry.Project{ID: "42", Slug: stringPtr("proj-slug")}),
+	}
+	_, err := prov.serviceHookDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/42/abc123"})
+	assert.Nil(t, err)
+	assert.Equal(t, deleted, "abc123")
+}
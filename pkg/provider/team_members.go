@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// teamMemberProperties are all part of the ID of a team membership, which
+// is either there or not.
+var teamMemberProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		"memberId":         true,
+		"organizationSlug": true,
+		"teamSlug":         true,
+	},
+	changedByUpdate: map[string]bool{},
+	outputs:         map[string]bool{},
+}
+
+func (k *sentryProvider) teamMemberCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkNonEmptyString(&failures, news, "memberId")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "teamSlug")
+
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) teamMemberDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return teamMemberProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) teamMemberCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	teamSlug := inputs["teamSlug"].StringValue()
+	memberID := inputs["memberId"].StringValue()
+
+	if err := k.sentryClient.AddTeamMember(sentry.Organization{Slug: &organizationSlug}, memberID, teamSlug); err != nil {
+		return nil, fmt.Errorf("could not AddTeamMember %v to %v: %w", memberID, teamSlug, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		teamMemberPropertyMap(organizationSlug, teamSlug, memberID),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildID(organizationSlug, teamSlug, memberID),
+		Properties: outputProperties,
+	}, nil
+}
+
+// teamMemberUpdate has nothing to update, every change replaces the
+// membership.
+func (k *sentryProvider) teamMemberUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed teamMemberUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed teamMemberUpdate because of malformed resource inputs: %w", err)
+	}
+
+	if err := teamMemberProperties.checkUpdatable("teamMemberUpdate", olds, news); err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateResponse{Properties: req.GetNews()}, nil
+}
+
+func (k *sentryProvider) teamMemberRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, teamSlug, memberID, err := parseTeamMemberID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	member, err := k.sentryClient.GetOrganizationMember(sentry.Organization{Slug: &organizationSlug}, memberID)
+	if err != nil {
+		if isNotFound(err) {
+			// The member left the organization, and its teams with it.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, fmt.Errorf("could not GetOrganizationMember %v: %w", memberID, err)
+	}
+	if !containsString(member.Teams, teamSlug) {
+		// The member left the team, delete the membership from stack state.
+		return &rpc.ReadResponse{}, nil
+	}
+
+	properties := teamMemberPropertyMap(organizationSlug, teamSlug, memberID)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         req.GetId(),
+		Properties: state,
+		Inputs:     state,
+	}, nil
+}
+
+func (k *sentryProvider) teamMemberDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, teamSlug, memberID, err := parseTeamMemberID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.RemoveTeamMember(sentry.Organization{Slug: &organizationSlug}, memberID, teamSlug)
+	if isNotFound(err) {
+		// The member already left the team or the organization.
+		err = nil
+	}
+	return &pbempty.Empty{}, err
+}
+
+// parseTeamMemberID parses IDs of team memberships:
+// <orgSlug>/<teamSlug>/<memberID>.
+func parseTeamMemberID(id string) (organizationSlug, teamSlug, memberID string, err error) {
+	parts, err := parseID(id, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func teamMemberPropertyMap(organizationSlug, teamSlug, memberID string) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"memberId":         memberID,
+		"organizationSlug": organizationSlug,
+		"teamSlug":         teamSlug,
+	})
+}
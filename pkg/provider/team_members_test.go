@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestTeamMemberCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "memberId", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "teamSlug", Reason: "this input must be a non-empty string"},
+			},
+		},
+		"valid": {
+			news: resource.PropertyMap{
+				"memberId":         resource.NewPropertyValue("42"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"teamSlug":         resource.NewPropertyValue("the-team"),
+			},
+			wantFailures: nil,
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.teamMemberCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), tc.news)
+		})
+	}
+}
+
+func TestTeamMemberDiff(t *testing.T) {
+	olds := resource.PropertyMap{
+		"memberId":         resource.NewPropertyValue("42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"teamSlug":         resource.NewPropertyValue("the-team"),
+	}
+	prov := sentryProvider{}
+	resp, err := prov.teamMemberDiff(olds, olds)
+	assert.Nil(t, err)
+	assert.Equal(t, *resp, rpc.DiffResponse{})
+
+	resp, err = prov.teamMemberDiff(olds, propertyMapWithOverrides(olds, resource.PropertyMap{
+		"teamSlug": resource.NewPropertyValue("other-team"),
+	}))
+	assert.Nil(t, err)
+	assert.Equal(t, *resp, rpc.DiffResponse{
+		Changes:             rpc.DiffResponse_DIFF_SOME,
+		Diffs:               []string{"teamSlug"},
+		Replaces:            []string{"teamSlug"},
+		DeleteBeforeReplace: true,
+	})
+}
+
+func TestTeamMemberCreate(t *testing.T) {
+	ctx := context.Background()
+	added := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			addTeamMember: func(org sentry.Organization, memberID, teamSlug string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, memberID, "42")
+				assert.Equal(t, teamSlug, "the-team")
+				added = true
+				return nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"memberId":         resource.NewPropertyValue("42"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"teamSlug":         resource.NewPropertyValue("the-team"),
+	}
+	resp, err := prov.teamMemberCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.True(t, added)
+	assert.Equal(t, resp.GetId(), "org-slug/the-team/42")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), inputs)
+}
+
+func TestTeamMemberRead(t *testing.T) {
+	tests := map[string]struct {
+		member    organizationMember
+		err       error
+		wantFound bool
+	}{
+		"in the team": {
+			member:    organizationMember{ID: "42", Teams: []string{"other-team", "the-team"}},
+			wantFound: true,
+		},
+		"left the team": {
+			member:    organizationMember{ID: "42", Teams: []string{"other-team"}},
+			wantFound: false,
+		},
+		"left the organization": {
+			err:       sentry.APIError{Detail: "not found", StatusCode: 404},
+			wantFound: false,
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{
+				sentryClient: &sentryClientMock{
+					getOrganizationMember: func(org sentry.Organization, id string) (organizationMember, error) {
+						assert.Equal(t, *org.Slug, "org-slug")
+						assert.Equal(t, id, "42")
+						return tc.member, tc.err
+					},
+				},
+			}
+			resp, err := prov.teamMemberRead(ctx, &rpc.ReadRequest{Id: "org-slug/the-team/42"})
+			assert.Nil(t, err)
+			if !tc.wantFound {
+				assert.Equal(t, resp.GetId(), "")
+				assert.Nil(t, resp.GetProperties())
+				return
+			}
+			assert.Equal(t, resp.GetId(), "org-slug/the-team/42")
+			want := resource.PropertyMap{
+				"memberId":         resource.NewPropertyValue("42"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"teamSlug":         resource.NewPropertyValue("the-team"),
+			}
+			assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), want)
+			assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), want)
+		})
+	}
+}
+
+func TestTeamMemberDelete(t *testing.T) {
+	ctx := context.Background()
+	removed := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			removeTeamMember: func(org sentry.Organization, memberID, teamSlug string) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, memberID, "42")
+				assert.Equal(t, teamSlug, "the-team")
+				removed = true
+				return sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	_, err := prov.teamMemberDelete(ctx, &rpc.DeleteRequest{Id: "org-slug/the-team/42"})
+	assert.Nil(t, err)
+	assert.True(t, removed)
+}
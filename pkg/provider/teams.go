@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var teamProperties = resourceProperties{
+	changedByReplacement: map[string]bool{
+		// Organization and team slugs are part of the Team's ID
+		// (<orgSlug>/<teamSlug>), so changing either of them replaces the
+		// team.
+		"organizationSlug": true,
+		"slug":             true,
+	},
+	changedByUpdate: map[string]bool{
+		"name": true,
+	},
+	outputs: map[string]bool{},
+}
+
+func (k *sentryProvider) teamCheck(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Check(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.news", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		RejectAssets: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "check failed because malformed resource inputs")
+	}
+
+	k.fillDefaultOrganization(news)
+
+	var failures []*rpc.CheckFailure
+	checkNonEmptyString(&failures, news, "name")
+	checkNonEmptyString(&failures, news, "organizationSlug")
+	checkNonEmptyString(&failures, news, "slug")
+
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: true,
+		SkipNulls:    true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CheckResponse{Inputs: inputs, Failures: failures}, nil
+}
+
+func (k *sentryProvider) teamDiff(olds, news resource.PropertyMap) (*rpc.DiffResponse, error) {
+	return teamProperties.diff(olds, news)
+}
+
+func (k *sentryProvider) teamCreate(ctx context.Context, req *rpc.CreateRequest, inputs resource.PropertyMap) (*rpc.CreateResponse, error) {
+	organizationSlug := inputs["organizationSlug"].StringValue()
+	name := inputs["name"].StringValue()
+	slug := inputs["slug"].StringValue()
+
+	team, err := k.sentryClient.CreateTeam(sentry.Organization{Slug: &organizationSlug}, name, &slug)
+	if err != nil {
+		return nil, fmt.Errorf("could not CreateTeam %v: %v", slug, err)
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		teamPropertyMap(organizationSlug, team),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CreateResponse{
+		Id:         buildSlugID(organizationSlug, *team.Slug),
+		Properties: outputProperties,
+	}, nil
+}
+
+func (k *sentryProvider) teamUpdate(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Update(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, slug, err := parseSlugID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.olds", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed teamUpdate because of malformed resource inputs: %w", err)
+	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{
+		Label: fmt.Sprintf("%s.news", label),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed teamUpdate because of malformed resource inputs: %w", err)
+	}
+
+	// This should already be validated by Diff, but let's be strict just in case.
+	if err := teamProperties.checkUpdatable("teamUpdate", olds, news); err != nil {
+		return nil, err
+	}
+
+	team := sentry.Team{
+		Slug: &slug,
+		Name: news["name"].StringValue(),
+	}
+	if err := k.sentryClient.UpdateTeam(sentry.Organization{Slug: &organizationSlug}, team); err != nil {
+		return nil, fmt.Errorf("could not UpdateTeam %v: %v", slug, err)
+	}
+	return &rpc.UpdateResponse{Properties: req.GetNews()}, nil
+}
+
+func (k *sentryProvider) teamRead(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	urn := resource.URN(req.GetUrn())
+	label := fmt.Sprintf("%s.Read(%s)", k.label(), urn)
+	logger.V(9).Infof("%s executing", label)
+
+	organizationSlug, slug, err := parseSlugID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	team, err := k.sentryClient.GetTeam(sentry.Organization{Slug: &organizationSlug}, slug)
+	if err != nil {
+		if isNotFound(err) {
+			// The team is not there, delete it from stack state.
+			return &rpc.ReadResponse{}, nil
+		}
+		return nil, err
+	}
+	properties := teamPropertyMap(organizationSlug, team)
+	state, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{
+		Label: label + ".state", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := plugin.MarshalProperties(teamProperties.inputs(properties), plugin.MarshalOptions{
+		Label: label + ".inputs", KeepUnknowns: true, SkipNulls: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReadResponse{
+		Id:         buildSlugID(organizationSlug, *team.Slug),
+		Properties: state,
+		Inputs:     inputs,
+	}, nil
+}
+
+func (k *sentryProvider) teamDelete(ctx context.Context, req *rpc.DeleteRequest) (*pbempty.Empty, error) {
+	organizationSlug, slug, err := parseSlugID(req.GetId())
+	if err != nil {
+		return &pbempty.Empty{}, err
+	}
+	err = k.sentryClient.DeleteTeam(sentry.Organization{Slug: &organizationSlug}, sentry.Team{Slug: &slug})
+	return &pbempty.Empty{}, err
+}
+
+func teamPropertyMap(organizationSlug string, team sentry.Team) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"name":             team.Name,
+		"organizationSlug": organizationSlug,
+		"slug":             *team.Slug,
+	})
+}
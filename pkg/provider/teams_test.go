@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/marcin-ro/go-sentry-api"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+	"github.com/stvp/assert"
+)
+
+func TestTeamCheck(t *testing.T) {
+	tests := map[string]struct {
+		news         resource.PropertyMap
+		wantFailures []*rpc.CheckFailure
+	}{
+		"nulls for required fields": {
+			news: resource.PropertyMap{},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "slug", Reason: "this input must be a non-empty string"},
+			},
+		},
+		"wrong type": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue(1),
+				"organizationSlug": resource.NewPropertyValue(1),
+				"slug":             resource.NewPropertyValue(1),
+			},
+			wantFailures: []*rpc.CheckFailure{
+				{Property: "name", Reason: "this input must be a non-empty string"},
+				{Property: "organizationSlug", Reason: "this input must be a non-empty string"},
+				{Property: "slug", Reason: "this input must be a non-empty string"},
+			},
+		},
+		"correct": {
+			news: resource.PropertyMap{
+				"name":             resource.NewPropertyValue("a name"),
+				"organizationSlug": resource.NewPropertyValue("org-slug"),
+				"slug":             resource.NewPropertyValue("slug"),
+			},
+			wantFailures: nil,
+		},
+	}
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.teamCheck(ctx, &rpc.CheckRequest{
+				Urn:  "urn:pulumi:fake::fake::fake::fake",
+				News: mustMarshalProperties(tc.news),
+			})
+			assert.Nil(t, err)
+			sort.Sort(byProperty(resp.Failures))
+			assert.Equal(t, resp.Failures, tc.wantFailures)
+		})
+	}
+}
+
+func TestTeamDiff(t *testing.T) {
+	baseOlds := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("base name"),
+		"organizationSlug": resource.NewPropertyValue("base-org-slug"),
+		"slug":             resource.NewPropertyValue("base-slug"),
+	}
+	tests := map[string]struct {
+		olds, news   resource.PropertyMap
+		wantResponse rpc.DiffResponse
+	}{
+		"no change": {
+			olds:         baseOlds,
+			news:         baseOlds,
+			wantResponse: rpc.DiffResponse{},
+		},
+		"simple updates": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"name": resource.NewPropertyValue("new name"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes: rpc.DiffResponse_DIFF_SOME,
+				Diffs:   []string{"name"},
+			},
+		},
+		"replacement": {
+			olds: baseOlds,
+			news: propertyMapWithOverrides(baseOlds, resource.PropertyMap{
+				"organizationSlug": resource.NewPropertyValue("new-org-slug"),
+				"slug":             resource.NewPropertyValue("new-slug"),
+			}),
+			wantResponse: rpc.DiffResponse{
+				Changes:             rpc.DiffResponse_DIFF_SOME,
+				Diffs:               []string{"organizationSlug", "slug"},
+				Replaces:            []string{"organizationSlug", "slug"},
+				DeleteBeforeReplace: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prov := sentryProvider{}
+			resp, err := prov.teamDiff(tc.olds, tc.news)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantResponse, *resp)
+		})
+	}
+}
+
+func TestTeamCreate(t *testing.T) {
+	ctx := context.Background()
+	createCalled := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			createTeam: func(org sentry.Organization, name string, slug *string) (sentry.Team, error) {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, name, "a name")
+				assert.Equal(t, *slug, "slug")
+				createCalled = true
+				return sentry.Team{
+					Name: "name-from-create",
+					Slug: stringPtr("slug-from-create"),
+				}, nil
+			},
+		},
+	}
+	inputs := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("the-org"),
+		"slug":             resource.NewPropertyValue("slug"),
+	}
+	resp, err := prov.teamCreate(ctx, &rpc.CreateRequest{}, inputs)
+	assert.Nil(t, err)
+	assert.True(t, createCalled)
+	assert.Equal(t, resp.GetId(), "the-org/slug-from-create")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"name":             resource.NewPropertyValue("name-from-create"),
+		"organizationSlug": resource.NewPropertyValue("the-org"),
+		"slug":             resource.NewPropertyValue("slug-from-create"),
+	})
+}
+
+func TestTeamRead(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getTeam: func(org sentry.Organization, teamSlug string) (sentry.Team, error) {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, teamSlug, "team-slug")
+				return sentry.Team{
+					Name: "name-from-read",
+					Slug: stringPtr("slug-from-read"),
+				}, nil
+			},
+		},
+	}
+	resp, err := prov.teamRead(ctx, &rpc.ReadRequest{Id: "org-slug/team-slug"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "org-slug/slug-from-read")
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), resource.PropertyMap{
+		"name":             resource.NewPropertyValue("name-from-read"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"slug":             resource.NewPropertyValue("slug-from-read"),
+	})
+	assert.Equal(t, mustUnmarshalProperties(resp.GetInputs()), mustUnmarshalProperties(resp.GetProperties()))
+}
+
+func TestTeamRead404(t *testing.T) {
+	ctx := context.Background()
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			getTeam: func(org sentry.Organization, teamSlug string) (sentry.Team, error) {
+				return sentry.Team{}, sentry.APIError{Detail: "not found", StatusCode: 404}
+			},
+		},
+	}
+	resp, err := prov.teamRead(ctx, &rpc.ReadRequest{Id: "org-slug/team-slug"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.GetId(), "")
+	assert.Nil(t, resp.GetProperties())
+}
+
+func TestTeamDelete(t *testing.T) {
+	ctx := context.Background()
+	deleteCalled := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			deleteTeam: func(org sentry.Organization, team sentry.Team) error {
+				assert.Equal(t, *org.Slug, "the-org")
+				assert.Equal(t, *team.Slug, "the-team")
+				deleteCalled = true
+				return nil
+			},
+		},
+	}
+	_, err := prov.teamDelete(ctx, &rpc.DeleteRequest{Id: "the-org/the-team"})
+	assert.Nil(t, err)
+	assert.True(t, deleteCalled)
+}
+
+func TestTeamUpdate(t *testing.T) {
+	ctx := context.Background()
+	updateCalled := false
+	prov := sentryProvider{
+		sentryClient: &sentryClientMock{
+			updateTeam: func(org sentry.Organization, team sentry.Team) error {
+				assert.Equal(t, *org.Slug, "org-slug")
+				assert.Equal(t, *team.Slug, "team-slug")
+				assert.Equal(t, team.Name, "new name")
+				updateCalled = true
+				return nil
+			},
+		},
+	}
+	olds := resource.PropertyMap{
+		"name":             resource.NewPropertyValue("a name"),
+		"organizationSlug": resource.NewPropertyValue("org-slug"),
+		"slug":             resource.NewPropertyValue("team-slug"),
+	}
+	news := propertyMapWithOverrides(olds, resource.PropertyMap{
+		"name": resource.NewPropertyValue("new name"),
+	})
+	resp, err := prov.teamUpdate(ctx, &rpc.UpdateRequest{
+		Id:   "org-slug/team-slug",
+		News: mustMarshalProperties(news),
+		Olds: mustMarshalProperties(olds),
+	})
+	assert.Nil(t, err)
+	assert.True(t, updateCalled)
+	assert.Equal(t, mustUnmarshalProperties(resp.GetProperties()), news)
+}
@@ -46,6 +46,14 @@ func stringPtr(value string) *string {
 	return &value
 }
 
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
 func propertyMapWithOverrides(source resource.PropertyMap, overrides resource.PropertyMap) resource.PropertyMap {
 	ret := resource.PropertyMap{}
 	for k, v := range source {
@@ -1,13 +1,18 @@
 package provider
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
 )
 
 func checkOptionalString(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
 	value := props[resource.PropertyKey(key)]
-	if value.IsNull() {
+	if value.IsNull() || value.ContainsUnknowns() {
 		return
 	}
 
@@ -21,6 +26,11 @@ func checkOptionalString(failures *[]*rpc.CheckFailure, props resource.PropertyM
 
 func checkNonEmptyString(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
 	value := props[resource.PropertyKey(key)]
+	if value.ContainsUnknowns() {
+		// Inputs computed from other resources are only known after they
+		// are created.
+		return
+	}
 	if value.IsNull() || !value.IsString() || (value.StringValue() == "") {
 		*failures = append(*failures, &rpc.CheckFailure{
 			Property: key,
@@ -28,3 +38,210 @@ func checkNonEmptyString(failures *[]*rpc.CheckFailure, props resource.PropertyM
 		})
 	}
 }
+
+func checkOptionalStringArray(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	value := props[resource.PropertyKey(key)]
+	if value.IsNull() || value.IsComputed() {
+		return
+	}
+
+	valid := value.IsArray()
+	if valid {
+		for _, element := range value.ArrayValue() {
+			if !element.ContainsUnknowns() && (!element.IsString() || element.StringValue() == "") {
+				valid = false
+			}
+		}
+	}
+	if !valid {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   "this input must be a list of non-empty strings",
+		})
+	}
+}
+
+func checkOptionalBool(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	value := props[resource.PropertyKey(key)]
+	if value.IsNull() || value.ContainsUnknowns() {
+		return
+	}
+
+	if !value.IsBool() {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   "this input must be a boolean",
+		})
+	}
+}
+
+func checkOptionalPositiveInteger(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	value := props[resource.PropertyKey(key)]
+	if value.IsNull() || value.ContainsUnknowns() {
+		return
+	}
+
+	if !value.IsNumber() || value.NumberValue() <= 0 || value.NumberValue() != float64(int(value.NumberValue())) {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   "this input must be a positive integer",
+		})
+	}
+}
+
+func checkOptionalNonNegativeInteger(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	value := props[resource.PropertyKey(key)]
+	if value.IsNull() || value.ContainsUnknowns() {
+		return
+	}
+
+	if !value.IsNumber() || value.NumberValue() < 0 || value.NumberValue() != float64(int(value.NumberValue())) {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   "this input must be a non-negative integer",
+		})
+	}
+}
+
+// checkBothOrNeither reports both keys as failures if only one of them is set.
+func checkBothOrNeither(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key1, key2 string) {
+	if props[resource.PropertyKey(key1)].IsNull() == props[resource.PropertyKey(key2)].IsNull() {
+		return
+	}
+	reason := fmt.Sprintf("%s and %s must be set together", key1, key2)
+	*failures = append(*failures,
+		&rpc.CheckFailure{Property: key1, Reason: reason},
+		&rpc.CheckFailure{Property: key2, Reason: reason},
+	)
+}
+
+func checkHTTPURL(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	value := props[resource.PropertyKey(key)]
+	if value.ContainsUnknowns() {
+		return
+	}
+	if value.IsString() {
+		if u, err := url.Parse(value.StringValue()); err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+			return
+		}
+	}
+	*failures = append(*failures, &rpc.CheckFailure{
+		Property: key,
+		Reason:   "this input must be an absolute http(s) URL",
+	})
+}
+
+func checkOptionalOneOf(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string, allowed ...string) {
+	value := props[resource.PropertyKey(key)]
+	if value.IsNull() || value.ContainsUnknowns() {
+		return
+	}
+
+	if value.IsString() {
+		for _, a := range allowed {
+			if value.StringValue() == a {
+				return
+			}
+		}
+	}
+	*failures = append(*failures, &rpc.CheckFailure{
+		Property: key,
+		Reason:   fmt.Sprintf("this input must be one of: %s", strings.Join(allowed, ", ")),
+	})
+}
+
+// checkObjectsWithID checks a list of objects identified by their "id", like
+// conditions or actions of alert rules.  If nonEmpty is set, the list must
+// have at least one element.
+func checkObjectsWithID(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string, nonEmpty bool) {
+	value := props[resource.PropertyKey(key)]
+	if value.IsComputed() || (value.IsNull() && !nonEmpty) {
+		return
+	}
+
+	valid := value.IsArray() && (!nonEmpty || len(value.ArrayValue()) > 0)
+	if valid {
+		for _, element := range value.ArrayValue() {
+			if element.ContainsUnknowns() {
+				continue
+			}
+			if !element.IsObject() || !element.ObjectValue()["id"].IsString() || element.ObjectValue()["id"].StringValue() == "" {
+				valid = false
+			}
+		}
+	}
+	if !valid {
+		reason := "this input must be a list of objects with a non-empty id"
+		if nonEmpty {
+			reason = "this input must be a non-empty list of objects with a non-empty id"
+		}
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   reason,
+		})
+	}
+}
+
+func checkOneOf(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string, allowed ...string) {
+	if props[resource.PropertyKey(key)].IsNull() {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   fmt.Sprintf("this input must be one of: %s", strings.Join(allowed, ", ")),
+		})
+		return
+	}
+	checkOptionalOneOf(failures, props, key, allowed...)
+}
+
+func checkOptionalNumber(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	value := props[resource.PropertyKey(key)]
+	if value.IsNull() || value.ContainsUnknowns() {
+		return
+	}
+
+	if !value.IsNumber() {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   "this input must be a number",
+		})
+	}
+}
+
+func checkNumber(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	if props[resource.PropertyKey(key)].IsNull() {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   "this input must be a number",
+		})
+		return
+	}
+	checkOptionalNumber(failures, props, key)
+}
+
+func checkPositiveInteger(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	if props[resource.PropertyKey(key)].IsNull() {
+		*failures = append(*failures, &rpc.CheckFailure{
+			Property: key,
+			Reason:   "this input must be a positive integer",
+		})
+		return
+	}
+	checkOptionalPositiveInteger(failures, props, key)
+}
+
+func checkOptionalTime(failures *[]*rpc.CheckFailure, props resource.PropertyMap, key string) {
+	value := props[resource.PropertyKey(key)]
+	if value.IsNull() || value.ContainsUnknowns() {
+		return
+	}
+
+	if value.IsString() {
+		if _, err := time.Parse(time.RFC3339, value.StringValue()); err == nil {
+			return
+		}
+	}
+	*failures = append(*failures, &rpc.CheckFailure{
+		Property: key,
+		Reason:   "this input must be a date and time in RFC 3339 format, e.g. 2020-12-01T10:00:00Z",
+	})
+}
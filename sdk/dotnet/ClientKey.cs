@@ -0,0 +1,118 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    public partial class ClientKey : Pulumi.CustomResource
+    {
+        [Output("dsnCSP")]
+        public Output<string> DsnCSP { get; private set; } = null!;
+
+        [Output("dsnPublic")]
+        public Output<string> DsnPublic { get; private set; } = null!;
+
+        [Output("dsnSecret")]
+        public Output<string> DsnSecret { get; private set; } = null!;
+
+        [Output("dsnSecurity")]
+        public Output<string> DsnSecurity { get; private set; } = null!;
+
+        [Output("isActive")]
+        public Output<bool> IsActive { get; private set; } = null!;
+
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlug")]
+        public Output<string> ProjectSlug { get; private set; } = null!;
+
+        [Output("rateLimitCount")]
+        public Output<int?> RateLimitCount { get; private set; } = null!;
+
+        [Output("rateLimitWindow")]
+        public Output<int?> RateLimitWindow { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ClientKey resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ClientKey(string name, ClientKeyArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:ClientKey", name, args ?? new ClientKeyArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ClientKey(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:ClientKey", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "dsnSecret",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ClientKey resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ClientKey Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ClientKey(name, id, options);
+        }
+    }
+
+    public sealed class ClientKeyArgs : Pulumi.ResourceArgs
+    {
+        [Input("isActive")]
+        public Input<bool>? IsActive { get; set; }
+
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlug", required: true)]
+        public Input<string> ProjectSlug { get; set; } = null!;
+
+        [Input("rateLimitCount")]
+        public Input<int>? RateLimitCount { get; set; }
+
+        [Input("rateLimitWindow")]
+        public Input<int>? RateLimitWindow { get; set; }
+
+        public ClientKeyArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,32 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System.Collections.Immutable;
+
+namespace Pulumi.Sentry
+{
+    public static class Config
+    {
+        private static readonly Pulumi.Config __config = new Pulumi.Config("sentry");
+        /// <summary>
+        /// The URL of the Sentry API, e.g. https://sentry.io/api/0/. Falls back to the server URL in SENTRY_URL.
+        /// </summary>
+        public static string? ApiURL { get; set; } = __config.Get("apiURL") ?? Utilities.GetEnv("SENTRY_URL");
+
+        /// <summary>
+        /// The default organization slug for resources that don't set one.
+        /// </summary>
+        public static string? Organization { get; set; } = __config.Get("organization") ?? Utilities.GetEnv("SENTRY_ORG");
+
+        /// <summary>
+        /// Skip checking the token against the Sentry API when the provider starts.
+        /// </summary>
+        public static bool? SkipCredentialsValidation { get; set; } = __config.GetBoolean("skipCredentialsValidation");
+
+        /// <summary>
+        /// The Sentry auth token.
+        /// </summary>
+        public static string? Token { get; set; } = __config.Get("token") ?? Utilities.GetEnv("SENTRY_AUTH_TOKEN");
+
+    }
+}
@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// A dashboard of an organization: a grid of widgets charting the results of queries of events.
+    /// </summary>
+    public partial class Dashboard : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("title")]
+        public Output<string> Title { get; private set; } = null!;
+
+        /// <summary>
+        /// The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+        /// </summary>
+        [Output("widgets")]
+        public Output<ImmutableArray<Outputs.DashboardWidget>> Widgets { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Dashboard resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Dashboard(string name, DashboardArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:Dashboard", name, args ?? new DashboardArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Dashboard(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:Dashboard", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Dashboard resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Dashboard Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Dashboard(name, id, options);
+        }
+    }
+
+    public sealed class DashboardArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("title", required: true)]
+        public Input<string> Title { get; set; } = null!;
+
+        [Input("widgets")]
+        private InputList<Inputs.DashboardWidgetArgs>? _widgets;
+
+        /// <summary>
+        /// The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+        /// </summary>
+        public InputList<Inputs.DashboardWidgetArgs> Widgets
+        {
+            get => _widgets ?? (_widgets = new InputList<Inputs.DashboardWidgetArgs>());
+            set => _widgets = value;
+        }
+
+        public DashboardArgs()
+        {
+        }
+    }
+}
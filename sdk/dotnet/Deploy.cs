@@ -0,0 +1,138 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// A deploy of a release to an environment. Sentry keeps deploys as a log: any change records a new deploy, and deleting the resource leaves the deploy in Sentry.
+    /// </summary>
+    public partial class Deploy : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+        /// </summary>
+        [Output("dateFinished")]
+        public Output<string> DateFinished { get; private set; } = null!;
+
+        /// <summary>
+        /// When the deploy started, in RFC 3339 format.
+        /// </summary>
+        [Output("dateStarted")]
+        public Output<string?> DateStarted { get; private set; } = null!;
+
+        [Output("environment")]
+        public Output<string> Environment { get; private set; } = null!;
+
+        [Output("name")]
+        public Output<string?> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// A link to the deploy, e.g. to its pipeline.
+        /// </summary>
+        [Output("url")]
+        public Output<string?> Url { get; private set; } = null!;
+
+        /// <summary>
+        /// The version of the release deployed.
+        /// </summary>
+        [Output("version")]
+        public Output<string> Version { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Deploy resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Deploy(string name, DeployArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:Deploy", name, args ?? new DeployArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Deploy(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:Deploy", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Deploy resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Deploy Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Deploy(name, id, options);
+        }
+    }
+
+    public sealed class DeployArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+        /// </summary>
+        [Input("dateFinished")]
+        public Input<string>? DateFinished { get; set; }
+
+        /// <summary>
+        /// When the deploy started, in RFC 3339 format.
+        /// </summary>
+        [Input("dateStarted")]
+        public Input<string>? DateStarted { get; set; }
+
+        [Input("environment", required: true)]
+        public Input<string> Environment { get; set; } = null!;
+
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        /// <summary>
+        /// A link to the deploy, e.g. to its pipeline.
+        /// </summary>
+        [Input("url")]
+        public Input<string>? Url { get; set; }
+
+        /// <summary>
+        /// The version of the release deployed.
+        /// </summary>
+        [Input("version", required: true)]
+        public Input<string> Version { get; set; } = null!;
+
+        public DeployArgs()
+        {
+        }
+    }
+}
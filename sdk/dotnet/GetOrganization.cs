@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    public static class GetOrganization
+    {
+        public static Task<GetOrganizationResult> InvokeAsync(GetOrganizationArgs args, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<GetOrganizationResult>("sentry:index:getOrganization", args ?? new GetOrganizationArgs(), options.WithVersion());
+    }
+
+
+    public sealed class GetOrganizationArgs : Pulumi.InvokeArgs
+    {
+        [Input("slug", required: true)]
+        public string Slug { get; set; } = null!;
+
+        public GetOrganizationArgs()
+        {
+        }
+    }
+
+
+    [OutputType]
+    public sealed class GetOrganizationResult
+    {
+        public readonly string Id;
+        public readonly string Name;
+        public readonly string Slug;
+
+        [OutputConstructor]
+        private GetOrganizationResult(
+            string id,
+
+            string name,
+
+            string slug)
+        {
+            Id = id;
+            Name = name;
+            Slug = slug;
+        }
+    }
+}
@@ -0,0 +1,94 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    public static class GetOrganizationIntegration
+    {
+        /// <summary>
+        /// Finds an integration installed in an organization, e.g. a Slack workspace, by its provider and name.
+        /// </summary>
+        public static Task<GetOrganizationIntegrationResult> InvokeAsync(GetOrganizationIntegrationArgs args, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<GetOrganizationIntegrationResult>("sentry:index:getOrganizationIntegration", args ?? new GetOrganizationIntegrationArgs(), options.WithVersion());
+    }
+
+
+    public sealed class GetOrganizationIntegrationArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the integration as shown in Sentry, e.g. the Slack workspace or GitHub owner.
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public string? OrganizationSlug { get; set; }
+
+        /// <summary>
+        /// The service of the integration, e.g. slack, github or jira.
+        /// </summary>
+        [Input("providerKey", required: true)]
+        public string ProviderKey { get; set; } = null!;
+
+        public GetOrganizationIntegrationArgs()
+        {
+        }
+    }
+
+
+    [OutputType]
+    public sealed class GetOrganizationIntegrationResult
+    {
+        public readonly string DomainName;
+        /// <summary>
+        /// The ID of the integration in the service, e.g. the Slack workspace ID.
+        /// </summary>
+        public readonly string ExternalId;
+        /// <summary>
+        /// The integration ID used by alert rule actions and repositories.
+        /// </summary>
+        public readonly int Id;
+        public readonly string Name;
+        public readonly string OrganizationSlug;
+        public readonly string ProviderKey;
+        public readonly string ProviderName;
+        public readonly string Status;
+
+        [OutputConstructor]
+        private GetOrganizationIntegrationResult(
+            string domainName,
+
+            string externalId,
+
+            int id,
+
+            string name,
+
+            string organizationSlug,
+
+            string providerKey,
+
+            string providerName,
+
+            string status)
+        {
+            DomainName = domainName;
+            ExternalId = externalId;
+            Id = id;
+            Name = name;
+            OrganizationSlug = organizationSlug;
+            ProviderKey = providerKey;
+            ProviderName = providerName;
+            Status = status;
+        }
+    }
+}
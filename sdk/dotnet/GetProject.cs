@@ -0,0 +1,154 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    public static class GetProject
+    {
+        public static Task<GetProjectResult> InvokeAsync(GetProjectArgs args, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<GetProjectResult>("sentry:index:getProject", args ?? new GetProjectArgs(), options.WithVersion());
+    }
+
+
+    public sealed class GetProjectArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public string? OrganizationSlug { get; set; }
+
+        [Input("slug", required: true)]
+        public string Slug { get; set; } = null!;
+
+        public GetProjectArgs()
+        {
+        }
+    }
+
+
+    [OutputType]
+    public sealed class GetProjectResult
+    {
+        /// <summary>
+        /// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+        /// </summary>
+        public readonly ImmutableArray<string> AllowedDomains;
+        /// <summary>
+        /// Whether Sentry removes sensitive data from events server-side.
+        /// </summary>
+        public readonly bool? DataScrubber;
+        /// <summary>
+        /// Whether the data scrubber applies its default list of sensitive fields.
+        /// </summary>
+        public readonly bool? DataScrubberDefaults;
+        public readonly string DefaultClientKeyDSNPublic;
+        /// <summary>
+        /// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+        /// </summary>
+        public readonly int? DefaultClientKeyRateLimitCount;
+        /// <summary>
+        /// The window of the default client key's rate limit, in seconds.
+        /// </summary>
+        public readonly int? DefaultClientKeyRateLimitWindow;
+        public readonly string? DefaultEnvironment;
+        public readonly string Id;
+        public readonly string Name;
+        public readonly string OrganizationSlug;
+        /// <summary>
+        /// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+        /// </summary>
+        public readonly int? ResolveAge;
+        /// <summary>
+        /// Field names the data scrubber must leave as they are.
+        /// </summary>
+        public readonly ImmutableArray<string> SafeFields;
+        /// <summary>
+        /// Whether Sentry fetches JavaScript source files and source maps.
+        /// </summary>
+        public readonly bool? ScrapeJavaScript;
+        /// <summary>
+        /// Whether IP addresses are removed from events.
+        /// </summary>
+        public readonly bool? ScrubIPAddresses;
+        /// <summary>
+        /// Additional field names the data scrubber removes.
+        /// </summary>
+        public readonly ImmutableArray<string> SensitiveFields;
+        public readonly string Slug;
+        public readonly string? SubjectPrefix;
+        public readonly string? SubjectTemplate;
+        public readonly string? TeamSlug;
+        public readonly ImmutableArray<string> TeamSlugs;
+
+        [OutputConstructor]
+        private GetProjectResult(
+            ImmutableArray<string> allowedDomains,
+
+            bool? dataScrubber,
+
+            bool? dataScrubberDefaults,
+
+            string defaultClientKeyDSNPublic,
+
+            int? defaultClientKeyRateLimitCount,
+
+            int? defaultClientKeyRateLimitWindow,
+
+            string? defaultEnvironment,
+
+            string id,
+
+            string name,
+
+            string organizationSlug,
+
+            int? resolveAge,
+
+            ImmutableArray<string> safeFields,
+
+            bool? scrapeJavaScript,
+
+            bool? scrubIPAddresses,
+
+            ImmutableArray<string> sensitiveFields,
+
+            string slug,
+
+            string? subjectPrefix,
+
+            string? subjectTemplate,
+
+            string? teamSlug,
+
+            ImmutableArray<string> teamSlugs)
+        {
+            AllowedDomains = allowedDomains;
+            DataScrubber = dataScrubber;
+            DataScrubberDefaults = dataScrubberDefaults;
+            DefaultClientKeyDSNPublic = defaultClientKeyDSNPublic;
+            DefaultClientKeyRateLimitCount = defaultClientKeyRateLimitCount;
+            DefaultClientKeyRateLimitWindow = defaultClientKeyRateLimitWindow;
+            DefaultEnvironment = defaultEnvironment;
+            Id = id;
+            Name = name;
+            OrganizationSlug = organizationSlug;
+            ResolveAge = resolveAge;
+            SafeFields = safeFields;
+            ScrapeJavaScript = scrapeJavaScript;
+            ScrubIPAddresses = scrubIPAddresses;
+            SensitiveFields = sensitiveFields;
+            Slug = slug;
+            SubjectPrefix = subjectPrefix;
+            SubjectTemplate = subjectTemplate;
+            TeamSlug = teamSlug;
+            TeamSlugs = teamSlugs;
+        }
+    }
+}
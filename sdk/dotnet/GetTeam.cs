@@ -0,0 +1,60 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    public static class GetTeam
+    {
+        public static Task<GetTeamResult> InvokeAsync(GetTeamArgs args, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<GetTeamResult>("sentry:index:getTeam", args ?? new GetTeamArgs(), options.WithVersion());
+    }
+
+
+    public sealed class GetTeamArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public string? OrganizationSlug { get; set; }
+
+        [Input("slug", required: true)]
+        public string Slug { get; set; } = null!;
+
+        public GetTeamArgs()
+        {
+        }
+    }
+
+
+    [OutputType]
+    public sealed class GetTeamResult
+    {
+        public readonly string Id;
+        public readonly string Name;
+        public readonly string OrganizationSlug;
+        public readonly string Slug;
+
+        [OutputConstructor]
+        private GetTeamResult(
+            string id,
+
+            string name,
+
+            string organizationSlug,
+
+            string slug)
+        {
+            Id = id;
+            Name = name;
+            OrganizationSlug = organizationSlug;
+            Slug = slug;
+        }
+    }
+}
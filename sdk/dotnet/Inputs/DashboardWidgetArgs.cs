@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Inputs
+{
+
+    /// <summary>
+    /// A chart, table or number of a dashboard.
+    /// </summary>
+    public sealed class DashboardWidgetArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// line, area, stacked_area, bar, table, big_number, top_n or world_map.
+        /// </summary>
+        [Input("displayType", required: true)]
+        public Input<string> DisplayType { get; set; } = null!;
+
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        [Input("id")]
+        public Input<string>? Id { get; set; }
+
+        [Input("layout")]
+        public Input<Inputs.DashboardWidgetLayoutArgs>? Layout { get; set; }
+
+        [Input("queries", required: true)]
+        private InputList<Inputs.DashboardWidgetQueryArgs>? _queries;
+        public InputList<Inputs.DashboardWidgetQueryArgs> Queries
+        {
+            get => _queries ?? (_queries = new InputList<Inputs.DashboardWidgetQueryArgs>());
+            set => _queries = value;
+        }
+
+        [Input("title", required: true)]
+        public Input<string> Title { get; set; } = null!;
+
+        public DashboardWidgetArgs()
+        {
+        }
+    }
+}
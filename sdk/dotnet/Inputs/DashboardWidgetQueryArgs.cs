@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Inputs
+{
+
+    /// <summary>
+    /// A query of events drawn by a widget of a dashboard.
+    /// </summary>
+    public sealed class DashboardWidgetQueryArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+        /// </summary>
+        [Input("conditions")]
+        public Input<string>? Conditions { get; set; }
+
+        [Input("fields", required: true)]
+        private InputList<string>? _fields;
+
+        /// <summary>
+        /// The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+        /// </summary>
+        public InputList<string> Fields
+        {
+            get => _fields ?? (_fields = new InputList<string>());
+            set => _fields = value;
+        }
+
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        [Input("id")]
+        public Input<string>? Id { get; set; }
+
+        /// <summary>
+        /// The legend of the query.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// The field to sort by, prefixed with - for descending order.
+        /// </summary>
+        [Input("orderby")]
+        public Input<string>? Orderby { get; set; }
+
+        public DashboardWidgetQueryArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Inputs
+{
+
+    /// <summary>
+    /// An action run when a trigger of a metric alert rule fires.
+    /// </summary>
+    public sealed class MetricAlertRuleTriggerActionArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        [Input("id")]
+        public Input<string>? Id { get; set; }
+
+        /// <summary>
+        /// The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+        /// </summary>
+        [Input("integrationId")]
+        public Input<int>? IntegrationId { get; set; }
+
+        /// <summary>
+        /// The user or team ID, or the channel name for specific targets.
+        /// </summary>
+        [Input("targetIdentifier")]
+        public Input<string>? TargetIdentifier { get; set; }
+
+        /// <summary>
+        /// user, team, specific or sentry_app.
+        /// </summary>
+        [Input("targetType", required: true)]
+        public Input<string> TargetType { get; set; } = null!;
+
+        /// <summary>
+        /// email, slack, pagerduty, msteams or sentry_app.
+        /// </summary>
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        public MetricAlertRuleTriggerActionArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Inputs
+{
+
+    /// <summary>
+    /// A threshold of a metric alert rule, with the actions run when it's crossed.
+    /// </summary>
+    public sealed class MetricAlertRuleTriggerArgs : Pulumi.ResourceArgs
+    {
+        [Input("actions", required: true)]
+        private InputList<Inputs.MetricAlertRuleTriggerActionArgs>? _actions;
+        public InputList<Inputs.MetricAlertRuleTriggerActionArgs> Actions
+        {
+            get => _actions ?? (_actions = new InputList<Inputs.MetricAlertRuleTriggerActionArgs>());
+            set => _actions = value;
+        }
+
+        /// <summary>
+        /// The value of the metric firing the trigger.
+        /// </summary>
+        [Input("alertThreshold", required: true)]
+        public Input<double> AlertThreshold { get; set; } = null!;
+
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        [Input("id")]
+        public Input<string>? Id { get; set; }
+
+        /// <summary>
+        /// critical or warning.
+        /// </summary>
+        [Input("label", required: true)]
+        public Input<string> Label { get; set; } = null!;
+
+        public MetricAlertRuleTriggerArgs()
+        {
+        }
+    }
+}
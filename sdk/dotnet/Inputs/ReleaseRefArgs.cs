@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Inputs
+{
+
+    /// <summary>
+    /// A commit of a repository of the organization in a release.
+    /// </summary>
+    public sealed class ReleaseRefArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The SHA of the last commit of the release.
+        /// </summary>
+        [Input("commit", required: true)]
+        public Input<string> Commit { get; set; } = null!;
+
+        /// <summary>
+        /// The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+        /// </summary>
+        [Input("previousCommit")]
+        public Input<string>? PreviousCommit { get; set; }
+
+        /// <summary>
+        /// The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+        /// </summary>
+        [Input("repository", required: true)]
+        public Input<string> Repository { get; set; } = null!;
+
+        public ReleaseRefArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,186 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    public partial class IssueAlertRule : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// When to run the actions: all, any or none of the conditions match. Defaults to all.
+        /// </summary>
+        [Output("actionMatch")]
+        public Output<string> ActionMatch { get; private set; } = null!;
+
+        /// <summary>
+        /// Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+        /// </summary>
+        [Output("actions")]
+        public Output<ImmutableArray<ImmutableDictionary<string, object>>> Actions { get; private set; } = null!;
+
+        /// <summary>
+        /// Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+        /// </summary>
+        [Output("conditions")]
+        public Output<ImmutableArray<ImmutableDictionary<string, object>>> Conditions { get; private set; } = null!;
+
+        /// <summary>
+        /// Only trigger the rule for events from this environment.
+        /// </summary>
+        [Output("environment")]
+        public Output<string?> Environment { get; private set; } = null!;
+
+        /// <summary>
+        /// How filters are combined: all, any or none. Defaults to all.
+        /// </summary>
+        [Output("filterMatch")]
+        public Output<string> FilterMatch { get; private set; } = null!;
+
+        /// <summary>
+        /// Filters events must pass for the rule to fire.
+        /// </summary>
+        [Output("filters")]
+        public Output<ImmutableArray<ImmutableDictionary<string, object>>> Filters { get; private set; } = null!;
+
+        /// <summary>
+        /// Minimum number of minutes between actions for the same issue. Defaults to 30.
+        /// </summary>
+        [Output("frequency")]
+        public Output<int> Frequency { get; private set; } = null!;
+
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlug")]
+        public Output<string> ProjectSlug { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a IssueAlertRule resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public IssueAlertRule(string name, IssueAlertRuleArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:IssueAlertRule", name, args ?? new IssueAlertRuleArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private IssueAlertRule(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:IssueAlertRule", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing IssueAlertRule resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static IssueAlertRule Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new IssueAlertRule(name, id, options);
+        }
+    }
+
+    public sealed class IssueAlertRuleArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// When to run the actions: all, any or none of the conditions match. Defaults to all.
+        /// </summary>
+        [Input("actionMatch")]
+        public Input<string>? ActionMatch { get; set; }
+
+        [Input("actions", required: true)]
+        private InputList<ImmutableDictionary<string, object>>? _actions;
+
+        /// <summary>
+        /// Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+        /// </summary>
+        public InputList<ImmutableDictionary<string, object>> Actions
+        {
+            get => _actions ?? (_actions = new InputList<ImmutableDictionary<string, object>>());
+            set => _actions = value;
+        }
+
+        [Input("conditions")]
+        private InputList<ImmutableDictionary<string, object>>? _conditions;
+
+        /// <summary>
+        /// Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+        /// </summary>
+        public InputList<ImmutableDictionary<string, object>> Conditions
+        {
+            get => _conditions ?? (_conditions = new InputList<ImmutableDictionary<string, object>>());
+            set => _conditions = value;
+        }
+
+        /// <summary>
+        /// Only trigger the rule for events from this environment.
+        /// </summary>
+        [Input("environment")]
+        public Input<string>? Environment { get; set; }
+
+        /// <summary>
+        /// How filters are combined: all, any or none. Defaults to all.
+        /// </summary>
+        [Input("filterMatch")]
+        public Input<string>? FilterMatch { get; set; }
+
+        [Input("filters")]
+        private InputList<ImmutableDictionary<string, object>>? _filters;
+
+        /// <summary>
+        /// Filters events must pass for the rule to fire.
+        /// </summary>
+        public InputList<ImmutableDictionary<string, object>> Filters
+        {
+            get => _filters ?? (_filters = new InputList<ImmutableDictionary<string, object>>());
+            set => _filters = value;
+        }
+
+        /// <summary>
+        /// Minimum number of minutes between actions for the same issue. Defaults to 30.
+        /// </summary>
+        [Input("frequency")]
+        public Input<int>? Frequency { get; set; }
+
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlug", required: true)]
+        public Input<string> ProjectSlug { get; set; } = null!;
+
+        public IssueAlertRuleArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,179 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    public partial class MetricAlertRule : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The metric, e.g. count() or p95(transaction.duration).
+        /// </summary>
+        [Output("aggregate")]
+        public Output<string> Aggregate { get; private set; } = null!;
+
+        /// <summary>
+        /// events or transactions. Defaults to events.
+        /// </summary>
+        [Output("dataset")]
+        public Output<string> Dataset { get; private set; } = null!;
+
+        /// <summary>
+        /// Only count events from this environment.
+        /// </summary>
+        [Output("environment")]
+        public Output<string?> Environment { get; private set; } = null!;
+
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlug")]
+        public Output<string> ProjectSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// Only count events matching this search query.
+        /// </summary>
+        [Output("query")]
+        public Output<string> Query { get; private set; } = null!;
+
+        /// <summary>
+        /// The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+        /// </summary>
+        [Output("resolveThreshold")]
+        public Output<double?> ResolveThreshold { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the alert fires above or below the thresholds. Defaults to above.
+        /// </summary>
+        [Output("thresholdType")]
+        public Output<string> ThresholdType { get; private set; } = null!;
+
+        /// <summary>
+        /// The period the metric is aggregated over, in minutes.
+        /// </summary>
+        [Output("timeWindow")]
+        public Output<int> TimeWindow { get; private set; } = null!;
+
+        [Output("triggers")]
+        public Output<ImmutableArray<Outputs.MetricAlertRuleTrigger>> Triggers { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a MetricAlertRule resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public MetricAlertRule(string name, MetricAlertRuleArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:MetricAlertRule", name, args ?? new MetricAlertRuleArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private MetricAlertRule(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:MetricAlertRule", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing MetricAlertRule resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static MetricAlertRule Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new MetricAlertRule(name, id, options);
+        }
+    }
+
+    public sealed class MetricAlertRuleArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The metric, e.g. count() or p95(transaction.duration).
+        /// </summary>
+        [Input("aggregate", required: true)]
+        public Input<string> Aggregate { get; set; } = null!;
+
+        /// <summary>
+        /// events or transactions. Defaults to events.
+        /// </summary>
+        [Input("dataset")]
+        public Input<string>? Dataset { get; set; }
+
+        /// <summary>
+        /// Only count events from this environment.
+        /// </summary>
+        [Input("environment")]
+        public Input<string>? Environment { get; set; }
+
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlug", required: true)]
+        public Input<string> ProjectSlug { get; set; } = null!;
+
+        /// <summary>
+        /// Only count events matching this search query.
+        /// </summary>
+        [Input("query")]
+        public Input<string>? Query { get; set; }
+
+        /// <summary>
+        /// The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+        /// </summary>
+        [Input("resolveThreshold")]
+        public Input<double>? ResolveThreshold { get; set; }
+
+        /// <summary>
+        /// Whether the alert fires above or below the thresholds. Defaults to above.
+        /// </summary>
+        [Input("thresholdType")]
+        public Input<string>? ThresholdType { get; set; }
+
+        /// <summary>
+        /// The period the metric is aggregated over, in minutes.
+        /// </summary>
+        [Input("timeWindow", required: true)]
+        public Input<int> TimeWindow { get; set; } = null!;
+
+        [Input("triggers", required: true)]
+        private InputList<Inputs.MetricAlertRuleTriggerArgs>? _triggers;
+        public InputList<Inputs.MetricAlertRuleTriggerArgs> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputList<Inputs.MetricAlertRuleTriggerArgs>());
+            set => _triggers = value;
+        }
+
+        public MetricAlertRuleArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,174 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// A cron monitor of a project, alerting when a scheduled job misses a check-in or runs for too long.
+    /// </summary>
+    public partial class Monitor : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+        /// </summary>
+        [Output("checkinMargin")]
+        public Output<int?> CheckinMargin { get; private set; } = null!;
+
+        /// <summary>
+        /// minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+        /// </summary>
+        [Output("intervalUnit")]
+        public Output<string?> IntervalUnit { get; private set; } = null!;
+
+        /// <summary>
+        /// How many intervalUnits there are between runs of the job.
+        /// </summary>
+        [Output("intervalValue")]
+        public Output<int?> IntervalValue { get; private set; } = null!;
+
+        /// <summary>
+        /// How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+        /// </summary>
+        [Output("maxRuntime")]
+        public Output<int?> MaxRuntime { get; private set; } = null!;
+
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlug")]
+        public Output<string> ProjectSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+        /// </summary>
+        [Output("schedule")]
+        public Output<string?> Schedule { get; private set; } = null!;
+
+        /// <summary>
+        /// The slug jobs check in with.
+        /// </summary>
+        [Output("slug")]
+        public Output<string> Slug { get; private set; } = null!;
+
+        /// <summary>
+        /// The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+        /// </summary>
+        [Output("timezone")]
+        public Output<string> Timezone { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Monitor resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Monitor(string name, MonitorArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:Monitor", name, args ?? new MonitorArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Monitor(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:Monitor", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Monitor resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Monitor Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Monitor(name, id, options);
+        }
+    }
+
+    public sealed class MonitorArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+        /// </summary>
+        [Input("checkinMargin")]
+        public Input<int>? CheckinMargin { get; set; }
+
+        /// <summary>
+        /// minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+        /// </summary>
+        [Input("intervalUnit")]
+        public Input<string>? IntervalUnit { get; set; }
+
+        /// <summary>
+        /// How many intervalUnits there are between runs of the job.
+        /// </summary>
+        [Input("intervalValue")]
+        public Input<int>? IntervalValue { get; set; }
+
+        /// <summary>
+        /// How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+        /// </summary>
+        [Input("maxRuntime")]
+        public Input<int>? MaxRuntime { get; set; }
+
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlug", required: true)]
+        public Input<string> ProjectSlug { get; set; } = null!;
+
+        /// <summary>
+        /// The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+        /// </summary>
+        [Input("schedule")]
+        public Input<string>? Schedule { get; set; }
+
+        /// <summary>
+        /// The slug jobs check in with.
+        /// </summary>
+        [Input("slug", required: true)]
+        public Input<string> Slug { get; set; } = null!;
+
+        /// <summary>
+        /// The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+        /// </summary>
+        [Input("timezone")]
+        public Input<string>? Timezone { get; set; }
+
+        public MonitorArgs()
+        {
+        }
+    }
+}
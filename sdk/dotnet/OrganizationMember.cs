@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// A member of an organization, invited by email on creation and removed from the organization on deletion.
+    /// </summary>
+    public partial class OrganizationMember : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The email the invitation is sent to.
+        /// </summary>
+        [Output("email")]
+        public Output<string> Email { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the member, e.g. for TeamMember resources.
+        /// </summary>
+        [Output("memberId")]
+        public Output<string> MemberId { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the invitation has not been accepted yet.
+        /// </summary>
+        [Output("pending")]
+        public Output<bool> Pending { get; private set; } = null!;
+
+        /// <summary>
+        /// One of member, admin, manager, owner or billing. Defaults to member.
+        /// </summary>
+        [Output("role")]
+        public Output<string> Role { get; private set; } = null!;
+
+        /// <summary>
+        /// The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+        /// </summary>
+        [Output("teamSlugs")]
+        public Output<ImmutableArray<string>> TeamSlugs { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a OrganizationMember resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public OrganizationMember(string name, OrganizationMemberArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:OrganizationMember", name, args ?? new OrganizationMemberArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private OrganizationMember(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:OrganizationMember", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing OrganizationMember resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static OrganizationMember Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new OrganizationMember(name, id, options);
+        }
+    }
+
+    public sealed class OrganizationMemberArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The email the invitation is sent to.
+        /// </summary>
+        [Input("email", required: true)]
+        public Input<string> Email { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        /// <summary>
+        /// One of member, admin, manager, owner or billing. Defaults to member.
+        /// </summary>
+        [Input("role")]
+        public Input<string>? Role { get; set; }
+
+        [Input("teamSlugs")]
+        private InputList<string>? _teamSlugs;
+
+        /// <summary>
+        /// The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+        /// </summary>
+        public InputList<string> TeamSlugs
+        {
+            get => _teamSlugs ?? (_teamSlugs = new InputList<string>());
+            set => _teamSlugs = value;
+        }
+
+        public OrganizationMemberArgs()
+        {
+        }
+    }
+}
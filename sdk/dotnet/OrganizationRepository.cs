@@ -0,0 +1,114 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// A code repository linked to an organization through an integration such as GitHub, so that releases can reference its commits.
+    /// </summary>
+    public partial class OrganizationRepository : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+        /// </summary>
+        [Output("integrationId")]
+        public Output<int> IntegrationId { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// Assigned by Sentry, e.g. `integrations:github`.
+        /// </summary>
+        [Output("provider")]
+        public Output<string> Provider { get; private set; } = null!;
+
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        [Output("url")]
+        public Output<string> Url { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a OrganizationRepository resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public OrganizationRepository(string name, OrganizationRepositoryArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:OrganizationRepository", name, args ?? new OrganizationRepositoryArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private OrganizationRepository(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:OrganizationRepository", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing OrganizationRepository resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static OrganizationRepository Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new OrganizationRepository(name, id, options);
+        }
+    }
+
+    public sealed class OrganizationRepositoryArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+        /// </summary>
+        [Input("integrationId", required: true)]
+        public Input<int> IntegrationId { get; set; } = null!;
+
+        /// <summary>
+        /// The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        public OrganizationRepositoryArgs()
+        {
+        }
+    }
+}
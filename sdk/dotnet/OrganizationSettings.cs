@@ -0,0 +1,216 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// The settings of an existing organization. Settings left out keep what they have in Sentry. Creating the resource adopts the organization, and deleting it leaves the organization and its settings as they are.
+    /// </summary>
+    public partial class OrganizationSettings : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Allow sharing of limited details on issues to anonymous users.
+        /// </summary>
+        [Output("allowSharedIssues")]
+        public Output<bool?> AllowSharedIssues { get; private set; } = null!;
+
+        /// <summary>
+        /// Require server-side data scrubbing in all projects.
+        /// </summary>
+        [Output("dataScrubber")]
+        public Output<bool?> DataScrubber { get; private set; } = null!;
+
+        /// <summary>
+        /// Require the default scrubbers in all projects.
+        /// </summary>
+        [Output("dataScrubberDefaults")]
+        public Output<bool?> DataScrubberDefaults { get; private set; } = null!;
+
+        /// <summary>
+        /// The role of new members: member, admin, manager, owner or billing.
+        /// </summary>
+        [Output("defaultRole")]
+        public Output<string?> DefaultRole { get; private set; } = null!;
+
+        /// <summary>
+        /// Keep source code and other sensitive data out of notifications.
+        /// </summary>
+        [Output("enhancedPrivacy")]
+        public Output<bool?> EnhancedPrivacy { get; private set; } = null!;
+
+        [Output("name")]
+        public Output<string?> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Let members join any team of the organization.
+        /// </summary>
+        [Output("openMembership")]
+        public Output<bool?> OpenMembership { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// Require members to enable two-factor authentication.
+        /// </summary>
+        [Output("require2FA")]
+        public Output<bool?> Require2FA { get; private set; } = null!;
+
+        /// <summary>
+        /// Field names data scrubbers ignore in all projects.
+        /// </summary>
+        [Output("safeFields")]
+        public Output<ImmutableArray<string>> SafeFields { get; private set; } = null!;
+
+        /// <summary>
+        /// Keep IP addresses out of events of all projects.
+        /// </summary>
+        [Output("scrubIPAddresses")]
+        public Output<bool?> ScrubIPAddresses { get; private set; } = null!;
+
+        /// <summary>
+        /// Additional field names to scrub in all projects.
+        /// </summary>
+        [Output("sensitiveFields")]
+        public Output<ImmutableArray<string>> SensitiveFields { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a OrganizationSettings resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public OrganizationSettings(string name, OrganizationSettingsArgs? args = null, CustomResourceOptions? options = null)
+            : base("sentry:index:OrganizationSettings", name, args ?? new OrganizationSettingsArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private OrganizationSettings(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:OrganizationSettings", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing OrganizationSettings resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static OrganizationSettings Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new OrganizationSettings(name, id, options);
+        }
+    }
+
+    public sealed class OrganizationSettingsArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Allow sharing of limited details on issues to anonymous users.
+        /// </summary>
+        [Input("allowSharedIssues")]
+        public Input<bool>? AllowSharedIssues { get; set; }
+
+        /// <summary>
+        /// Require server-side data scrubbing in all projects.
+        /// </summary>
+        [Input("dataScrubber")]
+        public Input<bool>? DataScrubber { get; set; }
+
+        /// <summary>
+        /// Require the default scrubbers in all projects.
+        /// </summary>
+        [Input("dataScrubberDefaults")]
+        public Input<bool>? DataScrubberDefaults { get; set; }
+
+        /// <summary>
+        /// The role of new members: member, admin, manager, owner or billing.
+        /// </summary>
+        [Input("defaultRole")]
+        public Input<string>? DefaultRole { get; set; }
+
+        /// <summary>
+        /// Keep source code and other sensitive data out of notifications.
+        /// </summary>
+        [Input("enhancedPrivacy")]
+        public Input<bool>? EnhancedPrivacy { get; set; }
+
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// Let members join any team of the organization.
+        /// </summary>
+        [Input("openMembership")]
+        public Input<bool>? OpenMembership { get; set; }
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        /// <summary>
+        /// Require members to enable two-factor authentication.
+        /// </summary>
+        [Input("require2FA")]
+        public Input<bool>? Require2FA { get; set; }
+
+        [Input("safeFields")]
+        private InputList<string>? _safeFields;
+
+        /// <summary>
+        /// Field names data scrubbers ignore in all projects.
+        /// </summary>
+        public InputList<string> SafeFields
+        {
+            get => _safeFields ?? (_safeFields = new InputList<string>());
+            set => _safeFields = value;
+        }
+
+        /// <summary>
+        /// Keep IP addresses out of events of all projects.
+        /// </summary>
+        [Input("scrubIPAddresses")]
+        public Input<bool>? ScrubIPAddresses { get; set; }
+
+        [Input("sensitiveFields")]
+        private InputList<string>? _sensitiveFields;
+
+        /// <summary>
+        /// Additional field names to scrub in all projects.
+        /// </summary>
+        public InputList<string> SensitiveFields
+        {
+            get => _sensitiveFields ?? (_sensitiveFields = new InputList<string>());
+            set => _sensitiveFields = value;
+        }
+
+        public OrganizationSettingsArgs()
+        {
+        }
+    }
+}
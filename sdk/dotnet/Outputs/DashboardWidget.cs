@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Outputs
+{
+
+    [OutputType]
+    public sealed class DashboardWidget
+    {
+        /// <summary>
+        /// line, area, stacked_area, bar, table, big_number, top_n or world_map.
+        /// </summary>
+        public readonly string DisplayType;
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        public readonly string? Id;
+        public readonly Outputs.DashboardWidgetLayout? Layout;
+        public readonly ImmutableArray<Outputs.DashboardWidgetQuery> Queries;
+        public readonly string Title;
+
+        [OutputConstructor]
+        private DashboardWidget(
+            string displayType,
+
+            string? id,
+
+            Outputs.DashboardWidgetLayout? layout,
+
+            ImmutableArray<Outputs.DashboardWidgetQuery> queries,
+
+            string title)
+        {
+            DisplayType = displayType;
+            Id = id;
+            Layout = layout;
+            Queries = queries;
+            Title = title;
+        }
+    }
+}
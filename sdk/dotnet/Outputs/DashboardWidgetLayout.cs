@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Outputs
+{
+
+    [OutputType]
+    public sealed class DashboardWidgetLayout
+    {
+        public readonly int H;
+        public readonly int W;
+        public readonly int X;
+        public readonly int Y;
+
+        [OutputConstructor]
+        private DashboardWidgetLayout(
+            int h,
+
+            int w,
+
+            int x,
+
+            int y)
+        {
+            H = h;
+            W = w;
+            X = x;
+            Y = y;
+        }
+    }
+}
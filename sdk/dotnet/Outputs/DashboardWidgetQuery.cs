@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Outputs
+{
+
+    [OutputType]
+    public sealed class DashboardWidgetQuery
+    {
+        /// <summary>
+        /// The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+        /// </summary>
+        public readonly string? Conditions;
+        /// <summary>
+        /// The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+        /// </summary>
+        public readonly ImmutableArray<string> Fields;
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        public readonly string? Id;
+        /// <summary>
+        /// The legend of the query.
+        /// </summary>
+        public readonly string? Name;
+        /// <summary>
+        /// The field to sort by, prefixed with - for descending order.
+        /// </summary>
+        public readonly string? Orderby;
+
+        [OutputConstructor]
+        private DashboardWidgetQuery(
+            string? conditions,
+
+            ImmutableArray<string> fields,
+
+            string? id,
+
+            string? name,
+
+            string? orderby)
+        {
+            Conditions = conditions;
+            Fields = fields;
+            Id = id;
+            Name = name;
+            Orderby = orderby;
+        }
+    }
+}
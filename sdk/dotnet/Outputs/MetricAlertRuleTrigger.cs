@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Outputs
+{
+
+    [OutputType]
+    public sealed class MetricAlertRuleTrigger
+    {
+        public readonly ImmutableArray<Outputs.MetricAlertRuleTriggerAction> Actions;
+        /// <summary>
+        /// The value of the metric firing the trigger.
+        /// </summary>
+        public readonly double AlertThreshold;
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        public readonly string? Id;
+        /// <summary>
+        /// critical or warning.
+        /// </summary>
+        public readonly string Label;
+
+        [OutputConstructor]
+        private MetricAlertRuleTrigger(
+            ImmutableArray<Outputs.MetricAlertRuleTriggerAction> actions,
+
+            double alertThreshold,
+
+            string? id,
+
+            string label)
+        {
+            Actions = actions;
+            AlertThreshold = alertThreshold;
+            Id = id;
+            Label = label;
+        }
+    }
+}
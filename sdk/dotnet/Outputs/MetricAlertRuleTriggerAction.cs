@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Outputs
+{
+
+    [OutputType]
+    public sealed class MetricAlertRuleTriggerAction
+    {
+        /// <summary>
+        /// Assigned by Sentry.
+        /// </summary>
+        public readonly string? Id;
+        /// <summary>
+        /// The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+        /// </summary>
+        public readonly int? IntegrationId;
+        /// <summary>
+        /// The user or team ID, or the channel name for specific targets.
+        /// </summary>
+        public readonly string? TargetIdentifier;
+        /// <summary>
+        /// user, team, specific or sentry_app.
+        /// </summary>
+        public readonly string TargetType;
+        /// <summary>
+        /// email, slack, pagerduty, msteams or sentry_app.
+        /// </summary>
+        public readonly string Type;
+
+        [OutputConstructor]
+        private MetricAlertRuleTriggerAction(
+            string? id,
+
+            int? integrationId,
+
+            string? targetIdentifier,
+
+            string targetType,
+
+            string type)
+        {
+            Id = id;
+            IntegrationId = integrationId;
+            TargetIdentifier = targetIdentifier;
+            TargetType = targetType;
+            Type = type;
+        }
+    }
+}
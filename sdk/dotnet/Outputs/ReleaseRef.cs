@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry.Outputs
+{
+
+    [OutputType]
+    public sealed class ReleaseRef
+    {
+        /// <summary>
+        /// The SHA of the last commit of the release.
+        /// </summary>
+        public readonly string Commit;
+        /// <summary>
+        /// The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+        /// </summary>
+        public readonly string? PreviousCommit;
+        /// <summary>
+        /// The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+        /// </summary>
+        public readonly string Repository;
+
+        [OutputConstructor]
+        private ReleaseRef(
+            string commit,
+
+            string? previousCommit,
+
+            string repository)
+        {
+            Commit = commit;
+            PreviousCommit = previousCommit;
+            Repository = repository;
+        }
+    }
+}
@@ -11,9 +11,39 @@ namespace Pulumi.Sentry
 {
     public partial class Project : Pulumi.CustomResource
     {
+        /// <summary>
+        /// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+        /// </summary>
+        [Output("allowedDomains")]
+        public Output<ImmutableArray<string>> AllowedDomains { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether Sentry removes sensitive data from events server-side.
+        /// </summary>
+        [Output("dataScrubber")]
+        public Output<bool?> DataScrubber { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the data scrubber applies its default list of sensitive fields.
+        /// </summary>
+        [Output("dataScrubberDefaults")]
+        public Output<bool?> DataScrubberDefaults { get; private set; } = null!;
+
         [Output("defaultClientKeyDSNPublic")]
         public Output<string?> DefaultClientKeyDSNPublic { get; private set; } = null!;
 
+        /// <summary>
+        /// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+        /// </summary>
+        [Output("defaultClientKeyRateLimitCount")]
+        public Output<int?> DefaultClientKeyRateLimitCount { get; private set; } = null!;
+
+        /// <summary>
+        /// The window of the default client key's rate limit, in seconds.
+        /// </summary>
+        [Output("defaultClientKeyRateLimitWindow")]
+        public Output<int?> DefaultClientKeyRateLimitWindow { get; private set; } = null!;
+
         [Output("defaultEnvironment")]
         public Output<string?> DefaultEnvironment { get; private set; } = null!;
 
@@ -23,6 +53,42 @@ namespace Pulumi.Sentry
         [Output("organizationSlug")]
         public Output<string?> OrganizationSlug { get; private set; } = null!;
 
+        /// <summary>
+        /// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+        /// </summary>
+        [Output("resolveAge")]
+        public Output<int?> ResolveAge { get; private set; } = null!;
+
+        /// <summary>
+        /// Field names the data scrubber must leave as they are.
+        /// </summary>
+        [Output("safeFields")]
+        public Output<ImmutableArray<string>> SafeFields { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether Sentry fetches JavaScript source files and source maps.
+        /// </summary>
+        [Output("scrapeJavaScript")]
+        public Output<bool?> ScrapeJavaScript { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether IP addresses are removed from events.
+        /// </summary>
+        [Output("scrubIPAddresses")]
+        public Output<bool?> ScrubIPAddresses { get; private set; } = null!;
+
+        /// <summary>
+        /// Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+        /// </summary>
+        [Output("securityToken")]
+        public Output<string?> SecurityToken { get; private set; } = null!;
+
+        /// <summary>
+        /// Additional field names the data scrubber removes.
+        /// </summary>
+        [Output("sensitiveFields")]
+        public Output<ImmutableArray<string>> SensitiveFields { get; private set; } = null!;
+
         [Output("slug")]
         public Output<string> Slug { get; private set; } = null!;
 
@@ -35,6 +101,9 @@ namespace Pulumi.Sentry
         [Output("teamSlug")]
         public Output<string?> TeamSlug { get; private set; } = null!;
 
+        [Output("teamSlugs")]
+        public Output<ImmutableArray<string>> TeamSlugs { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Project resource with the given unique name, arguments, and options.
@@ -58,6 +127,10 @@ namespace Pulumi.Sentry
             var defaultOptions = new CustomResourceOptions
             {
                 Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "securityToken",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -80,14 +153,101 @@ namespace Pulumi.Sentry
 
     public sealed class ProjectArgs : Pulumi.ResourceArgs
     {
+        [Input("allowedDomains")]
+        private InputList<string>? _allowedDomains;
+
+        /// <summary>
+        /// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+        /// </summary>
+        public InputList<string> AllowedDomains
+        {
+            get => _allowedDomains ?? (_allowedDomains = new InputList<string>());
+            set => _allowedDomains = value;
+        }
+
+        /// <summary>
+        /// Whether Sentry removes sensitive data from events server-side.
+        /// </summary>
+        [Input("dataScrubber")]
+        public Input<bool>? DataScrubber { get; set; }
+
+        /// <summary>
+        /// Whether the data scrubber applies its default list of sensitive fields.
+        /// </summary>
+        [Input("dataScrubberDefaults")]
+        public Input<bool>? DataScrubberDefaults { get; set; }
+
+        /// <summary>
+        /// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+        /// </summary>
+        [Input("defaultClientKeyRateLimitCount")]
+        public Input<int>? DefaultClientKeyRateLimitCount { get; set; }
+
+        /// <summary>
+        /// The window of the default client key's rate limit, in seconds.
+        /// </summary>
+        [Input("defaultClientKeyRateLimitWindow")]
+        public Input<int>? DefaultClientKeyRateLimitWindow { get; set; }
+
         [Input("defaultEnvironment")]
         public Input<string>? DefaultEnvironment { get; set; }
 
         [Input("name", required: true)]
         public Input<string> Name { get; set; } = null!;
 
-        [Input("organizationSlug", required: true)]
-        public Input<string> OrganizationSlug { get; set; } = null!;
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        /// <summary>
+        /// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+        /// </summary>
+        [Input("resolveAge")]
+        public Input<int>? ResolveAge { get; set; }
+
+        [Input("safeFields")]
+        private InputList<string>? _safeFields;
+
+        /// <summary>
+        /// Field names the data scrubber must leave as they are.
+        /// </summary>
+        public InputList<string> SafeFields
+        {
+            get => _safeFields ?? (_safeFields = new InputList<string>());
+            set => _safeFields = value;
+        }
+
+        /// <summary>
+        /// Whether Sentry fetches JavaScript source files and source maps.
+        /// </summary>
+        [Input("scrapeJavaScript")]
+        public Input<bool>? ScrapeJavaScript { get; set; }
+
+        /// <summary>
+        /// Whether IP addresses are removed from events.
+        /// </summary>
+        [Input("scrubIPAddresses")]
+        public Input<bool>? ScrubIPAddresses { get; set; }
+
+        /// <summary>
+        /// Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+        /// </summary>
+        [Input("securityToken")]
+        public Input<string>? SecurityToken { get; set; }
+
+        [Input("sensitiveFields")]
+        private InputList<string>? _sensitiveFields;
+
+        /// <summary>
+        /// Additional field names the data scrubber removes.
+        /// </summary>
+        public InputList<string> SensitiveFields
+        {
+            get => _sensitiveFields ?? (_sensitiveFields = new InputList<string>());
+            set => _sensitiveFields = value;
+        }
 
         [Input("slug", required: true)]
         public Input<string> Slug { get; set; } = null!;
@@ -98,8 +258,16 @@ namespace Pulumi.Sentry
         [Input("subjectTemplate")]
         public Input<string>? SubjectTemplate { get; set; }
 
-        [Input("teamSlug", required: true)]
-        public Input<string> TeamSlug { get; set; } = null!;
+        [Input("teamSlug")]
+        public Input<string>? TeamSlug { get; set; }
+
+        [Input("teamSlugs")]
+        private InputList<string>? _teamSlugs;
+        public InputList<string> TeamSlugs
+        {
+            get => _teamSlugs ?? (_teamSlugs = new InputList<string>());
+            set => _teamSlugs = value;
+        }
 
         public ProjectArgs()
         {
@@ -0,0 +1,108 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// The visibility of an environment of a project. Deleting the resource shows the environment again, as Sentry can't delete environments.
+    /// </summary>
+    public partial class ProjectEnvironment : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Hide the environment from the environment selectors of Sentry. Defaults to false.
+        /// </summary>
+        [Output("hidden")]
+        public Output<bool> Hidden { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the environment, which Sentry adds to the project with the first event sent from it.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlug")]
+        public Output<string> ProjectSlug { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ProjectEnvironment resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ProjectEnvironment(string name, ProjectEnvironmentArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:ProjectEnvironment", name, args ?? new ProjectEnvironmentArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ProjectEnvironment(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:ProjectEnvironment", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ProjectEnvironment resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ProjectEnvironment Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ProjectEnvironment(name, id, options);
+        }
+    }
+
+    public sealed class ProjectEnvironmentArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Hide the environment from the environment selectors of Sentry. Defaults to false.
+        /// </summary>
+        [Input("hidden")]
+        public Input<bool>? Hidden { get; set; }
+
+        /// <summary>
+        /// The name of the environment, which Sentry adds to the project with the first event sent from it.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlug", required: true)]
+        public Input<string> ProjectSlug { get; set; } = null!;
+
+        public ProjectEnvironmentArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,189 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// The inbound data filters of a project. Filters left out are turned off, and deleting the resource turns them all off.
+    /// </summary>
+    public partial class ProjectInboundFilters : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Filter out errors caused by known browser extensions.
+        /// </summary>
+        [Output("browserExtensions")]
+        public Output<bool> BrowserExtensions { get; private set; } = null!;
+
+        /// <summary>
+        /// Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+        /// </summary>
+        [Output("errorMessages")]
+        public Output<ImmutableArray<string>> ErrorMessages { get; private set; } = null!;
+
+        /// <summary>
+        /// Filter out events from these IP addresses or CIDR ranges.
+        /// </summary>
+        [Output("ipAddresses")]
+        public Output<ImmutableArray<string>> IpAddresses { get; private set; } = null!;
+
+        /// <summary>
+        /// Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+        /// </summary>
+        [Output("legacyBrowsers")]
+        public Output<ImmutableArray<string>> LegacyBrowsers { get; private set; } = null!;
+
+        /// <summary>
+        /// Filter out events coming from localhost.
+        /// </summary>
+        [Output("localhost")]
+        public Output<bool> Localhost { get; private set; } = null!;
+
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlug")]
+        public Output<string> ProjectSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// Filter out events from releases matching one of these patterns, e.g. *-dev.
+        /// </summary>
+        [Output("releases")]
+        public Output<ImmutableArray<string>> Releases { get; private set; } = null!;
+
+        /// <summary>
+        /// Filter out events from known web crawlers.
+        /// </summary>
+        [Output("webCrawlers")]
+        public Output<bool> WebCrawlers { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ProjectInboundFilters resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ProjectInboundFilters(string name, ProjectInboundFiltersArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:ProjectInboundFilters", name, args ?? new ProjectInboundFiltersArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ProjectInboundFilters(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:ProjectInboundFilters", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ProjectInboundFilters resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ProjectInboundFilters Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ProjectInboundFilters(name, id, options);
+        }
+    }
+
+    public sealed class ProjectInboundFiltersArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Filter out errors caused by known browser extensions.
+        /// </summary>
+        [Input("browserExtensions")]
+        public Input<bool>? BrowserExtensions { get; set; }
+
+        [Input("errorMessages")]
+        private InputList<string>? _errorMessages;
+
+        /// <summary>
+        /// Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+        /// </summary>
+        public InputList<string> ErrorMessages
+        {
+            get => _errorMessages ?? (_errorMessages = new InputList<string>());
+            set => _errorMessages = value;
+        }
+
+        [Input("ipAddresses")]
+        private InputList<string>? _ipAddresses;
+
+        /// <summary>
+        /// Filter out events from these IP addresses or CIDR ranges.
+        /// </summary>
+        public InputList<string> IpAddresses
+        {
+            get => _ipAddresses ?? (_ipAddresses = new InputList<string>());
+            set => _ipAddresses = value;
+        }
+
+        [Input("legacyBrowsers")]
+        private InputList<string>? _legacyBrowsers;
+
+        /// <summary>
+        /// Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+        /// </summary>
+        public InputList<string> LegacyBrowsers
+        {
+            get => _legacyBrowsers ?? (_legacyBrowsers = new InputList<string>());
+            set => _legacyBrowsers = value;
+        }
+
+        /// <summary>
+        /// Filter out events coming from localhost.
+        /// </summary>
+        [Input("localhost")]
+        public Input<bool>? Localhost { get; set; }
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlug", required: true)]
+        public Input<string> ProjectSlug { get; set; } = null!;
+
+        [Input("releases")]
+        private InputList<string>? _releases;
+
+        /// <summary>
+        /// Filter out events from releases matching one of these patterns, e.g. *-dev.
+        /// </summary>
+        public InputList<string> Releases
+        {
+            get => _releases ?? (_releases = new InputList<string>());
+            set => _releases = value;
+        }
+
+        /// <summary>
+        /// Filter out events from known web crawlers.
+        /// </summary>
+        [Input("webCrawlers")]
+        public Input<bool>? WebCrawlers { get; set; }
+
+        public ProjectInboundFiltersArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,120 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// The ownership rules of a project, used to route alerts and assign issues. Deleting the resource removes all the rules.
+    /// </summary>
+    public partial class ProjectOwnership : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Assign new issues to their owners automatically. Defaults to false.
+        /// </summary>
+        [Output("autoAssignment")]
+        public Output<bool> AutoAssignment { get; private set; } = null!;
+
+        /// <summary>
+        /// Send alerts to all project members when no rule matches an issue. Defaults to true.
+        /// </summary>
+        [Output("fallthrough")]
+        public Output<bool> Fallthrough { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlug")]
+        public Output<string> ProjectSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.&lt;name&gt;, and default to path.
+        /// </summary>
+        [Output("raw")]
+        public Output<string> Raw { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ProjectOwnership resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ProjectOwnership(string name, ProjectOwnershipArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:ProjectOwnership", name, args ?? new ProjectOwnershipArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ProjectOwnership(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:ProjectOwnership", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ProjectOwnership resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ProjectOwnership Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ProjectOwnership(name, id, options);
+        }
+    }
+
+    public sealed class ProjectOwnershipArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Assign new issues to their owners automatically. Defaults to false.
+        /// </summary>
+        [Input("autoAssignment")]
+        public Input<bool>? AutoAssignment { get; set; }
+
+        /// <summary>
+        /// Send alerts to all project members when no rule matches an issue. Defaults to true.
+        /// </summary>
+        [Input("fallthrough")]
+        public Input<bool>? Fallthrough { get; set; }
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlug", required: true)]
+        public Input<string> ProjectSlug { get; set; } = null!;
+
+        /// <summary>
+        /// The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.&lt;name&gt;, and default to path.
+        /// </summary>
+        [Input("raw", required: true)]
+        public Input<string> Raw { get; set; } = null!;
+
+        public ProjectOwnershipArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,161 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// A version of the code deployed in projects of an organization. Sentry refuses to delete releases which have events.
+    /// </summary>
+    public partial class Release : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// When the release was created, in RFC 3339 format.
+        /// </summary>
+        [Output("dateCreated")]
+        public Output<string> DateCreated { get; private set; } = null!;
+
+        /// <summary>
+        /// When the release went live, in RFC 3339 format. Defaults to unreleased.
+        /// </summary>
+        [Output("dateReleased")]
+        public Output<string?> DateReleased { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlugs")]
+        public Output<ImmutableArray<string>> ProjectSlugs { get; private set; } = null!;
+
+        /// <summary>
+        /// A commit or tag of the release, for display.
+        /// </summary>
+        [Output("ref")]
+        public Output<string?> Ref { get; private set; } = null!;
+
+        /// <summary>
+        /// The commits of the release, to associate them with it.
+        /// </summary>
+        [Output("refs")]
+        public Output<ImmutableArray<Outputs.ReleaseRef>> Refs { get; private set; } = null!;
+
+        /// <summary>
+        /// A link to the release, e.g. to its build.
+        /// </summary>
+        [Output("url")]
+        public Output<string?> Url { get; private set; } = null!;
+
+        /// <summary>
+        /// The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+        /// </summary>
+        [Output("version")]
+        public Output<string> Version { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Release resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Release(string name, ReleaseArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:Release", name, args ?? new ReleaseArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Release(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:Release", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Release resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Release Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Release(name, id, options);
+        }
+    }
+
+    public sealed class ReleaseArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// When the release went live, in RFC 3339 format. Defaults to unreleased.
+        /// </summary>
+        [Input("dateReleased")]
+        public Input<string>? DateReleased { get; set; }
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlugs", required: true)]
+        private InputList<string>? _projectSlugs;
+        public InputList<string> ProjectSlugs
+        {
+            get => _projectSlugs ?? (_projectSlugs = new InputList<string>());
+            set => _projectSlugs = value;
+        }
+
+        /// <summary>
+        /// A commit or tag of the release, for display.
+        /// </summary>
+        [Input("ref")]
+        public Input<string>? Ref { get; set; }
+
+        [Input("refs")]
+        private InputList<Inputs.ReleaseRefArgs>? _refs;
+
+        /// <summary>
+        /// The commits of the release, to associate them with it.
+        /// </summary>
+        public InputList<Inputs.ReleaseRefArgs> Refs
+        {
+            get => _refs ?? (_refs = new InputList<Inputs.ReleaseRefArgs>());
+            set => _refs = value;
+        }
+
+        /// <summary>
+        /// A link to the release, e.g. to its build.
+        /// </summary>
+        [Input("url")]
+        public Input<string>? Url { get; set; }
+
+        /// <summary>
+        /// The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+        /// </summary>
+        [Input("version", required: true)]
+        public Input<string> Version { get; set; } = null!;
+
+        public ReleaseArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// A search of issues shared in an organization.
+    /// </summary>
+    public partial class SavedSearch : Pulumi.CustomResource
+    {
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+        /// </summary>
+        [Output("pinned")]
+        public Output<bool> Pinned { get; private set; } = null!;
+
+        /// <summary>
+        /// The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+        /// </summary>
+        [Output("query")]
+        public Output<string> Query { get; private set; } = null!;
+
+        /// <summary>
+        /// The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+        /// </summary>
+        [Output("sort")]
+        public Output<string> Sort { get; private set; } = null!;
+
+        /// <summary>
+        /// Who the search is listed for: organization or owner. Defaults to organization.
+        /// </summary>
+        [Output("visibility")]
+        public Output<string> Visibility { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SavedSearch resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SavedSearch(string name, SavedSearchArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:SavedSearch", name, args ?? new SavedSearchArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private SavedSearch(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:SavedSearch", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing SavedSearch resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static SavedSearch Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new SavedSearch(name, id, options);
+        }
+    }
+
+    public sealed class SavedSearchArgs : Pulumi.ResourceArgs
+    {
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        /// <summary>
+        /// Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+        /// </summary>
+        [Input("pinned")]
+        public Input<bool>? Pinned { get; set; }
+
+        /// <summary>
+        /// The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+        /// </summary>
+        [Input("query", required: true)]
+        public Input<string> Query { get; set; } = null!;
+
+        /// <summary>
+        /// The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+        /// </summary>
+        [Input("sort")]
+        public Input<string>? Sort { get; set; }
+
+        /// <summary>
+        /// Who the search is listed for: organization or owner. Defaults to organization.
+        /// </summary>
+        [Input("visibility")]
+        public Input<string>? Visibility { get; set; }
+
+        public SavedSearchArgs()
+        {
+        }
+    }
+}
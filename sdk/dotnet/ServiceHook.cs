@@ -0,0 +1,118 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// A webhook of a project, posting events to a URL.
+    /// </summary>
+    public partial class ServiceHook : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The events posted to the URL: event.alert and/or event.created.
+        /// </summary>
+        [Output("events")]
+        public Output<ImmutableArray<string>> Events { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("projectSlug")]
+        public Output<string> ProjectSlug { get; private set; } = null!;
+
+        /// <summary>
+        /// The secret Sentry signs the requests to the URL with, in the X-ServiceHook-Signature header.
+        /// </summary>
+        [Output("secret")]
+        public Output<string> Secret { get; private set; } = null!;
+
+        [Output("url")]
+        public Output<string> Url { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ServiceHook resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ServiceHook(string name, ServiceHookArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:ServiceHook", name, args ?? new ServiceHookArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ServiceHook(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:ServiceHook", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "secret",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ServiceHook resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ServiceHook Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ServiceHook(name, id, options);
+        }
+    }
+
+    public sealed class ServiceHookArgs : Pulumi.ResourceArgs
+    {
+        [Input("events", required: true)]
+        private InputList<string>? _events;
+
+        /// <summary>
+        /// The events posted to the URL: event.alert and/or event.created.
+        /// </summary>
+        public InputList<string> Events
+        {
+            get => _events ?? (_events = new InputList<string>());
+            set => _events = value;
+        }
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("projectSlug", required: true)]
+        public Input<string> ProjectSlug { get; set; } = null!;
+
+        [Input("url", required: true)]
+        public Input<string> Url { get; set; } = null!;
+
+        public ServiceHookArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,84 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    public partial class Team : Pulumi.CustomResource
+    {
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("slug")]
+        public Output<string> Slug { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Team resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Team(string name, TeamArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:Team", name, args ?? new TeamArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Team(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:Team", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Team resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Team Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Team(name, id, options);
+        }
+    }
+
+    public sealed class TeamArgs : Pulumi.ResourceArgs
+    {
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("slug", required: true)]
+        public Input<string> Slug { get; set; } = null!;
+
+        public TeamArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,96 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Sentry
+{
+    /// <summary>
+    /// The membership of an organization member in a team.
+    /// </summary>
+    public partial class TeamMember : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The ID of an organization member, e.g. the memberId of an OrganizationMember.
+        /// </summary>
+        [Output("memberId")]
+        public Output<string> MemberId { get; private set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Output("organizationSlug")]
+        public Output<string> OrganizationSlug { get; private set; } = null!;
+
+        [Output("teamSlug")]
+        public Output<string> TeamSlug { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a TeamMember resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public TeamMember(string name, TeamMemberArgs args, CustomResourceOptions? options = null)
+            : base("sentry:index:TeamMember", name, args ?? new TeamMemberArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private TeamMember(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("sentry:index:TeamMember", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing TeamMember resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static TeamMember Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new TeamMember(name, id, options);
+        }
+    }
+
+    public sealed class TeamMemberArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The ID of an organization member, e.g. the memberId of an OrganizationMember.
+        /// </summary>
+        [Input("memberId", required: true)]
+        public Input<string> MemberId { get; set; } = null!;
+
+        /// <summary>
+        /// Defaults to the sentry:organization provider config.
+        /// </summary>
+        [Input("organizationSlug")]
+        public Input<string>? OrganizationSlug { get; set; }
+
+        [Input("teamSlug", required: true)]
+        public Input<string> TeamSlug { get; set; } = null!;
+
+        public TeamMemberArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,158 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+type ClientKey struct {
+	pulumi.CustomResourceState
+
+	DsnCSP           pulumi.StringOutput `pulumi:"dsnCSP"`
+	DsnPublic        pulumi.StringOutput `pulumi:"dsnPublic"`
+	DsnSecret        pulumi.StringOutput `pulumi:"dsnSecret"`
+	DsnSecurity      pulumi.StringOutput `pulumi:"dsnSecurity"`
+	IsActive         pulumi.BoolOutput   `pulumi:"isActive"`
+	Name             pulumi.StringOutput `pulumi:"name"`
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	ProjectSlug      pulumi.StringOutput `pulumi:"projectSlug"`
+	RateLimitCount   pulumi.IntPtrOutput `pulumi:"rateLimitCount"`
+	RateLimitWindow  pulumi.IntPtrOutput `pulumi:"rateLimitWindow"`
+}
+
+// NewClientKey registers a new resource with the given unique name, arguments, and options.
+func NewClientKey(ctx *pulumi.Context,
+	name string, args *ClientKeyArgs, opts ...pulumi.ResourceOption) (*ClientKey, error) {
+	if args == nil || args.Name == nil {
+		return nil, errors.New("missing required argument 'Name'")
+	}
+	if args == nil || args.ProjectSlug == nil {
+		return nil, errors.New("missing required argument 'ProjectSlug'")
+	}
+	if args == nil {
+		args = &ClientKeyArgs{}
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"dsnSecret",
+	})
+	opts = append(opts, secrets)
+	var resource ClientKey
+	err := ctx.RegisterResource("sentry:index:ClientKey", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetClientKey gets an existing ClientKey resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetClientKey(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ClientKeyState, opts ...pulumi.ResourceOption) (*ClientKey, error) {
+	var resource ClientKey
+	err := ctx.ReadResource("sentry:index:ClientKey", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ClientKey resources.
+type clientKeyState struct {
+	DsnCSP           *string `pulumi:"dsnCSP"`
+	DsnPublic        *string `pulumi:"dsnPublic"`
+	DsnSecret        *string `pulumi:"dsnSecret"`
+	DsnSecurity      *string `pulumi:"dsnSecurity"`
+	IsActive         *bool   `pulumi:"isActive"`
+	Name             *string `pulumi:"name"`
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      *string `pulumi:"projectSlug"`
+	RateLimitCount   *int    `pulumi:"rateLimitCount"`
+	RateLimitWindow  *int    `pulumi:"rateLimitWindow"`
+}
+
+type ClientKeyState struct {
+	DsnCSP           pulumi.StringPtrInput
+	DsnPublic        pulumi.StringPtrInput
+	DsnSecret        pulumi.StringPtrInput
+	DsnSecurity      pulumi.StringPtrInput
+	IsActive         pulumi.BoolPtrInput
+	Name             pulumi.StringPtrInput
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringPtrInput
+	RateLimitCount   pulumi.IntPtrInput
+	RateLimitWindow  pulumi.IntPtrInput
+}
+
+func (ClientKeyState) ElementType() reflect.Type {
+	return reflect.TypeOf((*clientKeyState)(nil)).Elem()
+}
+
+type clientKeyArgs struct {
+	IsActive *bool  `pulumi:"isActive"`
+	Name     string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      string  `pulumi:"projectSlug"`
+	RateLimitCount   *int    `pulumi:"rateLimitCount"`
+	RateLimitWindow  *int    `pulumi:"rateLimitWindow"`
+}
+
+// The set of arguments for constructing a ClientKey resource.
+type ClientKeyArgs struct {
+	IsActive pulumi.BoolPtrInput
+	Name     pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringInput
+	RateLimitCount   pulumi.IntPtrInput
+	RateLimitWindow  pulumi.IntPtrInput
+}
+
+func (ClientKeyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*clientKeyArgs)(nil)).Elem()
+}
+
+type ClientKeyInput interface {
+	pulumi.Input
+
+	ToClientKeyOutput() ClientKeyOutput
+	ToClientKeyOutputWithContext(ctx context.Context) ClientKeyOutput
+}
+
+func (ClientKey) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClientKey)(nil)).Elem()
+}
+
+func (i ClientKey) ToClientKeyOutput() ClientKeyOutput {
+	return i.ToClientKeyOutputWithContext(context.Background())
+}
+
+func (i ClientKey) ToClientKeyOutputWithContext(ctx context.Context) ClientKeyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClientKeyOutput)
+}
+
+type ClientKeyOutput struct {
+	*pulumi.OutputState
+}
+
+func (ClientKeyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClientKeyOutput)(nil)).Elem()
+}
+
+func (o ClientKeyOutput) ToClientKeyOutput() ClientKeyOutput {
+	return o
+}
+
+func (o ClientKeyOutput) ToClientKeyOutputWithContext(ctx context.Context) ClientKeyOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ClientKeyOutput{})
+}
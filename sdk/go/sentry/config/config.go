@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package config
+
+import (
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/config"
+)
+
+// The URL of the Sentry API, e.g. https://sentry.io/api/0/. Falls back to the server URL in SENTRY_URL.
+func GetApiURL(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "sentry:apiURL")
+	if err == nil {
+		return v
+	}
+	return getEnvOrDefault("", nil, "SENTRY_URL").(string)
+}
+
+// The default organization slug for resources that don't set one.
+func GetOrganization(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "sentry:organization")
+	if err == nil {
+		return v
+	}
+	return getEnvOrDefault("", nil, "SENTRY_ORG").(string)
+}
+
+// Skip checking the token against the Sentry API when the provider starts.
+func GetSkipCredentialsValidation(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "sentry:skipCredentialsValidation")
+}
+
+// The Sentry auth token.
+func GetToken(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "sentry:token")
+	if err == nil {
+		return v
+	}
+	return getEnvOrDefault("", nil, "SENTRY_AUTH_TOKEN").(string)
+}
@@ -0,0 +1,133 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A dashboard of an organization: a grid of widgets charting the results of queries of events.
+type Dashboard struct {
+	pulumi.CustomResourceState
+
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	Title            pulumi.StringOutput `pulumi:"title"`
+	// The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+	Widgets DashboardWidgetArrayOutput `pulumi:"widgets"`
+}
+
+// NewDashboard registers a new resource with the given unique name, arguments, and options.
+func NewDashboard(ctx *pulumi.Context,
+	name string, args *DashboardArgs, opts ...pulumi.ResourceOption) (*Dashboard, error) {
+	if args == nil || args.Title == nil {
+		return nil, errors.New("missing required argument 'Title'")
+	}
+	if args == nil {
+		args = &DashboardArgs{}
+	}
+	var resource Dashboard
+	err := ctx.RegisterResource("sentry:index:Dashboard", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDashboard gets an existing Dashboard resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDashboard(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DashboardState, opts ...pulumi.ResourceOption) (*Dashboard, error) {
+	var resource Dashboard
+	err := ctx.ReadResource("sentry:index:Dashboard", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Dashboard resources.
+type dashboardState struct {
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	Title            *string `pulumi:"title"`
+	// The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+	Widgets []DashboardWidget `pulumi:"widgets"`
+}
+
+type DashboardState struct {
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	Title            pulumi.StringPtrInput
+	// The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+	Widgets DashboardWidgetArrayInput
+}
+
+func (DashboardState) ElementType() reflect.Type {
+	return reflect.TypeOf((*dashboardState)(nil)).Elem()
+}
+
+type dashboardArgs struct {
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	Title            string  `pulumi:"title"`
+	// The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+	Widgets []DashboardWidget `pulumi:"widgets"`
+}
+
+// The set of arguments for constructing a Dashboard resource.
+type DashboardArgs struct {
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	Title            pulumi.StringInput
+	// The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+	Widgets DashboardWidgetArrayInput
+}
+
+func (DashboardArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*dashboardArgs)(nil)).Elem()
+}
+
+type DashboardInput interface {
+	pulumi.Input
+
+	ToDashboardOutput() DashboardOutput
+	ToDashboardOutputWithContext(ctx context.Context) DashboardOutput
+}
+
+func (Dashboard) ElementType() reflect.Type {
+	return reflect.TypeOf((*Dashboard)(nil)).Elem()
+}
+
+func (i Dashboard) ToDashboardOutput() DashboardOutput {
+	return i.ToDashboardOutputWithContext(context.Background())
+}
+
+func (i Dashboard) ToDashboardOutputWithContext(ctx context.Context) DashboardOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DashboardOutput)
+}
+
+type DashboardOutput struct {
+	*pulumi.OutputState
+}
+
+func (DashboardOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DashboardOutput)(nil)).Elem()
+}
+
+func (o DashboardOutput) ToDashboardOutput() DashboardOutput {
+	return o
+}
+
+func (o DashboardOutput) ToDashboardOutputWithContext(ctx context.Context) DashboardOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(DashboardOutput{})
+}
@@ -0,0 +1,171 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A deploy of a release to an environment. Sentry keeps deploys as a log: any change records a new deploy, and deleting the resource leaves the deploy in Sentry.
+type Deploy struct {
+	pulumi.CustomResourceState
+
+	// When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+	DateFinished pulumi.StringOutput `pulumi:"dateFinished"`
+	// When the deploy started, in RFC 3339 format.
+	DateStarted pulumi.StringPtrOutput `pulumi:"dateStarted"`
+	Environment pulumi.StringOutput    `pulumi:"environment"`
+	Name        pulumi.StringPtrOutput `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	// A link to the deploy, e.g. to its pipeline.
+	Url pulumi.StringPtrOutput `pulumi:"url"`
+	// The version of the release deployed.
+	Version pulumi.StringOutput `pulumi:"version"`
+}
+
+// NewDeploy registers a new resource with the given unique name, arguments, and options.
+func NewDeploy(ctx *pulumi.Context,
+	name string, args *DeployArgs, opts ...pulumi.ResourceOption) (*Deploy, error) {
+	if args == nil || args.Environment == nil {
+		return nil, errors.New("missing required argument 'Environment'")
+	}
+	if args == nil || args.Version == nil {
+		return nil, errors.New("missing required argument 'Version'")
+	}
+	if args == nil {
+		args = &DeployArgs{}
+	}
+	var resource Deploy
+	err := ctx.RegisterResource("sentry:index:Deploy", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDeploy gets an existing Deploy resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDeploy(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DeployState, opts ...pulumi.ResourceOption) (*Deploy, error) {
+	var resource Deploy
+	err := ctx.ReadResource("sentry:index:Deploy", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Deploy resources.
+type deployState struct {
+	// When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+	DateFinished *string `pulumi:"dateFinished"`
+	// When the deploy started, in RFC 3339 format.
+	DateStarted *string `pulumi:"dateStarted"`
+	Environment *string `pulumi:"environment"`
+	Name        *string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// A link to the deploy, e.g. to its pipeline.
+	Url *string `pulumi:"url"`
+	// The version of the release deployed.
+	Version *string `pulumi:"version"`
+}
+
+type DeployState struct {
+	// When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+	DateFinished pulumi.StringPtrInput
+	// When the deploy started, in RFC 3339 format.
+	DateStarted pulumi.StringPtrInput
+	Environment pulumi.StringPtrInput
+	Name        pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// A link to the deploy, e.g. to its pipeline.
+	Url pulumi.StringPtrInput
+	// The version of the release deployed.
+	Version pulumi.StringPtrInput
+}
+
+func (DeployState) ElementType() reflect.Type {
+	return reflect.TypeOf((*deployState)(nil)).Elem()
+}
+
+type deployArgs struct {
+	// When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+	DateFinished *string `pulumi:"dateFinished"`
+	// When the deploy started, in RFC 3339 format.
+	DateStarted *string `pulumi:"dateStarted"`
+	Environment string  `pulumi:"environment"`
+	Name        *string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// A link to the deploy, e.g. to its pipeline.
+	Url *string `pulumi:"url"`
+	// The version of the release deployed.
+	Version string `pulumi:"version"`
+}
+
+// The set of arguments for constructing a Deploy resource.
+type DeployArgs struct {
+	// When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+	DateFinished pulumi.StringPtrInput
+	// When the deploy started, in RFC 3339 format.
+	DateStarted pulumi.StringPtrInput
+	Environment pulumi.StringInput
+	Name        pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// A link to the deploy, e.g. to its pipeline.
+	Url pulumi.StringPtrInput
+	// The version of the release deployed.
+	Version pulumi.StringInput
+}
+
+func (DeployArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*deployArgs)(nil)).Elem()
+}
+
+type DeployInput interface {
+	pulumi.Input
+
+	ToDeployOutput() DeployOutput
+	ToDeployOutputWithContext(ctx context.Context) DeployOutput
+}
+
+func (Deploy) ElementType() reflect.Type {
+	return reflect.TypeOf((*Deploy)(nil)).Elem()
+}
+
+func (i Deploy) ToDeployOutput() DeployOutput {
+	return i.ToDeployOutputWithContext(context.Background())
+}
+
+func (i Deploy) ToDeployOutputWithContext(ctx context.Context) DeployOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DeployOutput)
+}
+
+type DeployOutput struct {
+	*pulumi.OutputState
+}
+
+func (DeployOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DeployOutput)(nil)).Elem()
+}
+
+func (o DeployOutput) ToDeployOutput() DeployOutput {
+	return o
+}
+
+func (o DeployOutput) ToDeployOutputWithContext(ctx context.Context) DeployOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(DeployOutput{})
+}
@@ -0,0 +1,27 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+func GetOrganization(ctx *pulumi.Context, args *GetOrganizationArgs, opts ...pulumi.InvokeOption) (*GetOrganizationResult, error) {
+	var rv GetOrganizationResult
+	err := ctx.Invoke("sentry:index:getOrganization", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetOrganizationArgs struct {
+	Slug string `pulumi:"slug"`
+}
+
+type GetOrganizationResult struct {
+	Id   string `pulumi:"id"`
+	Name string `pulumi:"name"`
+	Slug string `pulumi:"slug"`
+}
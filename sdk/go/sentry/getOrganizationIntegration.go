@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Finds an integration installed in an organization, e.g. a Slack workspace, by its provider and name.
+func GetOrganizationIntegration(ctx *pulumi.Context, args *GetOrganizationIntegrationArgs, opts ...pulumi.InvokeOption) (*GetOrganizationIntegrationResult, error) {
+	var rv GetOrganizationIntegrationResult
+	err := ctx.Invoke("sentry:index:getOrganizationIntegration", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetOrganizationIntegrationArgs struct {
+	// The name of the integration as shown in Sentry, e.g. the Slack workspace or GitHub owner.
+	Name string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// The service of the integration, e.g. slack, github or jira.
+	ProviderKey string `pulumi:"providerKey"`
+}
+
+type GetOrganizationIntegrationResult struct {
+	DomainName string `pulumi:"domainName"`
+	// The ID of the integration in the service, e.g. the Slack workspace ID.
+	ExternalId string `pulumi:"externalId"`
+	// The integration ID used by alert rule actions and repositories.
+	Id               int    `pulumi:"id"`
+	Name             string `pulumi:"name"`
+	OrganizationSlug string `pulumi:"organizationSlug"`
+	ProviderKey      string `pulumi:"providerKey"`
+	ProviderName     string `pulumi:"providerName"`
+	Status           string `pulumi:"status"`
+}
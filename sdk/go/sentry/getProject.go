@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+func LookupProject(ctx *pulumi.Context, args *LookupProjectArgs, opts ...pulumi.InvokeOption) (*LookupProjectResult, error) {
+	var rv LookupProjectResult
+	err := ctx.Invoke("sentry:index:getProject", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupProjectArgs struct {
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	Slug             string  `pulumi:"slug"`
+}
+
+type LookupProjectResult struct {
+	// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+	AllowedDomains []string `pulumi:"allowedDomains"`
+	// Whether Sentry removes sensitive data from events server-side.
+	DataScrubber *bool `pulumi:"dataScrubber"`
+	// Whether the data scrubber applies its default list of sensitive fields.
+	DataScrubberDefaults      *bool  `pulumi:"dataScrubberDefaults"`
+	DefaultClientKeyDSNPublic string `pulumi:"defaultClientKeyDSNPublic"`
+	// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+	DefaultClientKeyRateLimitCount *int `pulumi:"defaultClientKeyRateLimitCount"`
+	// The window of the default client key's rate limit, in seconds.
+	DefaultClientKeyRateLimitWindow *int    `pulumi:"defaultClientKeyRateLimitWindow"`
+	DefaultEnvironment              *string `pulumi:"defaultEnvironment"`
+	Id                              string  `pulumi:"id"`
+	Name                            string  `pulumi:"name"`
+	OrganizationSlug                string  `pulumi:"organizationSlug"`
+	// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+	ResolveAge *int `pulumi:"resolveAge"`
+	// Field names the data scrubber must leave as they are.
+	SafeFields []string `pulumi:"safeFields"`
+	// Whether Sentry fetches JavaScript source files and source maps.
+	ScrapeJavaScript *bool `pulumi:"scrapeJavaScript"`
+	// Whether IP addresses are removed from events.
+	ScrubIPAddresses *bool `pulumi:"scrubIPAddresses"`
+	// Additional field names the data scrubber removes.
+	SensitiveFields []string `pulumi:"sensitiveFields"`
+	Slug            string   `pulumi:"slug"`
+	SubjectPrefix   *string  `pulumi:"subjectPrefix"`
+	SubjectTemplate *string  `pulumi:"subjectTemplate"`
+	TeamSlug        *string  `pulumi:"teamSlug"`
+	TeamSlugs       []string `pulumi:"teamSlugs"`
+}
@@ -0,0 +1,30 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+func LookupTeam(ctx *pulumi.Context, args *LookupTeamArgs, opts ...pulumi.InvokeOption) (*LookupTeamResult, error) {
+	var rv LookupTeamResult
+	err := ctx.Invoke("sentry:index:getTeam", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupTeamArgs struct {
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	Slug             string  `pulumi:"slug"`
+}
+
+type LookupTeamResult struct {
+	Id               string `pulumi:"id"`
+	Name             string `pulumi:"name"`
+	OrganizationSlug string `pulumi:"organizationSlug"`
+	Slug             string `pulumi:"slug"`
+}
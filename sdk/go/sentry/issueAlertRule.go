@@ -0,0 +1,200 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+type IssueAlertRule struct {
+	pulumi.CustomResourceState
+
+	// When to run the actions: all, any or none of the conditions match. Defaults to all.
+	ActionMatch pulumi.StringOutput `pulumi:"actionMatch"`
+	// Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+	Actions pulumi.MapArrayOutput `pulumi:"actions"`
+	// Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+	Conditions pulumi.MapArrayOutput `pulumi:"conditions"`
+	// Only trigger the rule for events from this environment.
+	Environment pulumi.StringPtrOutput `pulumi:"environment"`
+	// How filters are combined: all, any or none. Defaults to all.
+	FilterMatch pulumi.StringOutput `pulumi:"filterMatch"`
+	// Filters events must pass for the rule to fire.
+	Filters pulumi.MapArrayOutput `pulumi:"filters"`
+	// Minimum number of minutes between actions for the same issue. Defaults to 30.
+	Frequency        pulumi.IntOutput    `pulumi:"frequency"`
+	Name             pulumi.StringOutput `pulumi:"name"`
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	ProjectSlug      pulumi.StringOutput `pulumi:"projectSlug"`
+}
+
+// NewIssueAlertRule registers a new resource with the given unique name, arguments, and options.
+func NewIssueAlertRule(ctx *pulumi.Context,
+	name string, args *IssueAlertRuleArgs, opts ...pulumi.ResourceOption) (*IssueAlertRule, error) {
+	if args == nil || args.Actions == nil {
+		return nil, errors.New("missing required argument 'Actions'")
+	}
+	if args == nil || args.Name == nil {
+		return nil, errors.New("missing required argument 'Name'")
+	}
+	if args == nil || args.ProjectSlug == nil {
+		return nil, errors.New("missing required argument 'ProjectSlug'")
+	}
+	if args == nil {
+		args = &IssueAlertRuleArgs{}
+	}
+	var resource IssueAlertRule
+	err := ctx.RegisterResource("sentry:index:IssueAlertRule", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetIssueAlertRule gets an existing IssueAlertRule resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetIssueAlertRule(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *IssueAlertRuleState, opts ...pulumi.ResourceOption) (*IssueAlertRule, error) {
+	var resource IssueAlertRule
+	err := ctx.ReadResource("sentry:index:IssueAlertRule", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering IssueAlertRule resources.
+type issueAlertRuleState struct {
+	// When to run the actions: all, any or none of the conditions match. Defaults to all.
+	ActionMatch *string `pulumi:"actionMatch"`
+	// Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+	Actions []map[string]interface{} `pulumi:"actions"`
+	// Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+	Conditions []map[string]interface{} `pulumi:"conditions"`
+	// Only trigger the rule for events from this environment.
+	Environment *string `pulumi:"environment"`
+	// How filters are combined: all, any or none. Defaults to all.
+	FilterMatch *string `pulumi:"filterMatch"`
+	// Filters events must pass for the rule to fire.
+	Filters []map[string]interface{} `pulumi:"filters"`
+	// Minimum number of minutes between actions for the same issue. Defaults to 30.
+	Frequency        *int    `pulumi:"frequency"`
+	Name             *string `pulumi:"name"`
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      *string `pulumi:"projectSlug"`
+}
+
+type IssueAlertRuleState struct {
+	// When to run the actions: all, any or none of the conditions match. Defaults to all.
+	ActionMatch pulumi.StringPtrInput
+	// Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+	Actions pulumi.MapArrayInput
+	// Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+	Conditions pulumi.MapArrayInput
+	// Only trigger the rule for events from this environment.
+	Environment pulumi.StringPtrInput
+	// How filters are combined: all, any or none. Defaults to all.
+	FilterMatch pulumi.StringPtrInput
+	// Filters events must pass for the rule to fire.
+	Filters pulumi.MapArrayInput
+	// Minimum number of minutes between actions for the same issue. Defaults to 30.
+	Frequency        pulumi.IntPtrInput
+	Name             pulumi.StringPtrInput
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringPtrInput
+}
+
+func (IssueAlertRuleState) ElementType() reflect.Type {
+	return reflect.TypeOf((*issueAlertRuleState)(nil)).Elem()
+}
+
+type issueAlertRuleArgs struct {
+	// When to run the actions: all, any or none of the conditions match. Defaults to all.
+	ActionMatch *string `pulumi:"actionMatch"`
+	// Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+	Actions []map[string]interface{} `pulumi:"actions"`
+	// Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+	Conditions []map[string]interface{} `pulumi:"conditions"`
+	// Only trigger the rule for events from this environment.
+	Environment *string `pulumi:"environment"`
+	// How filters are combined: all, any or none. Defaults to all.
+	FilterMatch *string `pulumi:"filterMatch"`
+	// Filters events must pass for the rule to fire.
+	Filters []map[string]interface{} `pulumi:"filters"`
+	// Minimum number of minutes between actions for the same issue. Defaults to 30.
+	Frequency *int   `pulumi:"frequency"`
+	Name      string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      string  `pulumi:"projectSlug"`
+}
+
+// The set of arguments for constructing a IssueAlertRule resource.
+type IssueAlertRuleArgs struct {
+	// When to run the actions: all, any or none of the conditions match. Defaults to all.
+	ActionMatch pulumi.StringPtrInput
+	// Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+	Actions pulumi.MapArrayInput
+	// Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+	Conditions pulumi.MapArrayInput
+	// Only trigger the rule for events from this environment.
+	Environment pulumi.StringPtrInput
+	// How filters are combined: all, any or none. Defaults to all.
+	FilterMatch pulumi.StringPtrInput
+	// Filters events must pass for the rule to fire.
+	Filters pulumi.MapArrayInput
+	// Minimum number of minutes between actions for the same issue. Defaults to 30.
+	Frequency pulumi.IntPtrInput
+	Name      pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringInput
+}
+
+func (IssueAlertRuleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*issueAlertRuleArgs)(nil)).Elem()
+}
+
+type IssueAlertRuleInput interface {
+	pulumi.Input
+
+	ToIssueAlertRuleOutput() IssueAlertRuleOutput
+	ToIssueAlertRuleOutputWithContext(ctx context.Context) IssueAlertRuleOutput
+}
+
+func (IssueAlertRule) ElementType() reflect.Type {
+	return reflect.TypeOf((*IssueAlertRule)(nil)).Elem()
+}
+
+func (i IssueAlertRule) ToIssueAlertRuleOutput() IssueAlertRuleOutput {
+	return i.ToIssueAlertRuleOutputWithContext(context.Background())
+}
+
+func (i IssueAlertRule) ToIssueAlertRuleOutputWithContext(ctx context.Context) IssueAlertRuleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(IssueAlertRuleOutput)
+}
+
+type IssueAlertRuleOutput struct {
+	*pulumi.OutputState
+}
+
+func (IssueAlertRuleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*IssueAlertRuleOutput)(nil)).Elem()
+}
+
+func (o IssueAlertRuleOutput) ToIssueAlertRuleOutput() IssueAlertRuleOutput {
+	return o
+}
+
+func (o IssueAlertRuleOutput) ToIssueAlertRuleOutputWithContext(ctx context.Context) IssueAlertRuleOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(IssueAlertRuleOutput{})
+}
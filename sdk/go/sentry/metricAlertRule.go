@@ -0,0 +1,211 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+type MetricAlertRule struct {
+	pulumi.CustomResourceState
+
+	// The metric, e.g. count() or p95(transaction.duration).
+	Aggregate pulumi.StringOutput `pulumi:"aggregate"`
+	// events or transactions. Defaults to events.
+	Dataset pulumi.StringOutput `pulumi:"dataset"`
+	// Only count events from this environment.
+	Environment      pulumi.StringPtrOutput `pulumi:"environment"`
+	Name             pulumi.StringOutput    `pulumi:"name"`
+	OrganizationSlug pulumi.StringOutput    `pulumi:"organizationSlug"`
+	ProjectSlug      pulumi.StringOutput    `pulumi:"projectSlug"`
+	// Only count events matching this search query.
+	Query pulumi.StringOutput `pulumi:"query"`
+	// The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+	ResolveThreshold pulumi.Float64PtrOutput `pulumi:"resolveThreshold"`
+	// Whether the alert fires above or below the thresholds. Defaults to above.
+	ThresholdType pulumi.StringOutput `pulumi:"thresholdType"`
+	// The period the metric is aggregated over, in minutes.
+	TimeWindow pulumi.IntOutput                  `pulumi:"timeWindow"`
+	Triggers   MetricAlertRuleTriggerArrayOutput `pulumi:"triggers"`
+}
+
+// NewMetricAlertRule registers a new resource with the given unique name, arguments, and options.
+func NewMetricAlertRule(ctx *pulumi.Context,
+	name string, args *MetricAlertRuleArgs, opts ...pulumi.ResourceOption) (*MetricAlertRule, error) {
+	if args == nil || args.Aggregate == nil {
+		return nil, errors.New("missing required argument 'Aggregate'")
+	}
+	if args == nil || args.Name == nil {
+		return nil, errors.New("missing required argument 'Name'")
+	}
+	if args == nil || args.ProjectSlug == nil {
+		return nil, errors.New("missing required argument 'ProjectSlug'")
+	}
+	if args == nil || args.TimeWindow == nil {
+		return nil, errors.New("missing required argument 'TimeWindow'")
+	}
+	if args == nil || args.Triggers == nil {
+		return nil, errors.New("missing required argument 'Triggers'")
+	}
+	if args == nil {
+		args = &MetricAlertRuleArgs{}
+	}
+	var resource MetricAlertRule
+	err := ctx.RegisterResource("sentry:index:MetricAlertRule", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetMetricAlertRule gets an existing MetricAlertRule resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetMetricAlertRule(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *MetricAlertRuleState, opts ...pulumi.ResourceOption) (*MetricAlertRule, error) {
+	var resource MetricAlertRule
+	err := ctx.ReadResource("sentry:index:MetricAlertRule", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering MetricAlertRule resources.
+type metricAlertRuleState struct {
+	// The metric, e.g. count() or p95(transaction.duration).
+	Aggregate *string `pulumi:"aggregate"`
+	// events or transactions. Defaults to events.
+	Dataset *string `pulumi:"dataset"`
+	// Only count events from this environment.
+	Environment      *string `pulumi:"environment"`
+	Name             *string `pulumi:"name"`
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      *string `pulumi:"projectSlug"`
+	// Only count events matching this search query.
+	Query *string `pulumi:"query"`
+	// The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+	ResolveThreshold *float64 `pulumi:"resolveThreshold"`
+	// Whether the alert fires above or below the thresholds. Defaults to above.
+	ThresholdType *string `pulumi:"thresholdType"`
+	// The period the metric is aggregated over, in minutes.
+	TimeWindow *int                     `pulumi:"timeWindow"`
+	Triggers   []MetricAlertRuleTrigger `pulumi:"triggers"`
+}
+
+type MetricAlertRuleState struct {
+	// The metric, e.g. count() or p95(transaction.duration).
+	Aggregate pulumi.StringPtrInput
+	// events or transactions. Defaults to events.
+	Dataset pulumi.StringPtrInput
+	// Only count events from this environment.
+	Environment      pulumi.StringPtrInput
+	Name             pulumi.StringPtrInput
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringPtrInput
+	// Only count events matching this search query.
+	Query pulumi.StringPtrInput
+	// The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+	ResolveThreshold pulumi.Float64PtrInput
+	// Whether the alert fires above or below the thresholds. Defaults to above.
+	ThresholdType pulumi.StringPtrInput
+	// The period the metric is aggregated over, in minutes.
+	TimeWindow pulumi.IntPtrInput
+	Triggers   MetricAlertRuleTriggerArrayInput
+}
+
+func (MetricAlertRuleState) ElementType() reflect.Type {
+	return reflect.TypeOf((*metricAlertRuleState)(nil)).Elem()
+}
+
+type metricAlertRuleArgs struct {
+	// The metric, e.g. count() or p95(transaction.duration).
+	Aggregate string `pulumi:"aggregate"`
+	// events or transactions. Defaults to events.
+	Dataset *string `pulumi:"dataset"`
+	// Only count events from this environment.
+	Environment *string `pulumi:"environment"`
+	Name        string  `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      string  `pulumi:"projectSlug"`
+	// Only count events matching this search query.
+	Query *string `pulumi:"query"`
+	// The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+	ResolveThreshold *float64 `pulumi:"resolveThreshold"`
+	// Whether the alert fires above or below the thresholds. Defaults to above.
+	ThresholdType *string `pulumi:"thresholdType"`
+	// The period the metric is aggregated over, in minutes.
+	TimeWindow int                      `pulumi:"timeWindow"`
+	Triggers   []MetricAlertRuleTrigger `pulumi:"triggers"`
+}
+
+// The set of arguments for constructing a MetricAlertRule resource.
+type MetricAlertRuleArgs struct {
+	// The metric, e.g. count() or p95(transaction.duration).
+	Aggregate pulumi.StringInput
+	// events or transactions. Defaults to events.
+	Dataset pulumi.StringPtrInput
+	// Only count events from this environment.
+	Environment pulumi.StringPtrInput
+	Name        pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringInput
+	// Only count events matching this search query.
+	Query pulumi.StringPtrInput
+	// The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+	ResolveThreshold pulumi.Float64PtrInput
+	// Whether the alert fires above or below the thresholds. Defaults to above.
+	ThresholdType pulumi.StringPtrInput
+	// The period the metric is aggregated over, in minutes.
+	TimeWindow pulumi.IntInput
+	Triggers   MetricAlertRuleTriggerArrayInput
+}
+
+func (MetricAlertRuleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*metricAlertRuleArgs)(nil)).Elem()
+}
+
+type MetricAlertRuleInput interface {
+	pulumi.Input
+
+	ToMetricAlertRuleOutput() MetricAlertRuleOutput
+	ToMetricAlertRuleOutputWithContext(ctx context.Context) MetricAlertRuleOutput
+}
+
+func (MetricAlertRule) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetricAlertRule)(nil)).Elem()
+}
+
+func (i MetricAlertRule) ToMetricAlertRuleOutput() MetricAlertRuleOutput {
+	return i.ToMetricAlertRuleOutputWithContext(context.Background())
+}
+
+func (i MetricAlertRule) ToMetricAlertRuleOutputWithContext(ctx context.Context) MetricAlertRuleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetricAlertRuleOutput)
+}
+
+type MetricAlertRuleOutput struct {
+	*pulumi.OutputState
+}
+
+func (MetricAlertRuleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetricAlertRuleOutput)(nil)).Elem()
+}
+
+func (o MetricAlertRuleOutput) ToMetricAlertRuleOutput() MetricAlertRuleOutput {
+	return o
+}
+
+func (o MetricAlertRuleOutput) ToMetricAlertRuleOutputWithContext(ctx context.Context) MetricAlertRuleOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(MetricAlertRuleOutput{})
+}
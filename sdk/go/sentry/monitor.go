@@ -0,0 +1,204 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A cron monitor of a project, alerting when a scheduled job misses a check-in or runs for too long.
+type Monitor struct {
+	pulumi.CustomResourceState
+
+	// How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+	CheckinMargin pulumi.IntPtrOutput `pulumi:"checkinMargin"`
+	// minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+	IntervalUnit pulumi.StringPtrOutput `pulumi:"intervalUnit"`
+	// How many intervalUnits there are between runs of the job.
+	IntervalValue pulumi.IntPtrOutput `pulumi:"intervalValue"`
+	// How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+	MaxRuntime pulumi.IntPtrOutput `pulumi:"maxRuntime"`
+	Name       pulumi.StringOutput `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	ProjectSlug      pulumi.StringOutput `pulumi:"projectSlug"`
+	// The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+	Schedule pulumi.StringPtrOutput `pulumi:"schedule"`
+	// The slug jobs check in with.
+	Slug pulumi.StringOutput `pulumi:"slug"`
+	// The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+	Timezone pulumi.StringOutput `pulumi:"timezone"`
+}
+
+// NewMonitor registers a new resource with the given unique name, arguments, and options.
+func NewMonitor(ctx *pulumi.Context,
+	name string, args *MonitorArgs, opts ...pulumi.ResourceOption) (*Monitor, error) {
+	if args == nil || args.Name == nil {
+		return nil, errors.New("missing required argument 'Name'")
+	}
+	if args == nil || args.ProjectSlug == nil {
+		return nil, errors.New("missing required argument 'ProjectSlug'")
+	}
+	if args == nil || args.Slug == nil {
+		return nil, errors.New("missing required argument 'Slug'")
+	}
+	if args == nil {
+		args = &MonitorArgs{}
+	}
+	var resource Monitor
+	err := ctx.RegisterResource("sentry:index:Monitor", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetMonitor gets an existing Monitor resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetMonitor(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *MonitorState, opts ...pulumi.ResourceOption) (*Monitor, error) {
+	var resource Monitor
+	err := ctx.ReadResource("sentry:index:Monitor", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Monitor resources.
+type monitorState struct {
+	// How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+	CheckinMargin *int `pulumi:"checkinMargin"`
+	// minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+	IntervalUnit *string `pulumi:"intervalUnit"`
+	// How many intervalUnits there are between runs of the job.
+	IntervalValue *int `pulumi:"intervalValue"`
+	// How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+	MaxRuntime *int    `pulumi:"maxRuntime"`
+	Name       *string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      *string `pulumi:"projectSlug"`
+	// The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+	Schedule *string `pulumi:"schedule"`
+	// The slug jobs check in with.
+	Slug *string `pulumi:"slug"`
+	// The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+	Timezone *string `pulumi:"timezone"`
+}
+
+type MonitorState struct {
+	// How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+	CheckinMargin pulumi.IntPtrInput
+	// minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+	IntervalUnit pulumi.StringPtrInput
+	// How many intervalUnits there are between runs of the job.
+	IntervalValue pulumi.IntPtrInput
+	// How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+	MaxRuntime pulumi.IntPtrInput
+	Name       pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringPtrInput
+	// The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+	Schedule pulumi.StringPtrInput
+	// The slug jobs check in with.
+	Slug pulumi.StringPtrInput
+	// The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+	Timezone pulumi.StringPtrInput
+}
+
+func (MonitorState) ElementType() reflect.Type {
+	return reflect.TypeOf((*monitorState)(nil)).Elem()
+}
+
+type monitorArgs struct {
+	// How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+	CheckinMargin *int `pulumi:"checkinMargin"`
+	// minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+	IntervalUnit *string `pulumi:"intervalUnit"`
+	// How many intervalUnits there are between runs of the job.
+	IntervalValue *int `pulumi:"intervalValue"`
+	// How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+	MaxRuntime *int   `pulumi:"maxRuntime"`
+	Name       string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      string  `pulumi:"projectSlug"`
+	// The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+	Schedule *string `pulumi:"schedule"`
+	// The slug jobs check in with.
+	Slug string `pulumi:"slug"`
+	// The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+	Timezone *string `pulumi:"timezone"`
+}
+
+// The set of arguments for constructing a Monitor resource.
+type MonitorArgs struct {
+	// How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+	CheckinMargin pulumi.IntPtrInput
+	// minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+	IntervalUnit pulumi.StringPtrInput
+	// How many intervalUnits there are between runs of the job.
+	IntervalValue pulumi.IntPtrInput
+	// How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+	MaxRuntime pulumi.IntPtrInput
+	Name       pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringInput
+	// The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+	Schedule pulumi.StringPtrInput
+	// The slug jobs check in with.
+	Slug pulumi.StringInput
+	// The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+	Timezone pulumi.StringPtrInput
+}
+
+func (MonitorArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*monitorArgs)(nil)).Elem()
+}
+
+type MonitorInput interface {
+	pulumi.Input
+
+	ToMonitorOutput() MonitorOutput
+	ToMonitorOutputWithContext(ctx context.Context) MonitorOutput
+}
+
+func (Monitor) ElementType() reflect.Type {
+	return reflect.TypeOf((*Monitor)(nil)).Elem()
+}
+
+func (i Monitor) ToMonitorOutput() MonitorOutput {
+	return i.ToMonitorOutputWithContext(context.Background())
+}
+
+func (i Monitor) ToMonitorOutputWithContext(ctx context.Context) MonitorOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MonitorOutput)
+}
+
+type MonitorOutput struct {
+	*pulumi.OutputState
+}
+
+func (MonitorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MonitorOutput)(nil)).Elem()
+}
+
+func (o MonitorOutput) ToMonitorOutput() MonitorOutput {
+	return o
+}
+
+func (o MonitorOutput) ToMonitorOutputWithContext(ctx context.Context) MonitorOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(MonitorOutput{})
+}
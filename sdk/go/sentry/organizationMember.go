@@ -0,0 +1,160 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A member of an organization, invited by email on creation and removed from the organization on deletion.
+type OrganizationMember struct {
+	pulumi.CustomResourceState
+
+	// The email the invitation is sent to.
+	Email pulumi.StringOutput `pulumi:"email"`
+	// The ID of the member, e.g. for TeamMember resources.
+	MemberId pulumi.StringOutput `pulumi:"memberId"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	// Whether the invitation has not been accepted yet.
+	Pending pulumi.BoolOutput `pulumi:"pending"`
+	// One of member, admin, manager, owner or billing. Defaults to member.
+	Role pulumi.StringOutput `pulumi:"role"`
+	// The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+	TeamSlugs pulumi.StringArrayOutput `pulumi:"teamSlugs"`
+}
+
+// NewOrganizationMember registers a new resource with the given unique name, arguments, and options.
+func NewOrganizationMember(ctx *pulumi.Context,
+	name string, args *OrganizationMemberArgs, opts ...pulumi.ResourceOption) (*OrganizationMember, error) {
+	if args == nil || args.Email == nil {
+		return nil, errors.New("missing required argument 'Email'")
+	}
+	if args == nil {
+		args = &OrganizationMemberArgs{}
+	}
+	var resource OrganizationMember
+	err := ctx.RegisterResource("sentry:index:OrganizationMember", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetOrganizationMember gets an existing OrganizationMember resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetOrganizationMember(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *OrganizationMemberState, opts ...pulumi.ResourceOption) (*OrganizationMember, error) {
+	var resource OrganizationMember
+	err := ctx.ReadResource("sentry:index:OrganizationMember", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering OrganizationMember resources.
+type organizationMemberState struct {
+	// The email the invitation is sent to.
+	Email *string `pulumi:"email"`
+	// The ID of the member, e.g. for TeamMember resources.
+	MemberId *string `pulumi:"memberId"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// Whether the invitation has not been accepted yet.
+	Pending *bool `pulumi:"pending"`
+	// One of member, admin, manager, owner or billing. Defaults to member.
+	Role *string `pulumi:"role"`
+	// The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+	TeamSlugs []string `pulumi:"teamSlugs"`
+}
+
+type OrganizationMemberState struct {
+	// The email the invitation is sent to.
+	Email pulumi.StringPtrInput
+	// The ID of the member, e.g. for TeamMember resources.
+	MemberId pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// Whether the invitation has not been accepted yet.
+	Pending pulumi.BoolPtrInput
+	// One of member, admin, manager, owner or billing. Defaults to member.
+	Role pulumi.StringPtrInput
+	// The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+	TeamSlugs pulumi.StringArrayInput
+}
+
+func (OrganizationMemberState) ElementType() reflect.Type {
+	return reflect.TypeOf((*organizationMemberState)(nil)).Elem()
+}
+
+type organizationMemberArgs struct {
+	// The email the invitation is sent to.
+	Email string `pulumi:"email"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// One of member, admin, manager, owner or billing. Defaults to member.
+	Role *string `pulumi:"role"`
+	// The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+	TeamSlugs []string `pulumi:"teamSlugs"`
+}
+
+// The set of arguments for constructing a OrganizationMember resource.
+type OrganizationMemberArgs struct {
+	// The email the invitation is sent to.
+	Email pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// One of member, admin, manager, owner or billing. Defaults to member.
+	Role pulumi.StringPtrInput
+	// The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+	TeamSlugs pulumi.StringArrayInput
+}
+
+func (OrganizationMemberArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*organizationMemberArgs)(nil)).Elem()
+}
+
+type OrganizationMemberInput interface {
+	pulumi.Input
+
+	ToOrganizationMemberOutput() OrganizationMemberOutput
+	ToOrganizationMemberOutputWithContext(ctx context.Context) OrganizationMemberOutput
+}
+
+func (OrganizationMember) ElementType() reflect.Type {
+	return reflect.TypeOf((*OrganizationMember)(nil)).Elem()
+}
+
+func (i OrganizationMember) ToOrganizationMemberOutput() OrganizationMemberOutput {
+	return i.ToOrganizationMemberOutputWithContext(context.Background())
+}
+
+func (i OrganizationMember) ToOrganizationMemberOutputWithContext(ctx context.Context) OrganizationMemberOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OrganizationMemberOutput)
+}
+
+type OrganizationMemberOutput struct {
+	*pulumi.OutputState
+}
+
+func (OrganizationMemberOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OrganizationMemberOutput)(nil)).Elem()
+}
+
+func (o OrganizationMemberOutput) ToOrganizationMemberOutput() OrganizationMemberOutput {
+	return o
+}
+
+func (o OrganizationMemberOutput) ToOrganizationMemberOutputWithContext(ctx context.Context) OrganizationMemberOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(OrganizationMemberOutput{})
+}
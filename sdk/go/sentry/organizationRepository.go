@@ -0,0 +1,153 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A code repository linked to an organization through an integration such as GitHub, so that releases can reference its commits.
+type OrganizationRepository struct {
+	pulumi.CustomResourceState
+
+	// The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+	IntegrationId pulumi.IntOutput `pulumi:"integrationId"`
+	// The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	// Assigned by Sentry, e.g. `integrations:github`.
+	Provider pulumi.StringOutput `pulumi:"provider"`
+	// Assigned by Sentry.
+	Url pulumi.StringOutput `pulumi:"url"`
+}
+
+// NewOrganizationRepository registers a new resource with the given unique name, arguments, and options.
+func NewOrganizationRepository(ctx *pulumi.Context,
+	name string, args *OrganizationRepositoryArgs, opts ...pulumi.ResourceOption) (*OrganizationRepository, error) {
+	if args == nil || args.IntegrationId == nil {
+		return nil, errors.New("missing required argument 'IntegrationId'")
+	}
+	if args == nil || args.Name == nil {
+		return nil, errors.New("missing required argument 'Name'")
+	}
+	if args == nil {
+		args = &OrganizationRepositoryArgs{}
+	}
+	var resource OrganizationRepository
+	err := ctx.RegisterResource("sentry:index:OrganizationRepository", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetOrganizationRepository gets an existing OrganizationRepository resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetOrganizationRepository(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *OrganizationRepositoryState, opts ...pulumi.ResourceOption) (*OrganizationRepository, error) {
+	var resource OrganizationRepository
+	err := ctx.ReadResource("sentry:index:OrganizationRepository", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering OrganizationRepository resources.
+type organizationRepositoryState struct {
+	// The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+	IntegrationId *int `pulumi:"integrationId"`
+	// The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+	Name *string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// Assigned by Sentry, e.g. `integrations:github`.
+	Provider *string `pulumi:"provider"`
+	// Assigned by Sentry.
+	Url *string `pulumi:"url"`
+}
+
+type OrganizationRepositoryState struct {
+	// The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+	IntegrationId pulumi.IntPtrInput
+	// The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+	Name pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// Assigned by Sentry, e.g. `integrations:github`.
+	Provider pulumi.StringPtrInput
+	// Assigned by Sentry.
+	Url pulumi.StringPtrInput
+}
+
+func (OrganizationRepositoryState) ElementType() reflect.Type {
+	return reflect.TypeOf((*organizationRepositoryState)(nil)).Elem()
+}
+
+type organizationRepositoryArgs struct {
+	// The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+	IntegrationId int `pulumi:"integrationId"`
+	// The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+	Name string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+}
+
+// The set of arguments for constructing a OrganizationRepository resource.
+type OrganizationRepositoryArgs struct {
+	// The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+	IntegrationId pulumi.IntInput
+	// The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+	Name pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+}
+
+func (OrganizationRepositoryArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*organizationRepositoryArgs)(nil)).Elem()
+}
+
+type OrganizationRepositoryInput interface {
+	pulumi.Input
+
+	ToOrganizationRepositoryOutput() OrganizationRepositoryOutput
+	ToOrganizationRepositoryOutputWithContext(ctx context.Context) OrganizationRepositoryOutput
+}
+
+func (OrganizationRepository) ElementType() reflect.Type {
+	return reflect.TypeOf((*OrganizationRepository)(nil)).Elem()
+}
+
+func (i OrganizationRepository) ToOrganizationRepositoryOutput() OrganizationRepositoryOutput {
+	return i.ToOrganizationRepositoryOutputWithContext(context.Background())
+}
+
+func (i OrganizationRepository) ToOrganizationRepositoryOutputWithContext(ctx context.Context) OrganizationRepositoryOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OrganizationRepositoryOutput)
+}
+
+type OrganizationRepositoryOutput struct {
+	*pulumi.OutputState
+}
+
+func (OrganizationRepositoryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OrganizationRepositoryOutput)(nil)).Elem()
+}
+
+func (o OrganizationRepositoryOutput) ToOrganizationRepositoryOutput() OrganizationRepositoryOutput {
+	return o
+}
+
+func (o OrganizationRepositoryOutput) ToOrganizationRepositoryOutputWithContext(ctx context.Context) OrganizationRepositoryOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(OrganizationRepositoryOutput{})
+}
@@ -0,0 +1,219 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// The settings of an existing organization. Settings left out keep what they have in Sentry. Creating the resource adopts the organization, and deleting it leaves the organization and its settings as they are.
+type OrganizationSettings struct {
+	pulumi.CustomResourceState
+
+	// Allow sharing of limited details on issues to anonymous users.
+	AllowSharedIssues pulumi.BoolPtrOutput `pulumi:"allowSharedIssues"`
+	// Require server-side data scrubbing in all projects.
+	DataScrubber pulumi.BoolPtrOutput `pulumi:"dataScrubber"`
+	// Require the default scrubbers in all projects.
+	DataScrubberDefaults pulumi.BoolPtrOutput `pulumi:"dataScrubberDefaults"`
+	// The role of new members: member, admin, manager, owner or billing.
+	DefaultRole pulumi.StringPtrOutput `pulumi:"defaultRole"`
+	// Keep source code and other sensitive data out of notifications.
+	EnhancedPrivacy pulumi.BoolPtrOutput   `pulumi:"enhancedPrivacy"`
+	Name            pulumi.StringPtrOutput `pulumi:"name"`
+	// Let members join any team of the organization.
+	OpenMembership pulumi.BoolPtrOutput `pulumi:"openMembership"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	// Require members to enable two-factor authentication.
+	Require2FA pulumi.BoolPtrOutput `pulumi:"require2FA"`
+	// Field names data scrubbers ignore in all projects.
+	SafeFields pulumi.StringArrayOutput `pulumi:"safeFields"`
+	// Keep IP addresses out of events of all projects.
+	ScrubIPAddresses pulumi.BoolPtrOutput `pulumi:"scrubIPAddresses"`
+	// Additional field names to scrub in all projects.
+	SensitiveFields pulumi.StringArrayOutput `pulumi:"sensitiveFields"`
+}
+
+// NewOrganizationSettings registers a new resource with the given unique name, arguments, and options.
+func NewOrganizationSettings(ctx *pulumi.Context,
+	name string, args *OrganizationSettingsArgs, opts ...pulumi.ResourceOption) (*OrganizationSettings, error) {
+	if args == nil {
+		args = &OrganizationSettingsArgs{}
+	}
+	var resource OrganizationSettings
+	err := ctx.RegisterResource("sentry:index:OrganizationSettings", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetOrganizationSettings gets an existing OrganizationSettings resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetOrganizationSettings(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *OrganizationSettingsState, opts ...pulumi.ResourceOption) (*OrganizationSettings, error) {
+	var resource OrganizationSettings
+	err := ctx.ReadResource("sentry:index:OrganizationSettings", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering OrganizationSettings resources.
+type organizationSettingsState struct {
+	// Allow sharing of limited details on issues to anonymous users.
+	AllowSharedIssues *bool `pulumi:"allowSharedIssues"`
+	// Require server-side data scrubbing in all projects.
+	DataScrubber *bool `pulumi:"dataScrubber"`
+	// Require the default scrubbers in all projects.
+	DataScrubberDefaults *bool `pulumi:"dataScrubberDefaults"`
+	// The role of new members: member, admin, manager, owner or billing.
+	DefaultRole *string `pulumi:"defaultRole"`
+	// Keep source code and other sensitive data out of notifications.
+	EnhancedPrivacy *bool   `pulumi:"enhancedPrivacy"`
+	Name            *string `pulumi:"name"`
+	// Let members join any team of the organization.
+	OpenMembership *bool `pulumi:"openMembership"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// Require members to enable two-factor authentication.
+	Require2FA *bool `pulumi:"require2FA"`
+	// Field names data scrubbers ignore in all projects.
+	SafeFields []string `pulumi:"safeFields"`
+	// Keep IP addresses out of events of all projects.
+	ScrubIPAddresses *bool `pulumi:"scrubIPAddresses"`
+	// Additional field names to scrub in all projects.
+	SensitiveFields []string `pulumi:"sensitiveFields"`
+}
+
+type OrganizationSettingsState struct {
+	// Allow sharing of limited details on issues to anonymous users.
+	AllowSharedIssues pulumi.BoolPtrInput
+	// Require server-side data scrubbing in all projects.
+	DataScrubber pulumi.BoolPtrInput
+	// Require the default scrubbers in all projects.
+	DataScrubberDefaults pulumi.BoolPtrInput
+	// The role of new members: member, admin, manager, owner or billing.
+	DefaultRole pulumi.StringPtrInput
+	// Keep source code and other sensitive data out of notifications.
+	EnhancedPrivacy pulumi.BoolPtrInput
+	Name            pulumi.StringPtrInput
+	// Let members join any team of the organization.
+	OpenMembership pulumi.BoolPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// Require members to enable two-factor authentication.
+	Require2FA pulumi.BoolPtrInput
+	// Field names data scrubbers ignore in all projects.
+	SafeFields pulumi.StringArrayInput
+	// Keep IP addresses out of events of all projects.
+	ScrubIPAddresses pulumi.BoolPtrInput
+	// Additional field names to scrub in all projects.
+	SensitiveFields pulumi.StringArrayInput
+}
+
+func (OrganizationSettingsState) ElementType() reflect.Type {
+	return reflect.TypeOf((*organizationSettingsState)(nil)).Elem()
+}
+
+type organizationSettingsArgs struct {
+	// Allow sharing of limited details on issues to anonymous users.
+	AllowSharedIssues *bool `pulumi:"allowSharedIssues"`
+	// Require server-side data scrubbing in all projects.
+	DataScrubber *bool `pulumi:"dataScrubber"`
+	// Require the default scrubbers in all projects.
+	DataScrubberDefaults *bool `pulumi:"dataScrubberDefaults"`
+	// The role of new members: member, admin, manager, owner or billing.
+	DefaultRole *string `pulumi:"defaultRole"`
+	// Keep source code and other sensitive data out of notifications.
+	EnhancedPrivacy *bool   `pulumi:"enhancedPrivacy"`
+	Name            *string `pulumi:"name"`
+	// Let members join any team of the organization.
+	OpenMembership *bool `pulumi:"openMembership"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// Require members to enable two-factor authentication.
+	Require2FA *bool `pulumi:"require2FA"`
+	// Field names data scrubbers ignore in all projects.
+	SafeFields []string `pulumi:"safeFields"`
+	// Keep IP addresses out of events of all projects.
+	ScrubIPAddresses *bool `pulumi:"scrubIPAddresses"`
+	// Additional field names to scrub in all projects.
+	SensitiveFields []string `pulumi:"sensitiveFields"`
+}
+
+// The set of arguments for constructing a OrganizationSettings resource.
+type OrganizationSettingsArgs struct {
+	// Allow sharing of limited details on issues to anonymous users.
+	AllowSharedIssues pulumi.BoolPtrInput
+	// Require server-side data scrubbing in all projects.
+	DataScrubber pulumi.BoolPtrInput
+	// Require the default scrubbers in all projects.
+	DataScrubberDefaults pulumi.BoolPtrInput
+	// The role of new members: member, admin, manager, owner or billing.
+	DefaultRole pulumi.StringPtrInput
+	// Keep source code and other sensitive data out of notifications.
+	EnhancedPrivacy pulumi.BoolPtrInput
+	Name            pulumi.StringPtrInput
+	// Let members join any team of the organization.
+	OpenMembership pulumi.BoolPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// Require members to enable two-factor authentication.
+	Require2FA pulumi.BoolPtrInput
+	// Field names data scrubbers ignore in all projects.
+	SafeFields pulumi.StringArrayInput
+	// Keep IP addresses out of events of all projects.
+	ScrubIPAddresses pulumi.BoolPtrInput
+	// Additional field names to scrub in all projects.
+	SensitiveFields pulumi.StringArrayInput
+}
+
+func (OrganizationSettingsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*organizationSettingsArgs)(nil)).Elem()
+}
+
+type OrganizationSettingsInput interface {
+	pulumi.Input
+
+	ToOrganizationSettingsOutput() OrganizationSettingsOutput
+	ToOrganizationSettingsOutputWithContext(ctx context.Context) OrganizationSettingsOutput
+}
+
+func (OrganizationSettings) ElementType() reflect.Type {
+	return reflect.TypeOf((*OrganizationSettings)(nil)).Elem()
+}
+
+func (i OrganizationSettings) ToOrganizationSettingsOutput() OrganizationSettingsOutput {
+	return i.ToOrganizationSettingsOutputWithContext(context.Background())
+}
+
+func (i OrganizationSettings) ToOrganizationSettingsOutputWithContext(ctx context.Context) OrganizationSettingsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OrganizationSettingsOutput)
+}
+
+type OrganizationSettingsOutput struct {
+	*pulumi.OutputState
+}
+
+func (OrganizationSettingsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OrganizationSettingsOutput)(nil)).Elem()
+}
+
+func (o OrganizationSettingsOutput) ToOrganizationSettingsOutput() OrganizationSettingsOutput {
+	return o
+}
+
+func (o OrganizationSettingsOutput) ToOrganizationSettingsOutputWithContext(ctx context.Context) OrganizationSettingsOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(OrganizationSettingsOutput{})
+}
@@ -14,14 +14,38 @@ import (
 type Project struct {
 	pulumi.CustomResourceState
 
+	// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+	AllowedDomains pulumi.StringArrayOutput `pulumi:"allowedDomains"`
+	// Whether Sentry removes sensitive data from events server-side.
+	DataScrubber pulumi.BoolPtrOutput `pulumi:"dataScrubber"`
+	// Whether the data scrubber applies its default list of sensitive fields.
+	DataScrubberDefaults      pulumi.BoolPtrOutput   `pulumi:"dataScrubberDefaults"`
 	DefaultClientKeyDSNPublic pulumi.StringPtrOutput `pulumi:"defaultClientKeyDSNPublic"`
-	DefaultEnvironment        pulumi.StringPtrOutput `pulumi:"defaultEnvironment"`
-	Name                      pulumi.StringOutput    `pulumi:"name"`
-	OrganizationSlug          pulumi.StringPtrOutput `pulumi:"organizationSlug"`
-	Slug                      pulumi.StringOutput    `pulumi:"slug"`
-	SubjectPrefix             pulumi.StringPtrOutput `pulumi:"subjectPrefix"`
-	SubjectTemplate           pulumi.StringPtrOutput `pulumi:"subjectTemplate"`
-	TeamSlug                  pulumi.StringPtrOutput `pulumi:"teamSlug"`
+	// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+	DefaultClientKeyRateLimitCount pulumi.IntPtrOutput `pulumi:"defaultClientKeyRateLimitCount"`
+	// The window of the default client key's rate limit, in seconds.
+	DefaultClientKeyRateLimitWindow pulumi.IntPtrOutput    `pulumi:"defaultClientKeyRateLimitWindow"`
+	DefaultEnvironment              pulumi.StringPtrOutput `pulumi:"defaultEnvironment"`
+	Name                            pulumi.StringOutput    `pulumi:"name"`
+	OrganizationSlug                pulumi.StringPtrOutput `pulumi:"organizationSlug"`
+	// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+	ResolveAge pulumi.IntPtrOutput `pulumi:"resolveAge"`
+	// Field names the data scrubber must leave as they are.
+	SafeFields pulumi.StringArrayOutput `pulumi:"safeFields"`
+	// Whether Sentry fetches JavaScript source files and source maps.
+	ScrapeJavaScript pulumi.BoolPtrOutput `pulumi:"scrapeJavaScript"`
+	// Whether IP addresses are removed from events.
+	ScrubIPAddresses pulumi.BoolPtrOutput `pulumi:"scrubIPAddresses"`
+	// Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+	SecurityToken pulumi.StringPtrOutput `pulumi:"securityToken"`
+	// Additional field names the data scrubber removes.
+	SensitiveFields pulumi.StringArrayOutput `pulumi:"sensitiveFields"`
+	Slug            pulumi.StringOutput      `pulumi:"slug"`
+	SubjectPrefix   pulumi.StringPtrOutput   `pulumi:"subjectPrefix"`
+	SubjectTemplate pulumi.StringPtrOutput   `pulumi:"subjectTemplate"`
+	// Deprecated: Use teamSlugs instead.
+	TeamSlug  pulumi.StringPtrOutput   `pulumi:"teamSlug"`
+	TeamSlugs pulumi.StringArrayOutput `pulumi:"teamSlugs"`
 }
 
 // NewProject registers a new resource with the given unique name, arguments, and options.
@@ -30,18 +54,16 @@ func NewProject(ctx *pulumi.Context,
 	if args == nil || args.Name == nil {
 		return nil, errors.New("missing required argument 'Name'")
 	}
-	if args == nil || args.OrganizationSlug == nil {
-		return nil, errors.New("missing required argument 'OrganizationSlug'")
-	}
 	if args == nil || args.Slug == nil {
 		return nil, errors.New("missing required argument 'Slug'")
 	}
-	if args == nil || args.TeamSlug == nil {
-		return nil, errors.New("missing required argument 'TeamSlug'")
-	}
 	if args == nil {
 		args = &ProjectArgs{}
 	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"securityToken",
+	})
+	opts = append(opts, secrets)
 	var resource Project
 	err := ctx.RegisterResource("sentry:index:Project", name, args, &resource, opts...)
 	if err != nil {
@@ -64,25 +86,73 @@ func GetProject(ctx *pulumi.Context,
 
 // Input properties used for looking up and filtering Project resources.
 type projectState struct {
+	// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+	AllowedDomains []string `pulumi:"allowedDomains"`
+	// Whether Sentry removes sensitive data from events server-side.
+	DataScrubber *bool `pulumi:"dataScrubber"`
+	// Whether the data scrubber applies its default list of sensitive fields.
+	DataScrubberDefaults      *bool   `pulumi:"dataScrubberDefaults"`
 	DefaultClientKeyDSNPublic *string `pulumi:"defaultClientKeyDSNPublic"`
-	DefaultEnvironment        *string `pulumi:"defaultEnvironment"`
-	Name                      *string `pulumi:"name"`
-	OrganizationSlug          *string `pulumi:"organizationSlug"`
-	Slug                      *string `pulumi:"slug"`
-	SubjectPrefix             *string `pulumi:"subjectPrefix"`
-	SubjectTemplate           *string `pulumi:"subjectTemplate"`
-	TeamSlug                  *string `pulumi:"teamSlug"`
+	// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+	DefaultClientKeyRateLimitCount *int `pulumi:"defaultClientKeyRateLimitCount"`
+	// The window of the default client key's rate limit, in seconds.
+	DefaultClientKeyRateLimitWindow *int    `pulumi:"defaultClientKeyRateLimitWindow"`
+	DefaultEnvironment              *string `pulumi:"defaultEnvironment"`
+	Name                            *string `pulumi:"name"`
+	OrganizationSlug                *string `pulumi:"organizationSlug"`
+	// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+	ResolveAge *int `pulumi:"resolveAge"`
+	// Field names the data scrubber must leave as they are.
+	SafeFields []string `pulumi:"safeFields"`
+	// Whether Sentry fetches JavaScript source files and source maps.
+	ScrapeJavaScript *bool `pulumi:"scrapeJavaScript"`
+	// Whether IP addresses are removed from events.
+	ScrubIPAddresses *bool `pulumi:"scrubIPAddresses"`
+	// Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+	SecurityToken *string `pulumi:"securityToken"`
+	// Additional field names the data scrubber removes.
+	SensitiveFields []string `pulumi:"sensitiveFields"`
+	Slug            *string  `pulumi:"slug"`
+	SubjectPrefix   *string  `pulumi:"subjectPrefix"`
+	SubjectTemplate *string  `pulumi:"subjectTemplate"`
+	// Deprecated: Use teamSlugs instead.
+	TeamSlug  *string  `pulumi:"teamSlug"`
+	TeamSlugs []string `pulumi:"teamSlugs"`
 }
 
 type ProjectState struct {
+	// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+	AllowedDomains pulumi.StringArrayInput
+	// Whether Sentry removes sensitive data from events server-side.
+	DataScrubber pulumi.BoolPtrInput
+	// Whether the data scrubber applies its default list of sensitive fields.
+	DataScrubberDefaults      pulumi.BoolPtrInput
 	DefaultClientKeyDSNPublic pulumi.StringPtrInput
-	DefaultEnvironment        pulumi.StringPtrInput
-	Name                      pulumi.StringPtrInput
-	OrganizationSlug          pulumi.StringPtrInput
-	Slug                      pulumi.StringPtrInput
-	SubjectPrefix             pulumi.StringPtrInput
-	SubjectTemplate           pulumi.StringPtrInput
-	TeamSlug                  pulumi.StringPtrInput
+	// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+	DefaultClientKeyRateLimitCount pulumi.IntPtrInput
+	// The window of the default client key's rate limit, in seconds.
+	DefaultClientKeyRateLimitWindow pulumi.IntPtrInput
+	DefaultEnvironment              pulumi.StringPtrInput
+	Name                            pulumi.StringPtrInput
+	OrganizationSlug                pulumi.StringPtrInput
+	// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+	ResolveAge pulumi.IntPtrInput
+	// Field names the data scrubber must leave as they are.
+	SafeFields pulumi.StringArrayInput
+	// Whether Sentry fetches JavaScript source files and source maps.
+	ScrapeJavaScript pulumi.BoolPtrInput
+	// Whether IP addresses are removed from events.
+	ScrubIPAddresses pulumi.BoolPtrInput
+	// Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+	SecurityToken pulumi.StringPtrInput
+	// Additional field names the data scrubber removes.
+	SensitiveFields pulumi.StringArrayInput
+	Slug            pulumi.StringPtrInput
+	SubjectPrefix   pulumi.StringPtrInput
+	SubjectTemplate pulumi.StringPtrInput
+	// Deprecated: Use teamSlugs instead.
+	TeamSlug  pulumi.StringPtrInput
+	TeamSlugs pulumi.StringArrayInput
 }
 
 func (ProjectState) ElementType() reflect.Type {
@@ -90,24 +160,74 @@ func (ProjectState) ElementType() reflect.Type {
 }
 
 type projectArgs struct {
-	DefaultEnvironment *string `pulumi:"defaultEnvironment"`
-	Name               string  `pulumi:"name"`
-	OrganizationSlug   string  `pulumi:"organizationSlug"`
-	Slug               string  `pulumi:"slug"`
-	SubjectPrefix      *string `pulumi:"subjectPrefix"`
-	SubjectTemplate    *string `pulumi:"subjectTemplate"`
-	TeamSlug           string  `pulumi:"teamSlug"`
+	// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+	AllowedDomains []string `pulumi:"allowedDomains"`
+	// Whether Sentry removes sensitive data from events server-side.
+	DataScrubber *bool `pulumi:"dataScrubber"`
+	// Whether the data scrubber applies its default list of sensitive fields.
+	DataScrubberDefaults *bool `pulumi:"dataScrubberDefaults"`
+	// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+	DefaultClientKeyRateLimitCount *int `pulumi:"defaultClientKeyRateLimitCount"`
+	// The window of the default client key's rate limit, in seconds.
+	DefaultClientKeyRateLimitWindow *int    `pulumi:"defaultClientKeyRateLimitWindow"`
+	DefaultEnvironment              *string `pulumi:"defaultEnvironment"`
+	Name                            string  `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+	ResolveAge *int `pulumi:"resolveAge"`
+	// Field names the data scrubber must leave as they are.
+	SafeFields []string `pulumi:"safeFields"`
+	// Whether Sentry fetches JavaScript source files and source maps.
+	ScrapeJavaScript *bool `pulumi:"scrapeJavaScript"`
+	// Whether IP addresses are removed from events.
+	ScrubIPAddresses *bool `pulumi:"scrubIPAddresses"`
+	// Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+	SecurityToken *string `pulumi:"securityToken"`
+	// Additional field names the data scrubber removes.
+	SensitiveFields []string `pulumi:"sensitiveFields"`
+	Slug            string   `pulumi:"slug"`
+	SubjectPrefix   *string  `pulumi:"subjectPrefix"`
+	SubjectTemplate *string  `pulumi:"subjectTemplate"`
+	// Deprecated: Use teamSlugs instead.
+	TeamSlug  *string  `pulumi:"teamSlug"`
+	TeamSlugs []string `pulumi:"teamSlugs"`
 }
 
 // The set of arguments for constructing a Project resource.
 type ProjectArgs struct {
-	DefaultEnvironment pulumi.StringPtrInput
-	Name               pulumi.StringInput
-	OrganizationSlug   pulumi.StringInput
-	Slug               pulumi.StringInput
-	SubjectPrefix      pulumi.StringPtrInput
-	SubjectTemplate    pulumi.StringPtrInput
-	TeamSlug           pulumi.StringInput
+	// Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+	AllowedDomains pulumi.StringArrayInput
+	// Whether Sentry removes sensitive data from events server-side.
+	DataScrubber pulumi.BoolPtrInput
+	// Whether the data scrubber applies its default list of sensitive fields.
+	DataScrubberDefaults pulumi.BoolPtrInput
+	// The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+	DefaultClientKeyRateLimitCount pulumi.IntPtrInput
+	// The window of the default client key's rate limit, in seconds.
+	DefaultClientKeyRateLimitWindow pulumi.IntPtrInput
+	DefaultEnvironment              pulumi.StringPtrInput
+	Name                            pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// Hours of inactivity after which issues are resolved automatically, 0 disables it.
+	ResolveAge pulumi.IntPtrInput
+	// Field names the data scrubber must leave as they are.
+	SafeFields pulumi.StringArrayInput
+	// Whether Sentry fetches JavaScript source files and source maps.
+	ScrapeJavaScript pulumi.BoolPtrInput
+	// Whether IP addresses are removed from events.
+	ScrubIPAddresses pulumi.BoolPtrInput
+	// Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+	SecurityToken pulumi.StringPtrInput
+	// Additional field names the data scrubber removes.
+	SensitiveFields pulumi.StringArrayInput
+	Slug            pulumi.StringInput
+	SubjectPrefix   pulumi.StringPtrInput
+	SubjectTemplate pulumi.StringPtrInput
+	// Deprecated: Use teamSlugs instead.
+	TeamSlug  pulumi.StringPtrInput
+	TeamSlugs pulumi.StringArrayInput
 }
 
 func (ProjectArgs) ElementType() reflect.Type {
@@ -0,0 +1,146 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// The visibility of an environment of a project. Deleting the resource shows the environment again, as Sentry can't delete environments.
+type ProjectEnvironment struct {
+	pulumi.CustomResourceState
+
+	// Hide the environment from the environment selectors of Sentry. Defaults to false.
+	Hidden pulumi.BoolOutput `pulumi:"hidden"`
+	// The name of the environment, which Sentry adds to the project with the first event sent from it.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	ProjectSlug      pulumi.StringOutput `pulumi:"projectSlug"`
+}
+
+// NewProjectEnvironment registers a new resource with the given unique name, arguments, and options.
+func NewProjectEnvironment(ctx *pulumi.Context,
+	name string, args *ProjectEnvironmentArgs, opts ...pulumi.ResourceOption) (*ProjectEnvironment, error) {
+	if args == nil || args.Name == nil {
+		return nil, errors.New("missing required argument 'Name'")
+	}
+	if args == nil || args.ProjectSlug == nil {
+		return nil, errors.New("missing required argument 'ProjectSlug'")
+	}
+	if args == nil {
+		args = &ProjectEnvironmentArgs{}
+	}
+	var resource ProjectEnvironment
+	err := ctx.RegisterResource("sentry:index:ProjectEnvironment", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetProjectEnvironment gets an existing ProjectEnvironment resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetProjectEnvironment(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ProjectEnvironmentState, opts ...pulumi.ResourceOption) (*ProjectEnvironment, error) {
+	var resource ProjectEnvironment
+	err := ctx.ReadResource("sentry:index:ProjectEnvironment", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ProjectEnvironment resources.
+type projectEnvironmentState struct {
+	// Hide the environment from the environment selectors of Sentry. Defaults to false.
+	Hidden *bool `pulumi:"hidden"`
+	// The name of the environment, which Sentry adds to the project with the first event sent from it.
+	Name *string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      *string `pulumi:"projectSlug"`
+}
+
+type ProjectEnvironmentState struct {
+	// Hide the environment from the environment selectors of Sentry. Defaults to false.
+	Hidden pulumi.BoolPtrInput
+	// The name of the environment, which Sentry adds to the project with the first event sent from it.
+	Name pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringPtrInput
+}
+
+func (ProjectEnvironmentState) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectEnvironmentState)(nil)).Elem()
+}
+
+type projectEnvironmentArgs struct {
+	// Hide the environment from the environment selectors of Sentry. Defaults to false.
+	Hidden *bool `pulumi:"hidden"`
+	// The name of the environment, which Sentry adds to the project with the first event sent from it.
+	Name string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      string  `pulumi:"projectSlug"`
+}
+
+// The set of arguments for constructing a ProjectEnvironment resource.
+type ProjectEnvironmentArgs struct {
+	// Hide the environment from the environment selectors of Sentry. Defaults to false.
+	Hidden pulumi.BoolPtrInput
+	// The name of the environment, which Sentry adds to the project with the first event sent from it.
+	Name pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringInput
+}
+
+func (ProjectEnvironmentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectEnvironmentArgs)(nil)).Elem()
+}
+
+type ProjectEnvironmentInput interface {
+	pulumi.Input
+
+	ToProjectEnvironmentOutput() ProjectEnvironmentOutput
+	ToProjectEnvironmentOutputWithContext(ctx context.Context) ProjectEnvironmentOutput
+}
+
+func (ProjectEnvironment) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectEnvironment)(nil)).Elem()
+}
+
+func (i ProjectEnvironment) ToProjectEnvironmentOutput() ProjectEnvironmentOutput {
+	return i.ToProjectEnvironmentOutputWithContext(context.Background())
+}
+
+func (i ProjectEnvironment) ToProjectEnvironmentOutputWithContext(ctx context.Context) ProjectEnvironmentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectEnvironmentOutput)
+}
+
+type ProjectEnvironmentOutput struct {
+	*pulumi.OutputState
+}
+
+func (ProjectEnvironmentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectEnvironmentOutput)(nil)).Elem()
+}
+
+func (o ProjectEnvironmentOutput) ToProjectEnvironmentOutput() ProjectEnvironmentOutput {
+	return o
+}
+
+func (o ProjectEnvironmentOutput) ToProjectEnvironmentOutputWithContext(ctx context.Context) ProjectEnvironmentOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ProjectEnvironmentOutput{})
+}
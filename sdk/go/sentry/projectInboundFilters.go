@@ -0,0 +1,190 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// The inbound data filters of a project. Filters left out are turned off, and deleting the resource turns them all off.
+type ProjectInboundFilters struct {
+	pulumi.CustomResourceState
+
+	// Filter out errors caused by known browser extensions.
+	BrowserExtensions pulumi.BoolOutput `pulumi:"browserExtensions"`
+	// Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+	ErrorMessages pulumi.StringArrayOutput `pulumi:"errorMessages"`
+	// Filter out events from these IP addresses or CIDR ranges.
+	IpAddresses pulumi.StringArrayOutput `pulumi:"ipAddresses"`
+	// Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+	LegacyBrowsers pulumi.StringArrayOutput `pulumi:"legacyBrowsers"`
+	// Filter out events coming from localhost.
+	Localhost        pulumi.BoolOutput   `pulumi:"localhost"`
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	ProjectSlug      pulumi.StringOutput `pulumi:"projectSlug"`
+	// Filter out events from releases matching one of these patterns, e.g. *-dev.
+	Releases pulumi.StringArrayOutput `pulumi:"releases"`
+	// Filter out events from known web crawlers.
+	WebCrawlers pulumi.BoolOutput `pulumi:"webCrawlers"`
+}
+
+// NewProjectInboundFilters registers a new resource with the given unique name, arguments, and options.
+func NewProjectInboundFilters(ctx *pulumi.Context,
+	name string, args *ProjectInboundFiltersArgs, opts ...pulumi.ResourceOption) (*ProjectInboundFilters, error) {
+	if args == nil || args.ProjectSlug == nil {
+		return nil, errors.New("missing required argument 'ProjectSlug'")
+	}
+	if args == nil {
+		args = &ProjectInboundFiltersArgs{}
+	}
+	var resource ProjectInboundFilters
+	err := ctx.RegisterResource("sentry:index:ProjectInboundFilters", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetProjectInboundFilters gets an existing ProjectInboundFilters resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetProjectInboundFilters(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ProjectInboundFiltersState, opts ...pulumi.ResourceOption) (*ProjectInboundFilters, error) {
+	var resource ProjectInboundFilters
+	err := ctx.ReadResource("sentry:index:ProjectInboundFilters", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ProjectInboundFilters resources.
+type projectInboundFiltersState struct {
+	// Filter out errors caused by known browser extensions.
+	BrowserExtensions *bool `pulumi:"browserExtensions"`
+	// Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+	ErrorMessages []string `pulumi:"errorMessages"`
+	// Filter out events from these IP addresses or CIDR ranges.
+	IpAddresses []string `pulumi:"ipAddresses"`
+	// Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+	LegacyBrowsers []string `pulumi:"legacyBrowsers"`
+	// Filter out events coming from localhost.
+	Localhost        *bool   `pulumi:"localhost"`
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      *string `pulumi:"projectSlug"`
+	// Filter out events from releases matching one of these patterns, e.g. *-dev.
+	Releases []string `pulumi:"releases"`
+	// Filter out events from known web crawlers.
+	WebCrawlers *bool `pulumi:"webCrawlers"`
+}
+
+type ProjectInboundFiltersState struct {
+	// Filter out errors caused by known browser extensions.
+	BrowserExtensions pulumi.BoolPtrInput
+	// Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+	ErrorMessages pulumi.StringArrayInput
+	// Filter out events from these IP addresses or CIDR ranges.
+	IpAddresses pulumi.StringArrayInput
+	// Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+	LegacyBrowsers pulumi.StringArrayInput
+	// Filter out events coming from localhost.
+	Localhost        pulumi.BoolPtrInput
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringPtrInput
+	// Filter out events from releases matching one of these patterns, e.g. *-dev.
+	Releases pulumi.StringArrayInput
+	// Filter out events from known web crawlers.
+	WebCrawlers pulumi.BoolPtrInput
+}
+
+func (ProjectInboundFiltersState) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectInboundFiltersState)(nil)).Elem()
+}
+
+type projectInboundFiltersArgs struct {
+	// Filter out errors caused by known browser extensions.
+	BrowserExtensions *bool `pulumi:"browserExtensions"`
+	// Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+	ErrorMessages []string `pulumi:"errorMessages"`
+	// Filter out events from these IP addresses or CIDR ranges.
+	IpAddresses []string `pulumi:"ipAddresses"`
+	// Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+	LegacyBrowsers []string `pulumi:"legacyBrowsers"`
+	// Filter out events coming from localhost.
+	Localhost *bool `pulumi:"localhost"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      string  `pulumi:"projectSlug"`
+	// Filter out events from releases matching one of these patterns, e.g. *-dev.
+	Releases []string `pulumi:"releases"`
+	// Filter out events from known web crawlers.
+	WebCrawlers *bool `pulumi:"webCrawlers"`
+}
+
+// The set of arguments for constructing a ProjectInboundFilters resource.
+type ProjectInboundFiltersArgs struct {
+	// Filter out errors caused by known browser extensions.
+	BrowserExtensions pulumi.BoolPtrInput
+	// Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+	ErrorMessages pulumi.StringArrayInput
+	// Filter out events from these IP addresses or CIDR ranges.
+	IpAddresses pulumi.StringArrayInput
+	// Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+	LegacyBrowsers pulumi.StringArrayInput
+	// Filter out events coming from localhost.
+	Localhost pulumi.BoolPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringInput
+	// Filter out events from releases matching one of these patterns, e.g. *-dev.
+	Releases pulumi.StringArrayInput
+	// Filter out events from known web crawlers.
+	WebCrawlers pulumi.BoolPtrInput
+}
+
+func (ProjectInboundFiltersArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectInboundFiltersArgs)(nil)).Elem()
+}
+
+type ProjectInboundFiltersInput interface {
+	pulumi.Input
+
+	ToProjectInboundFiltersOutput() ProjectInboundFiltersOutput
+	ToProjectInboundFiltersOutputWithContext(ctx context.Context) ProjectInboundFiltersOutput
+}
+
+func (ProjectInboundFilters) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectInboundFilters)(nil)).Elem()
+}
+
+func (i ProjectInboundFilters) ToProjectInboundFiltersOutput() ProjectInboundFiltersOutput {
+	return i.ToProjectInboundFiltersOutputWithContext(context.Background())
+}
+
+func (i ProjectInboundFilters) ToProjectInboundFiltersOutputWithContext(ctx context.Context) ProjectInboundFiltersOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectInboundFiltersOutput)
+}
+
+type ProjectInboundFiltersOutput struct {
+	*pulumi.OutputState
+}
+
+func (ProjectInboundFiltersOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectInboundFiltersOutput)(nil)).Elem()
+}
+
+func (o ProjectInboundFiltersOutput) ToProjectInboundFiltersOutput() ProjectInboundFiltersOutput {
+	return o
+}
+
+func (o ProjectInboundFiltersOutput) ToProjectInboundFiltersOutputWithContext(ctx context.Context) ProjectInboundFiltersOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ProjectInboundFiltersOutput{})
+}
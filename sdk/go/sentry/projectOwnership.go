@@ -0,0 +1,156 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// The ownership rules of a project, used to route alerts and assign issues. Deleting the resource removes all the rules.
+type ProjectOwnership struct {
+	pulumi.CustomResourceState
+
+	// Assign new issues to their owners automatically. Defaults to false.
+	AutoAssignment pulumi.BoolOutput `pulumi:"autoAssignment"`
+	// Send alerts to all project members when no rule matches an issue. Defaults to true.
+	Fallthrough pulumi.BoolOutput `pulumi:"fallthrough"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	ProjectSlug      pulumi.StringOutput `pulumi:"projectSlug"`
+	// The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+	Raw pulumi.StringOutput `pulumi:"raw"`
+}
+
+// NewProjectOwnership registers a new resource with the given unique name, arguments, and options.
+func NewProjectOwnership(ctx *pulumi.Context,
+	name string, args *ProjectOwnershipArgs, opts ...pulumi.ResourceOption) (*ProjectOwnership, error) {
+	if args == nil || args.ProjectSlug == nil {
+		return nil, errors.New("missing required argument 'ProjectSlug'")
+	}
+	if args == nil || args.Raw == nil {
+		return nil, errors.New("missing required argument 'Raw'")
+	}
+	if args == nil {
+		args = &ProjectOwnershipArgs{}
+	}
+	var resource ProjectOwnership
+	err := ctx.RegisterResource("sentry:index:ProjectOwnership", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetProjectOwnership gets an existing ProjectOwnership resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetProjectOwnership(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ProjectOwnershipState, opts ...pulumi.ResourceOption) (*ProjectOwnership, error) {
+	var resource ProjectOwnership
+	err := ctx.ReadResource("sentry:index:ProjectOwnership", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ProjectOwnership resources.
+type projectOwnershipState struct {
+	// Assign new issues to their owners automatically. Defaults to false.
+	AutoAssignment *bool `pulumi:"autoAssignment"`
+	// Send alerts to all project members when no rule matches an issue. Defaults to true.
+	Fallthrough *bool `pulumi:"fallthrough"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      *string `pulumi:"projectSlug"`
+	// The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+	Raw *string `pulumi:"raw"`
+}
+
+type ProjectOwnershipState struct {
+	// Assign new issues to their owners automatically. Defaults to false.
+	AutoAssignment pulumi.BoolPtrInput
+	// Send alerts to all project members when no rule matches an issue. Defaults to true.
+	Fallthrough pulumi.BoolPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringPtrInput
+	// The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+	Raw pulumi.StringPtrInput
+}
+
+func (ProjectOwnershipState) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectOwnershipState)(nil)).Elem()
+}
+
+type projectOwnershipArgs struct {
+	// Assign new issues to their owners automatically. Defaults to false.
+	AutoAssignment *bool `pulumi:"autoAssignment"`
+	// Send alerts to all project members when no rule matches an issue. Defaults to true.
+	Fallthrough *bool `pulumi:"fallthrough"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      string  `pulumi:"projectSlug"`
+	// The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+	Raw string `pulumi:"raw"`
+}
+
+// The set of arguments for constructing a ProjectOwnership resource.
+type ProjectOwnershipArgs struct {
+	// Assign new issues to their owners automatically. Defaults to false.
+	AutoAssignment pulumi.BoolPtrInput
+	// Send alerts to all project members when no rule matches an issue. Defaults to true.
+	Fallthrough pulumi.BoolPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringInput
+	// The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+	Raw pulumi.StringInput
+}
+
+func (ProjectOwnershipArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectOwnershipArgs)(nil)).Elem()
+}
+
+type ProjectOwnershipInput interface {
+	pulumi.Input
+
+	ToProjectOwnershipOutput() ProjectOwnershipOutput
+	ToProjectOwnershipOutputWithContext(ctx context.Context) ProjectOwnershipOutput
+}
+
+func (ProjectOwnership) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectOwnership)(nil)).Elem()
+}
+
+func (i ProjectOwnership) ToProjectOwnershipOutput() ProjectOwnershipOutput {
+	return i.ToProjectOwnershipOutputWithContext(context.Background())
+}
+
+func (i ProjectOwnership) ToProjectOwnershipOutputWithContext(ctx context.Context) ProjectOwnershipOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectOwnershipOutput)
+}
+
+type ProjectOwnershipOutput struct {
+	*pulumi.OutputState
+}
+
+func (ProjectOwnershipOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectOwnershipOutput)(nil)).Elem()
+}
+
+func (o ProjectOwnershipOutput) ToProjectOwnershipOutput() ProjectOwnershipOutput {
+	return o
+}
+
+func (o ProjectOwnershipOutput) ToProjectOwnershipOutputWithContext(ctx context.Context) ProjectOwnershipOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ProjectOwnershipOutput{})
+}
@@ -0,0 +1,841 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A chart, table or number of a dashboard.
+type DashboardWidget struct {
+	// line, area, stacked_area, bar, table, big_number, top_n or world_map.
+	DisplayType string `pulumi:"displayType"`
+	// Assigned by Sentry.
+	Id      *string                `pulumi:"id"`
+	Layout  *DashboardWidgetLayout `pulumi:"layout"`
+	Queries []DashboardWidgetQuery `pulumi:"queries"`
+	Title   string                 `pulumi:"title"`
+}
+
+// DashboardWidgetInput is an input type that accepts DashboardWidgetArgs and DashboardWidgetOutput values.
+// You can construct a concrete instance of `DashboardWidgetInput` via:
+//
+//	DashboardWidgetArgs{...}
+type DashboardWidgetInput interface {
+	pulumi.Input
+
+	ToDashboardWidgetOutput() DashboardWidgetOutput
+	ToDashboardWidgetOutputWithContext(context.Context) DashboardWidgetOutput
+}
+
+// A chart, table or number of a dashboard.
+type DashboardWidgetArgs struct {
+	// line, area, stacked_area, bar, table, big_number, top_n or world_map.
+	DisplayType pulumi.StringInput `pulumi:"displayType"`
+	// Assigned by Sentry.
+	Id      pulumi.StringPtrInput          `pulumi:"id"`
+	Layout  DashboardWidgetLayoutPtrInput  `pulumi:"layout"`
+	Queries DashboardWidgetQueryArrayInput `pulumi:"queries"`
+	Title   pulumi.StringInput             `pulumi:"title"`
+}
+
+func (DashboardWidgetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DashboardWidget)(nil)).Elem()
+}
+
+func (i DashboardWidgetArgs) ToDashboardWidgetOutput() DashboardWidgetOutput {
+	return i.ToDashboardWidgetOutputWithContext(context.Background())
+}
+
+func (i DashboardWidgetArgs) ToDashboardWidgetOutputWithContext(ctx context.Context) DashboardWidgetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DashboardWidgetOutput)
+}
+
+// DashboardWidgetArrayInput is an input type that accepts DashboardWidgetArray and DashboardWidgetArrayOutput values.
+// You can construct a concrete instance of `DashboardWidgetArrayInput` via:
+//
+//	DashboardWidgetArray{ DashboardWidgetArgs{...} }
+type DashboardWidgetArrayInput interface {
+	pulumi.Input
+
+	ToDashboardWidgetArrayOutput() DashboardWidgetArrayOutput
+	ToDashboardWidgetArrayOutputWithContext(context.Context) DashboardWidgetArrayOutput
+}
+
+type DashboardWidgetArray []DashboardWidgetInput
+
+func (DashboardWidgetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DashboardWidget)(nil)).Elem()
+}
+
+func (i DashboardWidgetArray) ToDashboardWidgetArrayOutput() DashboardWidgetArrayOutput {
+	return i.ToDashboardWidgetArrayOutputWithContext(context.Background())
+}
+
+func (i DashboardWidgetArray) ToDashboardWidgetArrayOutputWithContext(ctx context.Context) DashboardWidgetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DashboardWidgetArrayOutput)
+}
+
+// A chart, table or number of a dashboard.
+type DashboardWidgetOutput struct{ *pulumi.OutputState }
+
+func (DashboardWidgetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DashboardWidget)(nil)).Elem()
+}
+
+func (o DashboardWidgetOutput) ToDashboardWidgetOutput() DashboardWidgetOutput {
+	return o
+}
+
+func (o DashboardWidgetOutput) ToDashboardWidgetOutputWithContext(ctx context.Context) DashboardWidgetOutput {
+	return o
+}
+
+// line, area, stacked_area, bar, table, big_number, top_n or world_map.
+func (o DashboardWidgetOutput) DisplayType() pulumi.StringOutput {
+	return o.ApplyT(func(v DashboardWidget) string { return v.DisplayType }).(pulumi.StringOutput)
+}
+
+// Assigned by Sentry.
+func (o DashboardWidgetOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DashboardWidget) *string { return v.Id }).(pulumi.StringPtrOutput)
+}
+
+func (o DashboardWidgetOutput) Layout() DashboardWidgetLayoutPtrOutput {
+	return o.ApplyT(func(v DashboardWidget) *DashboardWidgetLayout { return v.Layout }).(DashboardWidgetLayoutPtrOutput)
+}
+
+func (o DashboardWidgetOutput) Queries() DashboardWidgetQueryArrayOutput {
+	return o.ApplyT(func(v DashboardWidget) []DashboardWidgetQuery { return v.Queries }).(DashboardWidgetQueryArrayOutput)
+}
+
+func (o DashboardWidgetOutput) Title() pulumi.StringOutput {
+	return o.ApplyT(func(v DashboardWidget) string { return v.Title }).(pulumi.StringOutput)
+}
+
+type DashboardWidgetArrayOutput struct{ *pulumi.OutputState }
+
+func (DashboardWidgetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DashboardWidget)(nil)).Elem()
+}
+
+func (o DashboardWidgetArrayOutput) ToDashboardWidgetArrayOutput() DashboardWidgetArrayOutput {
+	return o
+}
+
+func (o DashboardWidgetArrayOutput) ToDashboardWidgetArrayOutputWithContext(ctx context.Context) DashboardWidgetArrayOutput {
+	return o
+}
+
+func (o DashboardWidgetArrayOutput) Index(i pulumi.IntInput) DashboardWidgetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DashboardWidget {
+		return vs[0].([]DashboardWidget)[vs[1].(int)]
+	}).(DashboardWidgetOutput)
+}
+
+// The position and size of a widget in the grid of a dashboard, in columns and rows.
+type DashboardWidgetLayout struct {
+	H int `pulumi:"h"`
+	W int `pulumi:"w"`
+	X int `pulumi:"x"`
+	Y int `pulumi:"y"`
+}
+
+// DashboardWidgetLayoutInput is an input type that accepts DashboardWidgetLayoutArgs and DashboardWidgetLayoutOutput values.
+// You can construct a concrete instance of `DashboardWidgetLayoutInput` via:
+//
+//	DashboardWidgetLayoutArgs{...}
+type DashboardWidgetLayoutInput interface {
+	pulumi.Input
+
+	ToDashboardWidgetLayoutOutput() DashboardWidgetLayoutOutput
+	ToDashboardWidgetLayoutOutputWithContext(context.Context) DashboardWidgetLayoutOutput
+}
+
+// The position and size of a widget in the grid of a dashboard, in columns and rows.
+type DashboardWidgetLayoutArgs struct {
+	H pulumi.IntInput `pulumi:"h"`
+	W pulumi.IntInput `pulumi:"w"`
+	X pulumi.IntInput `pulumi:"x"`
+	Y pulumi.IntInput `pulumi:"y"`
+}
+
+func (DashboardWidgetLayoutArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DashboardWidgetLayout)(nil)).Elem()
+}
+
+func (i DashboardWidgetLayoutArgs) ToDashboardWidgetLayoutOutput() DashboardWidgetLayoutOutput {
+	return i.ToDashboardWidgetLayoutOutputWithContext(context.Background())
+}
+
+func (i DashboardWidgetLayoutArgs) ToDashboardWidgetLayoutOutputWithContext(ctx context.Context) DashboardWidgetLayoutOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DashboardWidgetLayoutOutput)
+}
+
+func (i DashboardWidgetLayoutArgs) ToDashboardWidgetLayoutPtrOutput() DashboardWidgetLayoutPtrOutput {
+	return i.ToDashboardWidgetLayoutPtrOutputWithContext(context.Background())
+}
+
+func (i DashboardWidgetLayoutArgs) ToDashboardWidgetLayoutPtrOutputWithContext(ctx context.Context) DashboardWidgetLayoutPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DashboardWidgetLayoutOutput).ToDashboardWidgetLayoutPtrOutputWithContext(ctx)
+}
+
+// DashboardWidgetLayoutPtrInput is an input type that accepts DashboardWidgetLayoutArgs, DashboardWidgetLayoutPtr and DashboardWidgetLayoutPtrOutput values.
+// You can construct a concrete instance of `DashboardWidgetLayoutPtrInput` via:
+//
+//	        DashboardWidgetLayoutArgs{...}
+//
+//	or:
+//
+//	        nil
+type DashboardWidgetLayoutPtrInput interface {
+	pulumi.Input
+
+	ToDashboardWidgetLayoutPtrOutput() DashboardWidgetLayoutPtrOutput
+	ToDashboardWidgetLayoutPtrOutputWithContext(context.Context) DashboardWidgetLayoutPtrOutput
+}
+
+type dashboardWidgetLayoutPtrType DashboardWidgetLayoutArgs
+
+func DashboardWidgetLayoutPtr(v *DashboardWidgetLayoutArgs) DashboardWidgetLayoutPtrInput {
+	return (*dashboardWidgetLayoutPtrType)(v)
+}
+
+func (*dashboardWidgetLayoutPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**DashboardWidgetLayout)(nil)).Elem()
+}
+
+func (i *dashboardWidgetLayoutPtrType) ToDashboardWidgetLayoutPtrOutput() DashboardWidgetLayoutPtrOutput {
+	return i.ToDashboardWidgetLayoutPtrOutputWithContext(context.Background())
+}
+
+func (i *dashboardWidgetLayoutPtrType) ToDashboardWidgetLayoutPtrOutputWithContext(ctx context.Context) DashboardWidgetLayoutPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DashboardWidgetLayoutPtrOutput)
+}
+
+// The position and size of a widget in the grid of a dashboard, in columns and rows.
+type DashboardWidgetLayoutOutput struct{ *pulumi.OutputState }
+
+func (DashboardWidgetLayoutOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DashboardWidgetLayout)(nil)).Elem()
+}
+
+func (o DashboardWidgetLayoutOutput) ToDashboardWidgetLayoutOutput() DashboardWidgetLayoutOutput {
+	return o
+}
+
+func (o DashboardWidgetLayoutOutput) ToDashboardWidgetLayoutOutputWithContext(ctx context.Context) DashboardWidgetLayoutOutput {
+	return o
+}
+
+func (o DashboardWidgetLayoutOutput) ToDashboardWidgetLayoutPtrOutput() DashboardWidgetLayoutPtrOutput {
+	return o.ToDashboardWidgetLayoutPtrOutputWithContext(context.Background())
+}
+
+func (o DashboardWidgetLayoutOutput) ToDashboardWidgetLayoutPtrOutputWithContext(ctx context.Context) DashboardWidgetLayoutPtrOutput {
+	return o.ApplyT(func(v DashboardWidgetLayout) *DashboardWidgetLayout {
+		return &v
+	}).(DashboardWidgetLayoutPtrOutput)
+}
+func (o DashboardWidgetLayoutOutput) H() pulumi.IntOutput {
+	return o.ApplyT(func(v DashboardWidgetLayout) int { return v.H }).(pulumi.IntOutput)
+}
+
+func (o DashboardWidgetLayoutOutput) W() pulumi.IntOutput {
+	return o.ApplyT(func(v DashboardWidgetLayout) int { return v.W }).(pulumi.IntOutput)
+}
+
+func (o DashboardWidgetLayoutOutput) X() pulumi.IntOutput {
+	return o.ApplyT(func(v DashboardWidgetLayout) int { return v.X }).(pulumi.IntOutput)
+}
+
+func (o DashboardWidgetLayoutOutput) Y() pulumi.IntOutput {
+	return o.ApplyT(func(v DashboardWidgetLayout) int { return v.Y }).(pulumi.IntOutput)
+}
+
+type DashboardWidgetLayoutPtrOutput struct{ *pulumi.OutputState }
+
+func (DashboardWidgetLayoutPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DashboardWidgetLayout)(nil)).Elem()
+}
+
+func (o DashboardWidgetLayoutPtrOutput) ToDashboardWidgetLayoutPtrOutput() DashboardWidgetLayoutPtrOutput {
+	return o
+}
+
+func (o DashboardWidgetLayoutPtrOutput) ToDashboardWidgetLayoutPtrOutputWithContext(ctx context.Context) DashboardWidgetLayoutPtrOutput {
+	return o
+}
+
+func (o DashboardWidgetLayoutPtrOutput) Elem() DashboardWidgetLayoutOutput {
+	return o.ApplyT(func(v *DashboardWidgetLayout) DashboardWidgetLayout { return *v }).(DashboardWidgetLayoutOutput)
+}
+
+func (o DashboardWidgetLayoutPtrOutput) H() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DashboardWidgetLayout) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.H
+	}).(pulumi.IntPtrOutput)
+}
+
+func (o DashboardWidgetLayoutPtrOutput) W() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DashboardWidgetLayout) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.W
+	}).(pulumi.IntPtrOutput)
+}
+
+func (o DashboardWidgetLayoutPtrOutput) X() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DashboardWidgetLayout) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.X
+	}).(pulumi.IntPtrOutput)
+}
+
+func (o DashboardWidgetLayoutPtrOutput) Y() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DashboardWidgetLayout) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.Y
+	}).(pulumi.IntPtrOutput)
+}
+
+// A query of events drawn by a widget of a dashboard.
+type DashboardWidgetQuery struct {
+	// The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+	Conditions *string `pulumi:"conditions"`
+	// The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+	Fields []string `pulumi:"fields"`
+	// Assigned by Sentry.
+	Id *string `pulumi:"id"`
+	// The legend of the query.
+	Name *string `pulumi:"name"`
+	// The field to sort by, prefixed with - for descending order.
+	Orderby *string `pulumi:"orderby"`
+}
+
+// DashboardWidgetQueryInput is an input type that accepts DashboardWidgetQueryArgs and DashboardWidgetQueryOutput values.
+// You can construct a concrete instance of `DashboardWidgetQueryInput` via:
+//
+//	DashboardWidgetQueryArgs{...}
+type DashboardWidgetQueryInput interface {
+	pulumi.Input
+
+	ToDashboardWidgetQueryOutput() DashboardWidgetQueryOutput
+	ToDashboardWidgetQueryOutputWithContext(context.Context) DashboardWidgetQueryOutput
+}
+
+// A query of events drawn by a widget of a dashboard.
+type DashboardWidgetQueryArgs struct {
+	// The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+	Conditions pulumi.StringPtrInput `pulumi:"conditions"`
+	// The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+	Fields pulumi.StringArrayInput `pulumi:"fields"`
+	// Assigned by Sentry.
+	Id pulumi.StringPtrInput `pulumi:"id"`
+	// The legend of the query.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// The field to sort by, prefixed with - for descending order.
+	Orderby pulumi.StringPtrInput `pulumi:"orderby"`
+}
+
+func (DashboardWidgetQueryArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DashboardWidgetQuery)(nil)).Elem()
+}
+
+func (i DashboardWidgetQueryArgs) ToDashboardWidgetQueryOutput() DashboardWidgetQueryOutput {
+	return i.ToDashboardWidgetQueryOutputWithContext(context.Background())
+}
+
+func (i DashboardWidgetQueryArgs) ToDashboardWidgetQueryOutputWithContext(ctx context.Context) DashboardWidgetQueryOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DashboardWidgetQueryOutput)
+}
+
+// DashboardWidgetQueryArrayInput is an input type that accepts DashboardWidgetQueryArray and DashboardWidgetQueryArrayOutput values.
+// You can construct a concrete instance of `DashboardWidgetQueryArrayInput` via:
+//
+//	DashboardWidgetQueryArray{ DashboardWidgetQueryArgs{...} }
+type DashboardWidgetQueryArrayInput interface {
+	pulumi.Input
+
+	ToDashboardWidgetQueryArrayOutput() DashboardWidgetQueryArrayOutput
+	ToDashboardWidgetQueryArrayOutputWithContext(context.Context) DashboardWidgetQueryArrayOutput
+}
+
+type DashboardWidgetQueryArray []DashboardWidgetQueryInput
+
+func (DashboardWidgetQueryArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DashboardWidgetQuery)(nil)).Elem()
+}
+
+func (i DashboardWidgetQueryArray) ToDashboardWidgetQueryArrayOutput() DashboardWidgetQueryArrayOutput {
+	return i.ToDashboardWidgetQueryArrayOutputWithContext(context.Background())
+}
+
+func (i DashboardWidgetQueryArray) ToDashboardWidgetQueryArrayOutputWithContext(ctx context.Context) DashboardWidgetQueryArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DashboardWidgetQueryArrayOutput)
+}
+
+// A query of events drawn by a widget of a dashboard.
+type DashboardWidgetQueryOutput struct{ *pulumi.OutputState }
+
+func (DashboardWidgetQueryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DashboardWidgetQuery)(nil)).Elem()
+}
+
+func (o DashboardWidgetQueryOutput) ToDashboardWidgetQueryOutput() DashboardWidgetQueryOutput {
+	return o
+}
+
+func (o DashboardWidgetQueryOutput) ToDashboardWidgetQueryOutputWithContext(ctx context.Context) DashboardWidgetQueryOutput {
+	return o
+}
+
+// The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+func (o DashboardWidgetQueryOutput) Conditions() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DashboardWidgetQuery) *string { return v.Conditions }).(pulumi.StringPtrOutput)
+}
+
+// The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+func (o DashboardWidgetQueryOutput) Fields() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v DashboardWidgetQuery) []string { return v.Fields }).(pulumi.StringArrayOutput)
+}
+
+// Assigned by Sentry.
+func (o DashboardWidgetQueryOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DashboardWidgetQuery) *string { return v.Id }).(pulumi.StringPtrOutput)
+}
+
+// The legend of the query.
+func (o DashboardWidgetQueryOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DashboardWidgetQuery) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// The field to sort by, prefixed with - for descending order.
+func (o DashboardWidgetQueryOutput) Orderby() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DashboardWidgetQuery) *string { return v.Orderby }).(pulumi.StringPtrOutput)
+}
+
+type DashboardWidgetQueryArrayOutput struct{ *pulumi.OutputState }
+
+func (DashboardWidgetQueryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DashboardWidgetQuery)(nil)).Elem()
+}
+
+func (o DashboardWidgetQueryArrayOutput) ToDashboardWidgetQueryArrayOutput() DashboardWidgetQueryArrayOutput {
+	return o
+}
+
+func (o DashboardWidgetQueryArrayOutput) ToDashboardWidgetQueryArrayOutputWithContext(ctx context.Context) DashboardWidgetQueryArrayOutput {
+	return o
+}
+
+func (o DashboardWidgetQueryArrayOutput) Index(i pulumi.IntInput) DashboardWidgetQueryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DashboardWidgetQuery {
+		return vs[0].([]DashboardWidgetQuery)[vs[1].(int)]
+	}).(DashboardWidgetQueryOutput)
+}
+
+// A threshold of a metric alert rule, with the actions run when it's crossed.
+type MetricAlertRuleTrigger struct {
+	Actions []MetricAlertRuleTriggerAction `pulumi:"actions"`
+	// The value of the metric firing the trigger.
+	AlertThreshold float64 `pulumi:"alertThreshold"`
+	// Assigned by Sentry.
+	Id *string `pulumi:"id"`
+	// critical or warning.
+	Label string `pulumi:"label"`
+}
+
+// MetricAlertRuleTriggerInput is an input type that accepts MetricAlertRuleTriggerArgs and MetricAlertRuleTriggerOutput values.
+// You can construct a concrete instance of `MetricAlertRuleTriggerInput` via:
+//
+//	MetricAlertRuleTriggerArgs{...}
+type MetricAlertRuleTriggerInput interface {
+	pulumi.Input
+
+	ToMetricAlertRuleTriggerOutput() MetricAlertRuleTriggerOutput
+	ToMetricAlertRuleTriggerOutputWithContext(context.Context) MetricAlertRuleTriggerOutput
+}
+
+// A threshold of a metric alert rule, with the actions run when it's crossed.
+type MetricAlertRuleTriggerArgs struct {
+	Actions MetricAlertRuleTriggerActionArrayInput `pulumi:"actions"`
+	// The value of the metric firing the trigger.
+	AlertThreshold pulumi.Float64Input `pulumi:"alertThreshold"`
+	// Assigned by Sentry.
+	Id pulumi.StringPtrInput `pulumi:"id"`
+	// critical or warning.
+	Label pulumi.StringInput `pulumi:"label"`
+}
+
+func (MetricAlertRuleTriggerArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetricAlertRuleTrigger)(nil)).Elem()
+}
+
+func (i MetricAlertRuleTriggerArgs) ToMetricAlertRuleTriggerOutput() MetricAlertRuleTriggerOutput {
+	return i.ToMetricAlertRuleTriggerOutputWithContext(context.Background())
+}
+
+func (i MetricAlertRuleTriggerArgs) ToMetricAlertRuleTriggerOutputWithContext(ctx context.Context) MetricAlertRuleTriggerOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetricAlertRuleTriggerOutput)
+}
+
+// MetricAlertRuleTriggerArrayInput is an input type that accepts MetricAlertRuleTriggerArray and MetricAlertRuleTriggerArrayOutput values.
+// You can construct a concrete instance of `MetricAlertRuleTriggerArrayInput` via:
+//
+//	MetricAlertRuleTriggerArray{ MetricAlertRuleTriggerArgs{...} }
+type MetricAlertRuleTriggerArrayInput interface {
+	pulumi.Input
+
+	ToMetricAlertRuleTriggerArrayOutput() MetricAlertRuleTriggerArrayOutput
+	ToMetricAlertRuleTriggerArrayOutputWithContext(context.Context) MetricAlertRuleTriggerArrayOutput
+}
+
+type MetricAlertRuleTriggerArray []MetricAlertRuleTriggerInput
+
+func (MetricAlertRuleTriggerArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MetricAlertRuleTrigger)(nil)).Elem()
+}
+
+func (i MetricAlertRuleTriggerArray) ToMetricAlertRuleTriggerArrayOutput() MetricAlertRuleTriggerArrayOutput {
+	return i.ToMetricAlertRuleTriggerArrayOutputWithContext(context.Background())
+}
+
+func (i MetricAlertRuleTriggerArray) ToMetricAlertRuleTriggerArrayOutputWithContext(ctx context.Context) MetricAlertRuleTriggerArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetricAlertRuleTriggerArrayOutput)
+}
+
+// A threshold of a metric alert rule, with the actions run when it's crossed.
+type MetricAlertRuleTriggerOutput struct{ *pulumi.OutputState }
+
+func (MetricAlertRuleTriggerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetricAlertRuleTrigger)(nil)).Elem()
+}
+
+func (o MetricAlertRuleTriggerOutput) ToMetricAlertRuleTriggerOutput() MetricAlertRuleTriggerOutput {
+	return o
+}
+
+func (o MetricAlertRuleTriggerOutput) ToMetricAlertRuleTriggerOutputWithContext(ctx context.Context) MetricAlertRuleTriggerOutput {
+	return o
+}
+
+func (o MetricAlertRuleTriggerOutput) Actions() MetricAlertRuleTriggerActionArrayOutput {
+	return o.ApplyT(func(v MetricAlertRuleTrigger) []MetricAlertRuleTriggerAction { return v.Actions }).(MetricAlertRuleTriggerActionArrayOutput)
+}
+
+// The value of the metric firing the trigger.
+func (o MetricAlertRuleTriggerOutput) AlertThreshold() pulumi.Float64Output {
+	return o.ApplyT(func(v MetricAlertRuleTrigger) float64 { return v.AlertThreshold }).(pulumi.Float64Output)
+}
+
+// Assigned by Sentry.
+func (o MetricAlertRuleTriggerOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v MetricAlertRuleTrigger) *string { return v.Id }).(pulumi.StringPtrOutput)
+}
+
+// critical or warning.
+func (o MetricAlertRuleTriggerOutput) Label() pulumi.StringOutput {
+	return o.ApplyT(func(v MetricAlertRuleTrigger) string { return v.Label }).(pulumi.StringOutput)
+}
+
+type MetricAlertRuleTriggerArrayOutput struct{ *pulumi.OutputState }
+
+func (MetricAlertRuleTriggerArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MetricAlertRuleTrigger)(nil)).Elem()
+}
+
+func (o MetricAlertRuleTriggerArrayOutput) ToMetricAlertRuleTriggerArrayOutput() MetricAlertRuleTriggerArrayOutput {
+	return o
+}
+
+func (o MetricAlertRuleTriggerArrayOutput) ToMetricAlertRuleTriggerArrayOutputWithContext(ctx context.Context) MetricAlertRuleTriggerArrayOutput {
+	return o
+}
+
+func (o MetricAlertRuleTriggerArrayOutput) Index(i pulumi.IntInput) MetricAlertRuleTriggerOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) MetricAlertRuleTrigger {
+		return vs[0].([]MetricAlertRuleTrigger)[vs[1].(int)]
+	}).(MetricAlertRuleTriggerOutput)
+}
+
+// An action run when a trigger of a metric alert rule fires.
+type MetricAlertRuleTriggerAction struct {
+	// Assigned by Sentry.
+	Id *string `pulumi:"id"`
+	// The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+	IntegrationId *int `pulumi:"integrationId"`
+	// The user or team ID, or the channel name for specific targets.
+	TargetIdentifier *string `pulumi:"targetIdentifier"`
+	// user, team, specific or sentry_app.
+	TargetType string `pulumi:"targetType"`
+	// email, slack, pagerduty, msteams or sentry_app.
+	Type string `pulumi:"type"`
+}
+
+// MetricAlertRuleTriggerActionInput is an input type that accepts MetricAlertRuleTriggerActionArgs and MetricAlertRuleTriggerActionOutput values.
+// You can construct a concrete instance of `MetricAlertRuleTriggerActionInput` via:
+//
+//	MetricAlertRuleTriggerActionArgs{...}
+type MetricAlertRuleTriggerActionInput interface {
+	pulumi.Input
+
+	ToMetricAlertRuleTriggerActionOutput() MetricAlertRuleTriggerActionOutput
+	ToMetricAlertRuleTriggerActionOutputWithContext(context.Context) MetricAlertRuleTriggerActionOutput
+}
+
+// An action run when a trigger of a metric alert rule fires.
+type MetricAlertRuleTriggerActionArgs struct {
+	// Assigned by Sentry.
+	Id pulumi.StringPtrInput `pulumi:"id"`
+	// The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+	IntegrationId pulumi.IntPtrInput `pulumi:"integrationId"`
+	// The user or team ID, or the channel name for specific targets.
+	TargetIdentifier pulumi.StringPtrInput `pulumi:"targetIdentifier"`
+	// user, team, specific or sentry_app.
+	TargetType pulumi.StringInput `pulumi:"targetType"`
+	// email, slack, pagerduty, msteams or sentry_app.
+	Type pulumi.StringInput `pulumi:"type"`
+}
+
+func (MetricAlertRuleTriggerActionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetricAlertRuleTriggerAction)(nil)).Elem()
+}
+
+func (i MetricAlertRuleTriggerActionArgs) ToMetricAlertRuleTriggerActionOutput() MetricAlertRuleTriggerActionOutput {
+	return i.ToMetricAlertRuleTriggerActionOutputWithContext(context.Background())
+}
+
+func (i MetricAlertRuleTriggerActionArgs) ToMetricAlertRuleTriggerActionOutputWithContext(ctx context.Context) MetricAlertRuleTriggerActionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetricAlertRuleTriggerActionOutput)
+}
+
+// MetricAlertRuleTriggerActionArrayInput is an input type that accepts MetricAlertRuleTriggerActionArray and MetricAlertRuleTriggerActionArrayOutput values.
+// You can construct a concrete instance of `MetricAlertRuleTriggerActionArrayInput` via:
+//
+//	MetricAlertRuleTriggerActionArray{ MetricAlertRuleTriggerActionArgs{...} }
+type MetricAlertRuleTriggerActionArrayInput interface {
+	pulumi.Input
+
+	ToMetricAlertRuleTriggerActionArrayOutput() MetricAlertRuleTriggerActionArrayOutput
+	ToMetricAlertRuleTriggerActionArrayOutputWithContext(context.Context) MetricAlertRuleTriggerActionArrayOutput
+}
+
+type MetricAlertRuleTriggerActionArray []MetricAlertRuleTriggerActionInput
+
+func (MetricAlertRuleTriggerActionArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MetricAlertRuleTriggerAction)(nil)).Elem()
+}
+
+func (i MetricAlertRuleTriggerActionArray) ToMetricAlertRuleTriggerActionArrayOutput() MetricAlertRuleTriggerActionArrayOutput {
+	return i.ToMetricAlertRuleTriggerActionArrayOutputWithContext(context.Background())
+}
+
+func (i MetricAlertRuleTriggerActionArray) ToMetricAlertRuleTriggerActionArrayOutputWithContext(ctx context.Context) MetricAlertRuleTriggerActionArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetricAlertRuleTriggerActionArrayOutput)
+}
+
+// An action run when a trigger of a metric alert rule fires.
+type MetricAlertRuleTriggerActionOutput struct{ *pulumi.OutputState }
+
+func (MetricAlertRuleTriggerActionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetricAlertRuleTriggerAction)(nil)).Elem()
+}
+
+func (o MetricAlertRuleTriggerActionOutput) ToMetricAlertRuleTriggerActionOutput() MetricAlertRuleTriggerActionOutput {
+	return o
+}
+
+func (o MetricAlertRuleTriggerActionOutput) ToMetricAlertRuleTriggerActionOutputWithContext(ctx context.Context) MetricAlertRuleTriggerActionOutput {
+	return o
+}
+
+// Assigned by Sentry.
+func (o MetricAlertRuleTriggerActionOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v MetricAlertRuleTriggerAction) *string { return v.Id }).(pulumi.StringPtrOutput)
+}
+
+// The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+func (o MetricAlertRuleTriggerActionOutput) IntegrationId() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v MetricAlertRuleTriggerAction) *int { return v.IntegrationId }).(pulumi.IntPtrOutput)
+}
+
+// The user or team ID, or the channel name for specific targets.
+func (o MetricAlertRuleTriggerActionOutput) TargetIdentifier() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v MetricAlertRuleTriggerAction) *string { return v.TargetIdentifier }).(pulumi.StringPtrOutput)
+}
+
+// user, team, specific or sentry_app.
+func (o MetricAlertRuleTriggerActionOutput) TargetType() pulumi.StringOutput {
+	return o.ApplyT(func(v MetricAlertRuleTriggerAction) string { return v.TargetType }).(pulumi.StringOutput)
+}
+
+// email, slack, pagerduty, msteams or sentry_app.
+func (o MetricAlertRuleTriggerActionOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v MetricAlertRuleTriggerAction) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type MetricAlertRuleTriggerActionArrayOutput struct{ *pulumi.OutputState }
+
+func (MetricAlertRuleTriggerActionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MetricAlertRuleTriggerAction)(nil)).Elem()
+}
+
+func (o MetricAlertRuleTriggerActionArrayOutput) ToMetricAlertRuleTriggerActionArrayOutput() MetricAlertRuleTriggerActionArrayOutput {
+	return o
+}
+
+func (o MetricAlertRuleTriggerActionArrayOutput) ToMetricAlertRuleTriggerActionArrayOutputWithContext(ctx context.Context) MetricAlertRuleTriggerActionArrayOutput {
+	return o
+}
+
+func (o MetricAlertRuleTriggerActionArrayOutput) Index(i pulumi.IntInput) MetricAlertRuleTriggerActionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) MetricAlertRuleTriggerAction {
+		return vs[0].([]MetricAlertRuleTriggerAction)[vs[1].(int)]
+	}).(MetricAlertRuleTriggerActionOutput)
+}
+
+// A commit of a repository of the organization in a release.
+type ReleaseRef struct {
+	// The SHA of the last commit of the release.
+	Commit string `pulumi:"commit"`
+	// The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+	PreviousCommit *string `pulumi:"previousCommit"`
+	// The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+	Repository string `pulumi:"repository"`
+}
+
+// ReleaseRefInput is an input type that accepts ReleaseRefArgs and ReleaseRefOutput values.
+// You can construct a concrete instance of `ReleaseRefInput` via:
+//
+//	ReleaseRefArgs{...}
+type ReleaseRefInput interface {
+	pulumi.Input
+
+	ToReleaseRefOutput() ReleaseRefOutput
+	ToReleaseRefOutputWithContext(context.Context) ReleaseRefOutput
+}
+
+// A commit of a repository of the organization in a release.
+type ReleaseRefArgs struct {
+	// The SHA of the last commit of the release.
+	Commit pulumi.StringInput `pulumi:"commit"`
+	// The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+	PreviousCommit pulumi.StringPtrInput `pulumi:"previousCommit"`
+	// The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+	Repository pulumi.StringInput `pulumi:"repository"`
+}
+
+func (ReleaseRefArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReleaseRef)(nil)).Elem()
+}
+
+func (i ReleaseRefArgs) ToReleaseRefOutput() ReleaseRefOutput {
+	return i.ToReleaseRefOutputWithContext(context.Background())
+}
+
+func (i ReleaseRefArgs) ToReleaseRefOutputWithContext(ctx context.Context) ReleaseRefOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReleaseRefOutput)
+}
+
+// ReleaseRefArrayInput is an input type that accepts ReleaseRefArray and ReleaseRefArrayOutput values.
+// You can construct a concrete instance of `ReleaseRefArrayInput` via:
+//
+//	ReleaseRefArray{ ReleaseRefArgs{...} }
+type ReleaseRefArrayInput interface {
+	pulumi.Input
+
+	ToReleaseRefArrayOutput() ReleaseRefArrayOutput
+	ToReleaseRefArrayOutputWithContext(context.Context) ReleaseRefArrayOutput
+}
+
+type ReleaseRefArray []ReleaseRefInput
+
+func (ReleaseRefArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReleaseRef)(nil)).Elem()
+}
+
+func (i ReleaseRefArray) ToReleaseRefArrayOutput() ReleaseRefArrayOutput {
+	return i.ToReleaseRefArrayOutputWithContext(context.Background())
+}
+
+func (i ReleaseRefArray) ToReleaseRefArrayOutputWithContext(ctx context.Context) ReleaseRefArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReleaseRefArrayOutput)
+}
+
+// A commit of a repository of the organization in a release.
+type ReleaseRefOutput struct{ *pulumi.OutputState }
+
+func (ReleaseRefOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReleaseRef)(nil)).Elem()
+}
+
+func (o ReleaseRefOutput) ToReleaseRefOutput() ReleaseRefOutput {
+	return o
+}
+
+func (o ReleaseRefOutput) ToReleaseRefOutputWithContext(ctx context.Context) ReleaseRefOutput {
+	return o
+}
+
+// The SHA of the last commit of the release.
+func (o ReleaseRefOutput) Commit() pulumi.StringOutput {
+	return o.ApplyT(func(v ReleaseRef) string { return v.Commit }).(pulumi.StringOutput)
+}
+
+// The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+func (o ReleaseRefOutput) PreviousCommit() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReleaseRef) *string { return v.PreviousCommit }).(pulumi.StringPtrOutput)
+}
+
+// The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+func (o ReleaseRefOutput) Repository() pulumi.StringOutput {
+	return o.ApplyT(func(v ReleaseRef) string { return v.Repository }).(pulumi.StringOutput)
+}
+
+type ReleaseRefArrayOutput struct{ *pulumi.OutputState }
+
+func (ReleaseRefArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReleaseRef)(nil)).Elem()
+}
+
+func (o ReleaseRefArrayOutput) ToReleaseRefArrayOutput() ReleaseRefArrayOutput {
+	return o
+}
+
+func (o ReleaseRefArrayOutput) ToReleaseRefArrayOutputWithContext(ctx context.Context) ReleaseRefArrayOutput {
+	return o
+}
+
+func (o ReleaseRefArrayOutput) Index(i pulumi.IntInput) ReleaseRefOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ReleaseRef {
+		return vs[0].([]ReleaseRef)[vs[1].(int)]
+	}).(ReleaseRefOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(DashboardWidgetOutput{})
+	pulumi.RegisterOutputType(DashboardWidgetArrayOutput{})
+	pulumi.RegisterOutputType(DashboardWidgetLayoutOutput{})
+	pulumi.RegisterOutputType(DashboardWidgetLayoutPtrOutput{})
+	pulumi.RegisterOutputType(DashboardWidgetQueryOutput{})
+	pulumi.RegisterOutputType(DashboardWidgetQueryArrayOutput{})
+	pulumi.RegisterOutputType(MetricAlertRuleTriggerOutput{})
+	pulumi.RegisterOutputType(MetricAlertRuleTriggerArrayOutput{})
+	pulumi.RegisterOutputType(MetricAlertRuleTriggerActionOutput{})
+	pulumi.RegisterOutputType(MetricAlertRuleTriggerActionArrayOutput{})
+	pulumi.RegisterOutputType(ReleaseRefOutput{})
+	pulumi.RegisterOutputType(ReleaseRefArrayOutput{})
+}
@@ -0,0 +1,182 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A version of the code deployed in projects of an organization. Sentry refuses to delete releases which have events.
+type Release struct {
+	pulumi.CustomResourceState
+
+	// When the release was created, in RFC 3339 format.
+	DateCreated pulumi.StringOutput `pulumi:"dateCreated"`
+	// When the release went live, in RFC 3339 format. Defaults to unreleased.
+	DateReleased pulumi.StringPtrOutput `pulumi:"dateReleased"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput      `pulumi:"organizationSlug"`
+	ProjectSlugs     pulumi.StringArrayOutput `pulumi:"projectSlugs"`
+	// A commit or tag of the release, for display.
+	Ref pulumi.StringPtrOutput `pulumi:"ref"`
+	// The commits of the release, to associate them with it.
+	Refs ReleaseRefArrayOutput `pulumi:"refs"`
+	// A link to the release, e.g. to its build.
+	Url pulumi.StringPtrOutput `pulumi:"url"`
+	// The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+	Version pulumi.StringOutput `pulumi:"version"`
+}
+
+// NewRelease registers a new resource with the given unique name, arguments, and options.
+func NewRelease(ctx *pulumi.Context,
+	name string, args *ReleaseArgs, opts ...pulumi.ResourceOption) (*Release, error) {
+	if args == nil || args.ProjectSlugs == nil {
+		return nil, errors.New("missing required argument 'ProjectSlugs'")
+	}
+	if args == nil || args.Version == nil {
+		return nil, errors.New("missing required argument 'Version'")
+	}
+	if args == nil {
+		args = &ReleaseArgs{}
+	}
+	var resource Release
+	err := ctx.RegisterResource("sentry:index:Release", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetRelease gets an existing Release resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetRelease(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ReleaseState, opts ...pulumi.ResourceOption) (*Release, error) {
+	var resource Release
+	err := ctx.ReadResource("sentry:index:Release", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Release resources.
+type releaseState struct {
+	// When the release was created, in RFC 3339 format.
+	DateCreated *string `pulumi:"dateCreated"`
+	// When the release went live, in RFC 3339 format. Defaults to unreleased.
+	DateReleased *string `pulumi:"dateReleased"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string  `pulumi:"organizationSlug"`
+	ProjectSlugs     []string `pulumi:"projectSlugs"`
+	// A commit or tag of the release, for display.
+	Ref *string `pulumi:"ref"`
+	// The commits of the release, to associate them with it.
+	Refs []ReleaseRef `pulumi:"refs"`
+	// A link to the release, e.g. to its build.
+	Url *string `pulumi:"url"`
+	// The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+	Version *string `pulumi:"version"`
+}
+
+type ReleaseState struct {
+	// When the release was created, in RFC 3339 format.
+	DateCreated pulumi.StringPtrInput
+	// When the release went live, in RFC 3339 format. Defaults to unreleased.
+	DateReleased pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlugs     pulumi.StringArrayInput
+	// A commit or tag of the release, for display.
+	Ref pulumi.StringPtrInput
+	// The commits of the release, to associate them with it.
+	Refs ReleaseRefArrayInput
+	// A link to the release, e.g. to its build.
+	Url pulumi.StringPtrInput
+	// The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+	Version pulumi.StringPtrInput
+}
+
+func (ReleaseState) ElementType() reflect.Type {
+	return reflect.TypeOf((*releaseState)(nil)).Elem()
+}
+
+type releaseArgs struct {
+	// When the release went live, in RFC 3339 format. Defaults to unreleased.
+	DateReleased *string `pulumi:"dateReleased"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string  `pulumi:"organizationSlug"`
+	ProjectSlugs     []string `pulumi:"projectSlugs"`
+	// A commit or tag of the release, for display.
+	Ref *string `pulumi:"ref"`
+	// The commits of the release, to associate them with it.
+	Refs []ReleaseRef `pulumi:"refs"`
+	// A link to the release, e.g. to its build.
+	Url *string `pulumi:"url"`
+	// The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+	Version string `pulumi:"version"`
+}
+
+// The set of arguments for constructing a Release resource.
+type ReleaseArgs struct {
+	// When the release went live, in RFC 3339 format. Defaults to unreleased.
+	DateReleased pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlugs     pulumi.StringArrayInput
+	// A commit or tag of the release, for display.
+	Ref pulumi.StringPtrInput
+	// The commits of the release, to associate them with it.
+	Refs ReleaseRefArrayInput
+	// A link to the release, e.g. to its build.
+	Url pulumi.StringPtrInput
+	// The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+	Version pulumi.StringInput
+}
+
+func (ReleaseArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*releaseArgs)(nil)).Elem()
+}
+
+type ReleaseInput interface {
+	pulumi.Input
+
+	ToReleaseOutput() ReleaseOutput
+	ToReleaseOutputWithContext(ctx context.Context) ReleaseOutput
+}
+
+func (Release) ElementType() reflect.Type {
+	return reflect.TypeOf((*Release)(nil)).Elem()
+}
+
+func (i Release) ToReleaseOutput() ReleaseOutput {
+	return i.ToReleaseOutputWithContext(context.Background())
+}
+
+func (i Release) ToReleaseOutputWithContext(ctx context.Context) ReleaseOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReleaseOutput)
+}
+
+type ReleaseOutput struct {
+	*pulumi.OutputState
+}
+
+func (ReleaseOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReleaseOutput)(nil)).Elem()
+}
+
+func (o ReleaseOutput) ToReleaseOutput() ReleaseOutput {
+	return o
+}
+
+func (o ReleaseOutput) ToReleaseOutputWithContext(ctx context.Context) ReleaseOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ReleaseOutput{})
+}
@@ -0,0 +1,166 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A search of issues shared in an organization.
+type SavedSearch struct {
+	pulumi.CustomResourceState
+
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	// Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+	Pinned pulumi.BoolOutput `pulumi:"pinned"`
+	// The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+	Query pulumi.StringOutput `pulumi:"query"`
+	// The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+	Sort pulumi.StringOutput `pulumi:"sort"`
+	// Who the search is listed for: organization or owner. Defaults to organization.
+	Visibility pulumi.StringOutput `pulumi:"visibility"`
+}
+
+// NewSavedSearch registers a new resource with the given unique name, arguments, and options.
+func NewSavedSearch(ctx *pulumi.Context,
+	name string, args *SavedSearchArgs, opts ...pulumi.ResourceOption) (*SavedSearch, error) {
+	if args == nil || args.Name == nil {
+		return nil, errors.New("missing required argument 'Name'")
+	}
+	if args == nil || args.Query == nil {
+		return nil, errors.New("missing required argument 'Query'")
+	}
+	if args == nil {
+		args = &SavedSearchArgs{}
+	}
+	var resource SavedSearch
+	err := ctx.RegisterResource("sentry:index:SavedSearch", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetSavedSearch gets an existing SavedSearch resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetSavedSearch(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *SavedSearchState, opts ...pulumi.ResourceOption) (*SavedSearch, error) {
+	var resource SavedSearch
+	err := ctx.ReadResource("sentry:index:SavedSearch", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering SavedSearch resources.
+type savedSearchState struct {
+	Name *string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+	Pinned *bool `pulumi:"pinned"`
+	// The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+	Query *string `pulumi:"query"`
+	// The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+	Sort *string `pulumi:"sort"`
+	// Who the search is listed for: organization or owner. Defaults to organization.
+	Visibility *string `pulumi:"visibility"`
+}
+
+type SavedSearchState struct {
+	Name pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+	Pinned pulumi.BoolPtrInput
+	// The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+	Query pulumi.StringPtrInput
+	// The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+	Sort pulumi.StringPtrInput
+	// Who the search is listed for: organization or owner. Defaults to organization.
+	Visibility pulumi.StringPtrInput
+}
+
+func (SavedSearchState) ElementType() reflect.Type {
+	return reflect.TypeOf((*savedSearchState)(nil)).Elem()
+}
+
+type savedSearchArgs struct {
+	Name string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	// Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+	Pinned *bool `pulumi:"pinned"`
+	// The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+	Query string `pulumi:"query"`
+	// The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+	Sort *string `pulumi:"sort"`
+	// Who the search is listed for: organization or owner. Defaults to organization.
+	Visibility *string `pulumi:"visibility"`
+}
+
+// The set of arguments for constructing a SavedSearch resource.
+type SavedSearchArgs struct {
+	Name pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	// Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+	Pinned pulumi.BoolPtrInput
+	// The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+	Query pulumi.StringInput
+	// The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+	Sort pulumi.StringPtrInput
+	// Who the search is listed for: organization or owner. Defaults to organization.
+	Visibility pulumi.StringPtrInput
+}
+
+func (SavedSearchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*savedSearchArgs)(nil)).Elem()
+}
+
+type SavedSearchInput interface {
+	pulumi.Input
+
+	ToSavedSearchOutput() SavedSearchOutput
+	ToSavedSearchOutputWithContext(ctx context.Context) SavedSearchOutput
+}
+
+func (SavedSearch) ElementType() reflect.Type {
+	return reflect.TypeOf((*SavedSearch)(nil)).Elem()
+}
+
+func (i SavedSearch) ToSavedSearchOutput() SavedSearchOutput {
+	return i.ToSavedSearchOutputWithContext(context.Background())
+}
+
+func (i SavedSearch) ToSavedSearchOutputWithContext(ctx context.Context) SavedSearchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SavedSearchOutput)
+}
+
+type SavedSearchOutput struct {
+	*pulumi.OutputState
+}
+
+func (SavedSearchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SavedSearchOutput)(nil)).Elem()
+}
+
+func (o SavedSearchOutput) ToSavedSearchOutput() SavedSearchOutput {
+	return o
+}
+
+func (o SavedSearchOutput) ToSavedSearchOutputWithContext(ctx context.Context) SavedSearchOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(SavedSearchOutput{})
+}
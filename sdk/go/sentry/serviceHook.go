@@ -0,0 +1,154 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A webhook of a project, posting events to a URL.
+type ServiceHook struct {
+	pulumi.CustomResourceState
+
+	// The events posted to the URL: event.alert and/or event.created.
+	Events pulumi.StringArrayOutput `pulumi:"events"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	ProjectSlug      pulumi.StringOutput `pulumi:"projectSlug"`
+	// The secret Sentry signs the requests to the URL with, in the X-ServiceHook-Signature header.
+	Secret pulumi.StringOutput `pulumi:"secret"`
+	Url    pulumi.StringOutput `pulumi:"url"`
+}
+
+// NewServiceHook registers a new resource with the given unique name, arguments, and options.
+func NewServiceHook(ctx *pulumi.Context,
+	name string, args *ServiceHookArgs, opts ...pulumi.ResourceOption) (*ServiceHook, error) {
+	if args == nil || args.Events == nil {
+		return nil, errors.New("missing required argument 'Events'")
+	}
+	if args == nil || args.ProjectSlug == nil {
+		return nil, errors.New("missing required argument 'ProjectSlug'")
+	}
+	if args == nil || args.Url == nil {
+		return nil, errors.New("missing required argument 'Url'")
+	}
+	if args == nil {
+		args = &ServiceHookArgs{}
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"secret",
+	})
+	opts = append(opts, secrets)
+	var resource ServiceHook
+	err := ctx.RegisterResource("sentry:index:ServiceHook", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetServiceHook gets an existing ServiceHook resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetServiceHook(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ServiceHookState, opts ...pulumi.ResourceOption) (*ServiceHook, error) {
+	var resource ServiceHook
+	err := ctx.ReadResource("sentry:index:ServiceHook", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ServiceHook resources.
+type serviceHookState struct {
+	// The events posted to the URL: event.alert and/or event.created.
+	Events []string `pulumi:"events"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      *string `pulumi:"projectSlug"`
+	// The secret Sentry signs the requests to the URL with, in the X-ServiceHook-Signature header.
+	Secret *string `pulumi:"secret"`
+	Url    *string `pulumi:"url"`
+}
+
+type ServiceHookState struct {
+	// The events posted to the URL: event.alert and/or event.created.
+	Events pulumi.StringArrayInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringPtrInput
+	// The secret Sentry signs the requests to the URL with, in the X-ServiceHook-Signature header.
+	Secret pulumi.StringPtrInput
+	Url    pulumi.StringPtrInput
+}
+
+func (ServiceHookState) ElementType() reflect.Type {
+	return reflect.TypeOf((*serviceHookState)(nil)).Elem()
+}
+
+type serviceHookArgs struct {
+	// The events posted to the URL: event.alert and/or event.created.
+	Events []string `pulumi:"events"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	ProjectSlug      string  `pulumi:"projectSlug"`
+	Url              string  `pulumi:"url"`
+}
+
+// The set of arguments for constructing a ServiceHook resource.
+type ServiceHookArgs struct {
+	// The events posted to the URL: event.alert and/or event.created.
+	Events pulumi.StringArrayInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	ProjectSlug      pulumi.StringInput
+	Url              pulumi.StringInput
+}
+
+func (ServiceHookArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*serviceHookArgs)(nil)).Elem()
+}
+
+type ServiceHookInput interface {
+	pulumi.Input
+
+	ToServiceHookOutput() ServiceHookOutput
+	ToServiceHookOutputWithContext(ctx context.Context) ServiceHookOutput
+}
+
+func (ServiceHook) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServiceHook)(nil)).Elem()
+}
+
+func (i ServiceHook) ToServiceHookOutput() ServiceHookOutput {
+	return i.ToServiceHookOutputWithContext(context.Background())
+}
+
+func (i ServiceHook) ToServiceHookOutputWithContext(ctx context.Context) ServiceHookOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ServiceHookOutput)
+}
+
+type ServiceHookOutput struct {
+	*pulumi.OutputState
+}
+
+func (ServiceHookOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServiceHookOutput)(nil)).Elem()
+}
+
+func (o ServiceHookOutput) ToServiceHookOutput() ServiceHookOutput {
+	return o
+}
+
+func (o ServiceHookOutput) ToServiceHookOutputWithContext(ctx context.Context) ServiceHookOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ServiceHookOutput{})
+}
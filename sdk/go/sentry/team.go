@@ -0,0 +1,127 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+type Team struct {
+	pulumi.CustomResourceState
+
+	Name             pulumi.StringOutput `pulumi:"name"`
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	Slug             pulumi.StringOutput `pulumi:"slug"`
+}
+
+// NewTeam registers a new resource with the given unique name, arguments, and options.
+func NewTeam(ctx *pulumi.Context,
+	name string, args *TeamArgs, opts ...pulumi.ResourceOption) (*Team, error) {
+	if args == nil || args.Name == nil {
+		return nil, errors.New("missing required argument 'Name'")
+	}
+	if args == nil || args.Slug == nil {
+		return nil, errors.New("missing required argument 'Slug'")
+	}
+	if args == nil {
+		args = &TeamArgs{}
+	}
+	var resource Team
+	err := ctx.RegisterResource("sentry:index:Team", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetTeam gets an existing Team resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetTeam(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *TeamState, opts ...pulumi.ResourceOption) (*Team, error) {
+	var resource Team
+	err := ctx.ReadResource("sentry:index:Team", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Team resources.
+type teamState struct {
+	Name             *string `pulumi:"name"`
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	Slug             *string `pulumi:"slug"`
+}
+
+type TeamState struct {
+	Name             pulumi.StringPtrInput
+	OrganizationSlug pulumi.StringPtrInput
+	Slug             pulumi.StringPtrInput
+}
+
+func (TeamState) ElementType() reflect.Type {
+	return reflect.TypeOf((*teamState)(nil)).Elem()
+}
+
+type teamArgs struct {
+	Name string `pulumi:"name"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	Slug             string  `pulumi:"slug"`
+}
+
+// The set of arguments for constructing a Team resource.
+type TeamArgs struct {
+	Name pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	Slug             pulumi.StringInput
+}
+
+func (TeamArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*teamArgs)(nil)).Elem()
+}
+
+type TeamInput interface {
+	pulumi.Input
+
+	ToTeamOutput() TeamOutput
+	ToTeamOutputWithContext(ctx context.Context) TeamOutput
+}
+
+func (Team) ElementType() reflect.Type {
+	return reflect.TypeOf((*Team)(nil)).Elem()
+}
+
+func (i Team) ToTeamOutput() TeamOutput {
+	return i.ToTeamOutputWithContext(context.Background())
+}
+
+func (i Team) ToTeamOutputWithContext(ctx context.Context) TeamOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamOutput)
+}
+
+type TeamOutput struct {
+	*pulumi.OutputState
+}
+
+func (TeamOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TeamOutput)(nil)).Elem()
+}
+
+func (o TeamOutput) ToTeamOutput() TeamOutput {
+	return o
+}
+
+func (o TeamOutput) ToTeamOutputWithContext(ctx context.Context) TeamOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(TeamOutput{})
+}
@@ -0,0 +1,136 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package sentry
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// The membership of an organization member in a team.
+type TeamMember struct {
+	pulumi.CustomResourceState
+
+	// The ID of an organization member, e.g. the memberId of an OrganizationMember.
+	MemberId pulumi.StringOutput `pulumi:"memberId"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringOutput `pulumi:"organizationSlug"`
+	TeamSlug         pulumi.StringOutput `pulumi:"teamSlug"`
+}
+
+// NewTeamMember registers a new resource with the given unique name, arguments, and options.
+func NewTeamMember(ctx *pulumi.Context,
+	name string, args *TeamMemberArgs, opts ...pulumi.ResourceOption) (*TeamMember, error) {
+	if args == nil || args.MemberId == nil {
+		return nil, errors.New("missing required argument 'MemberId'")
+	}
+	if args == nil || args.TeamSlug == nil {
+		return nil, errors.New("missing required argument 'TeamSlug'")
+	}
+	if args == nil {
+		args = &TeamMemberArgs{}
+	}
+	var resource TeamMember
+	err := ctx.RegisterResource("sentry:index:TeamMember", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetTeamMember gets an existing TeamMember resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetTeamMember(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *TeamMemberState, opts ...pulumi.ResourceOption) (*TeamMember, error) {
+	var resource TeamMember
+	err := ctx.ReadResource("sentry:index:TeamMember", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering TeamMember resources.
+type teamMemberState struct {
+	// The ID of an organization member, e.g. the memberId of an OrganizationMember.
+	MemberId *string `pulumi:"memberId"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	TeamSlug         *string `pulumi:"teamSlug"`
+}
+
+type TeamMemberState struct {
+	// The ID of an organization member, e.g. the memberId of an OrganizationMember.
+	MemberId pulumi.StringPtrInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	TeamSlug         pulumi.StringPtrInput
+}
+
+func (TeamMemberState) ElementType() reflect.Type {
+	return reflect.TypeOf((*teamMemberState)(nil)).Elem()
+}
+
+type teamMemberArgs struct {
+	// The ID of an organization member, e.g. the memberId of an OrganizationMember.
+	MemberId string `pulumi:"memberId"`
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug *string `pulumi:"organizationSlug"`
+	TeamSlug         string  `pulumi:"teamSlug"`
+}
+
+// The set of arguments for constructing a TeamMember resource.
+type TeamMemberArgs struct {
+	// The ID of an organization member, e.g. the memberId of an OrganizationMember.
+	MemberId pulumi.StringInput
+	// Defaults to the sentry:organization provider config.
+	OrganizationSlug pulumi.StringPtrInput
+	TeamSlug         pulumi.StringInput
+}
+
+func (TeamMemberArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*teamMemberArgs)(nil)).Elem()
+}
+
+type TeamMemberInput interface {
+	pulumi.Input
+
+	ToTeamMemberOutput() TeamMemberOutput
+	ToTeamMemberOutputWithContext(ctx context.Context) TeamMemberOutput
+}
+
+func (TeamMember) ElementType() reflect.Type {
+	return reflect.TypeOf((*TeamMember)(nil)).Elem()
+}
+
+func (i TeamMember) ToTeamMemberOutput() TeamMemberOutput {
+	return i.ToTeamMemberOutputWithContext(context.Background())
+}
+
+func (i TeamMember) ToTeamMemberOutputWithContext(ctx context.Context) TeamMemberOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamMemberOutput)
+}
+
+type TeamMemberOutput struct {
+	*pulumi.OutputState
+}
+
+func (TeamMemberOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TeamMemberOutput)(nil)).Elem()
+}
+
+func (o TeamMemberOutput) ToTeamMemberOutput() TeamMemberOutput {
+	return o
+}
+
+func (o TeamMemberOutput) ToTeamMemberOutputWithContext(ctx context.Context) TeamMemberOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(TeamMemberOutput{})
+}
@@ -0,0 +1,24 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+let __config = new pulumi.Config("sentry");
+
+/**
+ * The URL of the Sentry API, e.g. https://sentry.io/api/0/. Falls back to the server URL in SENTRY_URL.
+ */
+export let apiURL: string | undefined = __config.get("apiURL") || utilities.getEnv("SENTRY_URL");
+/**
+ * The default organization slug for resources that don't set one.
+ */
+export let organization: string | undefined = __config.get("organization") || utilities.getEnv("SENTRY_ORG");
+/**
+ * Skip checking the token against the Sentry API when the provider starts.
+ */
+export let skipCredentialsValidation: boolean | undefined = __config.getObject<boolean>("skipCredentialsValidation");
+/**
+ * The Sentry auth token.
+ */
+export let token: string | undefined = __config.get("token") || utilities.getEnv("SENTRY_AUTH_TOKEN");
@@ -0,0 +1,93 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * A dashboard of an organization: a grid of widgets charting the results of queries of events.
+ */
+export class Dashboard extends pulumi.CustomResource {
+    /**
+     * Get an existing Dashboard resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Dashboard {
+        return new Dashboard(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:Dashboard';
+
+    /**
+     * Returns true if the given object is an instance of Dashboard.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Dashboard {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Dashboard.__pulumiType;
+    }
+
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly title!: pulumi.Output<string>;
+    /**
+     * The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+     */
+    public readonly widgets!: pulumi.Output<outputs.DashboardWidget[] | undefined>;
+
+    /**
+     * Create a Dashboard resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: DashboardArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.title === undefined) {
+                throw new Error("Missing required property 'title'");
+            }
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["title"] = args ? args.title : undefined;
+            inputs["widgets"] = args ? args.widgets : undefined;
+        } else {
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["title"] = undefined /*out*/;
+            inputs["widgets"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(Dashboard.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Dashboard resource.
+ */
+export interface DashboardArgs {
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly title: pulumi.Input<string>;
+    /**
+     * The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+     */
+    readonly widgets?: pulumi.Input<pulumi.Input<inputs.DashboardWidget>[]>;
+}
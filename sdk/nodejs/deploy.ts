@@ -0,0 +1,129 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A deploy of a release to an environment. Sentry keeps deploys as a log: any change records a new deploy, and deleting the resource leaves the deploy in Sentry.
+ */
+export class Deploy extends pulumi.CustomResource {
+    /**
+     * Get an existing Deploy resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Deploy {
+        return new Deploy(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:Deploy';
+
+    /**
+     * Returns true if the given object is an instance of Deploy.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Deploy {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Deploy.__pulumiType;
+    }
+
+    /**
+     * When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+     */
+    public readonly dateFinished!: pulumi.Output<string>;
+    /**
+     * When the deploy started, in RFC 3339 format.
+     */
+    public readonly dateStarted!: pulumi.Output<string | undefined>;
+    public readonly environment!: pulumi.Output<string>;
+    public readonly name!: pulumi.Output<string | undefined>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    /**
+     * A link to the deploy, e.g. to its pipeline.
+     */
+    public readonly url!: pulumi.Output<string | undefined>;
+    /**
+     * The version of the release deployed.
+     */
+    public readonly version!: pulumi.Output<string>;
+
+    /**
+     * Create a Deploy resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: DeployArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.environment === undefined) {
+                throw new Error("Missing required property 'environment'");
+            }
+            if (!args || args.version === undefined) {
+                throw new Error("Missing required property 'version'");
+            }
+            inputs["dateFinished"] = args ? args.dateFinished : undefined;
+            inputs["dateStarted"] = args ? args.dateStarted : undefined;
+            inputs["environment"] = args ? args.environment : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["url"] = args ? args.url : undefined;
+            inputs["version"] = args ? args.version : undefined;
+        } else {
+            inputs["dateFinished"] = undefined /*out*/;
+            inputs["dateStarted"] = undefined /*out*/;
+            inputs["environment"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["url"] = undefined /*out*/;
+            inputs["version"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(Deploy.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Deploy resource.
+ */
+export interface DeployArgs {
+    /**
+     * When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+     */
+    readonly dateFinished?: pulumi.Input<string>;
+    /**
+     * When the deploy started, in RFC 3339 format.
+     */
+    readonly dateStarted?: pulumi.Input<string>;
+    readonly environment: pulumi.Input<string>;
+    readonly name?: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    /**
+     * A link to the deploy, e.g. to its pipeline.
+     */
+    readonly url?: pulumi.Input<string>;
+    /**
+     * The version of the release deployed.
+     */
+    readonly version: pulumi.Input<string>;
+}
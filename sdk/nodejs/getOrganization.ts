@@ -0,0 +1,29 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+export function getOrganization(args: GetOrganizationArgs, opts?: pulumi.InvokeOptions): Promise<GetOrganizationResult> {
+    if (!opts) {
+        opts = {}
+    }
+
+    if (!opts.version) {
+        opts.version = utilities.getVersion();
+    }
+    return pulumi.runtime.invoke("sentry:index:getOrganization", {
+        "slug": args.slug,
+    }, opts);
+}
+
+export interface GetOrganizationArgs {
+    readonly slug: string;
+}
+
+export interface GetOrganizationResult {
+    readonly id: string;
+    readonly name: string;
+    readonly slug: string;
+}
@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Finds an integration installed in an organization, e.g. a Slack workspace, by its provider and name.
+ */
+export function getOrganizationIntegration(args: GetOrganizationIntegrationArgs, opts?: pulumi.InvokeOptions): Promise<GetOrganizationIntegrationResult> {
+    if (!opts) {
+        opts = {}
+    }
+
+    if (!opts.version) {
+        opts.version = utilities.getVersion();
+    }
+    return pulumi.runtime.invoke("sentry:index:getOrganizationIntegration", {
+        "name": args.name,
+        "organizationSlug": args.organizationSlug,
+        "providerKey": args.providerKey,
+    }, opts);
+}
+
+export interface GetOrganizationIntegrationArgs {
+    /**
+     * The name of the integration as shown in Sentry, e.g. the Slack workspace or GitHub owner.
+     */
+    readonly name: string;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: string;
+    /**
+     * The service of the integration, e.g. slack, github or jira.
+     */
+    readonly providerKey: string;
+}
+
+export interface GetOrganizationIntegrationResult {
+    readonly domainName: string;
+    /**
+     * The ID of the integration in the service, e.g. the Slack workspace ID.
+     */
+    readonly externalId: string;
+    /**
+     * The integration ID used by alert rule actions and repositories.
+     */
+    readonly id: number;
+    readonly name: string;
+    readonly organizationSlug: string;
+    readonly providerKey: string;
+    readonly providerName: string;
+    readonly status: string;
+}
@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+export function getProject(args: GetProjectArgs, opts?: pulumi.InvokeOptions): Promise<GetProjectResult> {
+    if (!opts) {
+        opts = {}
+    }
+
+    if (!opts.version) {
+        opts.version = utilities.getVersion();
+    }
+    return pulumi.runtime.invoke("sentry:index:getProject", {
+        "organizationSlug": args.organizationSlug,
+        "slug": args.slug,
+    }, opts);
+}
+
+export interface GetProjectArgs {
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: string;
+    readonly slug: string;
+}
+
+export interface GetProjectResult {
+    /**
+     * Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+     */
+    readonly allowedDomains?: string[];
+    /**
+     * Whether Sentry removes sensitive data from events server-side.
+     */
+    readonly dataScrubber?: boolean;
+    /**
+     * Whether the data scrubber applies its default list of sensitive fields.
+     */
+    readonly dataScrubberDefaults?: boolean;
+    readonly defaultClientKeyDSNPublic: string;
+    /**
+     * The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+     */
+    readonly defaultClientKeyRateLimitCount?: number;
+    /**
+     * The window of the default client key's rate limit, in seconds.
+     */
+    readonly defaultClientKeyRateLimitWindow?: number;
+    readonly defaultEnvironment?: string;
+    readonly id: string;
+    readonly name: string;
+    readonly organizationSlug: string;
+    /**
+     * Hours of inactivity after which issues are resolved automatically, 0 disables it.
+     */
+    readonly resolveAge?: number;
+    /**
+     * Field names the data scrubber must leave as they are.
+     */
+    readonly safeFields?: string[];
+    /**
+     * Whether Sentry fetches JavaScript source files and source maps.
+     */
+    readonly scrapeJavaScript?: boolean;
+    /**
+     * Whether IP addresses are removed from events.
+     */
+    readonly scrubIPAddresses?: boolean;
+    /**
+     * Additional field names the data scrubber removes.
+     */
+    readonly sensitiveFields?: string[];
+    readonly slug: string;
+    readonly subjectPrefix?: string;
+    readonly subjectTemplate?: string;
+    readonly teamSlug?: string;
+    readonly teamSlugs: string[];
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+export function getTeam(args: GetTeamArgs, opts?: pulumi.InvokeOptions): Promise<GetTeamResult> {
+    if (!opts) {
+        opts = {}
+    }
+
+    if (!opts.version) {
+        opts.version = utilities.getVersion();
+    }
+    return pulumi.runtime.invoke("sentry:index:getTeam", {
+        "organizationSlug": args.organizationSlug,
+        "slug": args.slug,
+    }, opts);
+}
+
+export interface GetTeamArgs {
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: string;
+    readonly slug: string;
+}
+
+export interface GetTeamResult {
+    readonly id: string;
+    readonly name: string;
+    readonly organizationSlug: string;
+    readonly slug: string;
+}
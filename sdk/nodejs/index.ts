@@ -2,5 +2,35 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 // Export members:
+export * from "./clientKey";
+export * from "./dashboard";
+export * from "./deploy";
+export * from "./getOrganization";
+export * from "./getOrganizationIntegration";
+export * from "./getProject";
+export * from "./getTeam";
+export * from "./issueAlertRule";
+export * from "./metricAlertRule";
+export * from "./monitor";
+export * from "./organizationMember";
+export * from "./organizationRepository";
+export * from "./organizationSettings";
 export * from "./project";
+export * from "./projectEnvironment";
+export * from "./projectInboundFilters";
+export * from "./projectOwnership";
 export * from "./provider";
+export * from "./release";
+export * from "./savedSearch";
+export * from "./serviceHook";
+export * from "./team";
+export * from "./teamMember";
+
+// Export sub-modules:
+import * as config from "./config";
+import * as types from "./types";
+
+export {
+    config,
+    types,
+};
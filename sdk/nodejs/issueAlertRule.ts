@@ -0,0 +1,156 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+export class IssueAlertRule extends pulumi.CustomResource {
+    /**
+     * Get an existing IssueAlertRule resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): IssueAlertRule {
+        return new IssueAlertRule(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:IssueAlertRule';
+
+    /**
+     * Returns true if the given object is an instance of IssueAlertRule.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is IssueAlertRule {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === IssueAlertRule.__pulumiType;
+    }
+
+    /**
+     * When to run the actions: all, any or none of the conditions match. Defaults to all.
+     */
+    public readonly actionMatch!: pulumi.Output<string>;
+    /**
+     * Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+     */
+    public readonly actions!: pulumi.Output<{[key: string]: any}[]>;
+    /**
+     * Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+     */
+    public readonly conditions!: pulumi.Output<{[key: string]: any}[]>;
+    /**
+     * Only trigger the rule for events from this environment.
+     */
+    public readonly environment!: pulumi.Output<string | undefined>;
+    /**
+     * How filters are combined: all, any or none. Defaults to all.
+     */
+    public readonly filterMatch!: pulumi.Output<string>;
+    /**
+     * Filters events must pass for the rule to fire.
+     */
+    public readonly filters!: pulumi.Output<{[key: string]: any}[]>;
+    /**
+     * Minimum number of minutes between actions for the same issue. Defaults to 30.
+     */
+    public readonly frequency!: pulumi.Output<number>;
+    public readonly name!: pulumi.Output<string>;
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly projectSlug!: pulumi.Output<string>;
+
+    /**
+     * Create a IssueAlertRule resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: IssueAlertRuleArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.actions === undefined) {
+                throw new Error("Missing required property 'actions'");
+            }
+            if (!args || args.name === undefined) {
+                throw new Error("Missing required property 'name'");
+            }
+            if (!args || args.projectSlug === undefined) {
+                throw new Error("Missing required property 'projectSlug'");
+            }
+            inputs["actionMatch"] = args ? args.actionMatch : undefined;
+            inputs["actions"] = args ? args.actions : undefined;
+            inputs["conditions"] = args ? args.conditions : undefined;
+            inputs["environment"] = args ? args.environment : undefined;
+            inputs["filterMatch"] = args ? args.filterMatch : undefined;
+            inputs["filters"] = args ? args.filters : undefined;
+            inputs["frequency"] = args ? args.frequency : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["projectSlug"] = args ? args.projectSlug : undefined;
+        } else {
+            inputs["actionMatch"] = undefined /*out*/;
+            inputs["actions"] = undefined /*out*/;
+            inputs["conditions"] = undefined /*out*/;
+            inputs["environment"] = undefined /*out*/;
+            inputs["filterMatch"] = undefined /*out*/;
+            inputs["filters"] = undefined /*out*/;
+            inputs["frequency"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["projectSlug"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(IssueAlertRule.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a IssueAlertRule resource.
+ */
+export interface IssueAlertRuleArgs {
+    /**
+     * When to run the actions: all, any or none of the conditions match. Defaults to all.
+     */
+    readonly actionMatch?: pulumi.Input<string>;
+    /**
+     * Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+     */
+    readonly actions: pulumi.Input<pulumi.Input<{[key: string]: any}>[]>;
+    /**
+     * Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+     */
+    readonly conditions?: pulumi.Input<pulumi.Input<{[key: string]: any}>[]>;
+    /**
+     * Only trigger the rule for events from this environment.
+     */
+    readonly environment?: pulumi.Input<string>;
+    /**
+     * How filters are combined: all, any or none. Defaults to all.
+     */
+    readonly filterMatch?: pulumi.Input<string>;
+    /**
+     * Filters events must pass for the rule to fire.
+     */
+    readonly filters?: pulumi.Input<pulumi.Input<{[key: string]: any}>[]>;
+    /**
+     * Minimum number of minutes between actions for the same issue. Defaults to 30.
+     */
+    readonly frequency?: pulumi.Input<number>;
+    readonly name: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly projectSlug: pulumi.Input<string>;
+}
@@ -0,0 +1,167 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+export class MetricAlertRule extends pulumi.CustomResource {
+    /**
+     * Get an existing MetricAlertRule resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): MetricAlertRule {
+        return new MetricAlertRule(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:MetricAlertRule';
+
+    /**
+     * Returns true if the given object is an instance of MetricAlertRule.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is MetricAlertRule {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === MetricAlertRule.__pulumiType;
+    }
+
+    /**
+     * The metric, e.g. count() or p95(transaction.duration).
+     */
+    public readonly aggregate!: pulumi.Output<string>;
+    /**
+     * events or transactions. Defaults to events.
+     */
+    public readonly dataset!: pulumi.Output<string>;
+    /**
+     * Only count events from this environment.
+     */
+    public readonly environment!: pulumi.Output<string | undefined>;
+    public readonly name!: pulumi.Output<string>;
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly projectSlug!: pulumi.Output<string>;
+    /**
+     * Only count events matching this search query.
+     */
+    public readonly query!: pulumi.Output<string>;
+    /**
+     * The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+     */
+    public readonly resolveThreshold!: pulumi.Output<number | undefined>;
+    /**
+     * Whether the alert fires above or below the thresholds. Defaults to above.
+     */
+    public readonly thresholdType!: pulumi.Output<string>;
+    /**
+     * The period the metric is aggregated over, in minutes.
+     */
+    public readonly timeWindow!: pulumi.Output<number>;
+    public readonly triggers!: pulumi.Output<outputs.MetricAlertRuleTrigger[]>;
+
+    /**
+     * Create a MetricAlertRule resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: MetricAlertRuleArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.aggregate === undefined) {
+                throw new Error("Missing required property 'aggregate'");
+            }
+            if (!args || args.name === undefined) {
+                throw new Error("Missing required property 'name'");
+            }
+            if (!args || args.projectSlug === undefined) {
+                throw new Error("Missing required property 'projectSlug'");
+            }
+            if (!args || args.timeWindow === undefined) {
+                throw new Error("Missing required property 'timeWindow'");
+            }
+            if (!args || args.triggers === undefined) {
+                throw new Error("Missing required property 'triggers'");
+            }
+            inputs["aggregate"] = args ? args.aggregate : undefined;
+            inputs["dataset"] = args ? args.dataset : undefined;
+            inputs["environment"] = args ? args.environment : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["projectSlug"] = args ? args.projectSlug : undefined;
+            inputs["query"] = args ? args.query : undefined;
+            inputs["resolveThreshold"] = args ? args.resolveThreshold : undefined;
+            inputs["thresholdType"] = args ? args.thresholdType : undefined;
+            inputs["timeWindow"] = args ? args.timeWindow : undefined;
+            inputs["triggers"] = args ? args.triggers : undefined;
+        } else {
+            inputs["aggregate"] = undefined /*out*/;
+            inputs["dataset"] = undefined /*out*/;
+            inputs["environment"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["projectSlug"] = undefined /*out*/;
+            inputs["query"] = undefined /*out*/;
+            inputs["resolveThreshold"] = undefined /*out*/;
+            inputs["thresholdType"] = undefined /*out*/;
+            inputs["timeWindow"] = undefined /*out*/;
+            inputs["triggers"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(MetricAlertRule.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a MetricAlertRule resource.
+ */
+export interface MetricAlertRuleArgs {
+    /**
+     * The metric, e.g. count() or p95(transaction.duration).
+     */
+    readonly aggregate: pulumi.Input<string>;
+    /**
+     * events or transactions. Defaults to events.
+     */
+    readonly dataset?: pulumi.Input<string>;
+    /**
+     * Only count events from this environment.
+     */
+    readonly environment?: pulumi.Input<string>;
+    readonly name: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly projectSlug: pulumi.Input<string>;
+    /**
+     * Only count events matching this search query.
+     */
+    readonly query?: pulumi.Input<string>;
+    /**
+     * The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+     */
+    readonly resolveThreshold?: pulumi.Input<number>;
+    /**
+     * Whether the alert fires above or below the thresholds. Defaults to above.
+     */
+    readonly thresholdType?: pulumi.Input<string>;
+    /**
+     * The period the metric is aggregated over, in minutes.
+     */
+    readonly timeWindow: pulumi.Input<number>;
+    readonly triggers: pulumi.Input<pulumi.Input<inputs.MetricAlertRuleTrigger>[]>;
+}
@@ -0,0 +1,162 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A cron monitor of a project, alerting when a scheduled job misses a check-in or runs for too long.
+ */
+export class Monitor extends pulumi.CustomResource {
+    /**
+     * Get an existing Monitor resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Monitor {
+        return new Monitor(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:Monitor';
+
+    /**
+     * Returns true if the given object is an instance of Monitor.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Monitor {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Monitor.__pulumiType;
+    }
+
+    /**
+     * How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+     */
+    public readonly checkinMargin!: pulumi.Output<number | undefined>;
+    /**
+     * minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+     */
+    public readonly intervalUnit!: pulumi.Output<string | undefined>;
+    /**
+     * How many intervalUnits there are between runs of the job.
+     */
+    public readonly intervalValue!: pulumi.Output<number | undefined>;
+    /**
+     * How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+     */
+    public readonly maxRuntime!: pulumi.Output<number | undefined>;
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly projectSlug!: pulumi.Output<string>;
+    /**
+     * The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+     */
+    public readonly schedule!: pulumi.Output<string | undefined>;
+    /**
+     * The slug jobs check in with.
+     */
+    public readonly slug!: pulumi.Output<string>;
+    /**
+     * The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+     */
+    public readonly timezone!: pulumi.Output<string>;
+
+    /**
+     * Create a Monitor resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: MonitorArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.name === undefined) {
+                throw new Error("Missing required property 'name'");
+            }
+            if (!args || args.projectSlug === undefined) {
+                throw new Error("Missing required property 'projectSlug'");
+            }
+            if (!args || args.slug === undefined) {
+                throw new Error("Missing required property 'slug'");
+            }
+            inputs["checkinMargin"] = args ? args.checkinMargin : undefined;
+            inputs["intervalUnit"] = args ? args.intervalUnit : undefined;
+            inputs["intervalValue"] = args ? args.intervalValue : undefined;
+            inputs["maxRuntime"] = args ? args.maxRuntime : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["projectSlug"] = args ? args.projectSlug : undefined;
+            inputs["schedule"] = args ? args.schedule : undefined;
+            inputs["slug"] = args ? args.slug : undefined;
+            inputs["timezone"] = args ? args.timezone : undefined;
+        } else {
+            inputs["checkinMargin"] = undefined /*out*/;
+            inputs["intervalUnit"] = undefined /*out*/;
+            inputs["intervalValue"] = undefined /*out*/;
+            inputs["maxRuntime"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["projectSlug"] = undefined /*out*/;
+            inputs["schedule"] = undefined /*out*/;
+            inputs["slug"] = undefined /*out*/;
+            inputs["timezone"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(Monitor.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Monitor resource.
+ */
+export interface MonitorArgs {
+    /**
+     * How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+     */
+    readonly checkinMargin?: pulumi.Input<number>;
+    /**
+     * minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+     */
+    readonly intervalUnit?: pulumi.Input<string>;
+    /**
+     * How many intervalUnits there are between runs of the job.
+     */
+    readonly intervalValue?: pulumi.Input<number>;
+    /**
+     * How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+     */
+    readonly maxRuntime?: pulumi.Input<number>;
+    readonly name: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly projectSlug: pulumi.Input<string>;
+    /**
+     * The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+     */
+    readonly schedule?: pulumi.Input<string>;
+    /**
+     * The slug jobs check in with.
+     */
+    readonly slug: pulumi.Input<string>;
+    /**
+     * The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+     */
+    readonly timezone?: pulumi.Input<string>;
+}
@@ -0,0 +1,120 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A member of an organization, invited by email on creation and removed from the organization on deletion.
+ */
+export class OrganizationMember extends pulumi.CustomResource {
+    /**
+     * Get an existing OrganizationMember resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): OrganizationMember {
+        return new OrganizationMember(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:OrganizationMember';
+
+    /**
+     * Returns true if the given object is an instance of OrganizationMember.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is OrganizationMember {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === OrganizationMember.__pulumiType;
+    }
+
+    /**
+     * The email the invitation is sent to.
+     */
+    public readonly email!: pulumi.Output<string>;
+    /**
+     * The ID of the member, e.g. for TeamMember resources.
+     */
+    public /*out*/ readonly memberId!: pulumi.Output<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    /**
+     * Whether the invitation has not been accepted yet.
+     */
+    public /*out*/ readonly pending!: pulumi.Output<boolean>;
+    /**
+     * One of member, admin, manager, owner or billing. Defaults to member.
+     */
+    public readonly role!: pulumi.Output<string>;
+    /**
+     * The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+     */
+    public readonly teamSlugs!: pulumi.Output<string[] | undefined>;
+
+    /**
+     * Create a OrganizationMember resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: OrganizationMemberArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.email === undefined) {
+                throw new Error("Missing required property 'email'");
+            }
+            inputs["email"] = args ? args.email : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["role"] = args ? args.role : undefined;
+            inputs["teamSlugs"] = args ? args.teamSlugs : undefined;
+            inputs["memberId"] = undefined /*out*/;
+            inputs["pending"] = undefined /*out*/;
+        } else {
+            inputs["email"] = undefined /*out*/;
+            inputs["memberId"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["pending"] = undefined /*out*/;
+            inputs["role"] = undefined /*out*/;
+            inputs["teamSlugs"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(OrganizationMember.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a OrganizationMember resource.
+ */
+export interface OrganizationMemberArgs {
+    /**
+     * The email the invitation is sent to.
+     */
+    readonly email: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    /**
+     * One of member, admin, manager, owner or billing. Defaults to member.
+     */
+    readonly role?: pulumi.Input<string>;
+    /**
+     * The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+     */
+    readonly teamSlugs?: pulumi.Input<pulumi.Input<string>[]>;
+}
@@ -0,0 +1,113 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A code repository linked to an organization through an integration such as GitHub, so that releases can reference its commits.
+ */
+export class OrganizationRepository extends pulumi.CustomResource {
+    /**
+     * Get an existing OrganizationRepository resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): OrganizationRepository {
+        return new OrganizationRepository(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:OrganizationRepository';
+
+    /**
+     * Returns true if the given object is an instance of OrganizationRepository.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is OrganizationRepository {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === OrganizationRepository.__pulumiType;
+    }
+
+    /**
+     * The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+     */
+    public readonly integrationId!: pulumi.Output<number>;
+    /**
+     * The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+     */
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    /**
+     * Assigned by Sentry, e.g. `integrations:github`.
+     */
+    public /*out*/ readonly provider!: pulumi.Output<string>;
+    /**
+     * Assigned by Sentry.
+     */
+    public /*out*/ readonly url!: pulumi.Output<string>;
+
+    /**
+     * Create a OrganizationRepository resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: OrganizationRepositoryArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.integrationId === undefined) {
+                throw new Error("Missing required property 'integrationId'");
+            }
+            if (!args || args.name === undefined) {
+                throw new Error("Missing required property 'name'");
+            }
+            inputs["integrationId"] = args ? args.integrationId : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["provider"] = undefined /*out*/;
+            inputs["url"] = undefined /*out*/;
+        } else {
+            inputs["integrationId"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["provider"] = undefined /*out*/;
+            inputs["url"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(OrganizationRepository.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a OrganizationRepository resource.
+ */
+export interface OrganizationRepositoryArgs {
+    /**
+     * The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+     */
+    readonly integrationId: pulumi.Input<number>;
+    /**
+     * The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+     */
+    readonly name: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+}
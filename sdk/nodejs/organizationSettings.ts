@@ -0,0 +1,179 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The settings of an existing organization. Settings left out keep what they have in Sentry. Creating the resource adopts the organization, and deleting it leaves the organization and its settings as they are.
+ */
+export class OrganizationSettings extends pulumi.CustomResource {
+    /**
+     * Get an existing OrganizationSettings resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): OrganizationSettings {
+        return new OrganizationSettings(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:OrganizationSettings';
+
+    /**
+     * Returns true if the given object is an instance of OrganizationSettings.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is OrganizationSettings {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === OrganizationSettings.__pulumiType;
+    }
+
+    /**
+     * Allow sharing of limited details on issues to anonymous users.
+     */
+    public readonly allowSharedIssues!: pulumi.Output<boolean | undefined>;
+    /**
+     * Require server-side data scrubbing in all projects.
+     */
+    public readonly dataScrubber!: pulumi.Output<boolean | undefined>;
+    /**
+     * Require the default scrubbers in all projects.
+     */
+    public readonly dataScrubberDefaults!: pulumi.Output<boolean | undefined>;
+    /**
+     * The role of new members: member, admin, manager, owner or billing.
+     */
+    public readonly defaultRole!: pulumi.Output<string | undefined>;
+    /**
+     * Keep source code and other sensitive data out of notifications.
+     */
+    public readonly enhancedPrivacy!: pulumi.Output<boolean | undefined>;
+    public readonly name!: pulumi.Output<string | undefined>;
+    /**
+     * Let members join any team of the organization.
+     */
+    public readonly openMembership!: pulumi.Output<boolean | undefined>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    /**
+     * Require members to enable two-factor authentication.
+     */
+    public readonly require2FA!: pulumi.Output<boolean | undefined>;
+    /**
+     * Field names data scrubbers ignore in all projects.
+     */
+    public readonly safeFields!: pulumi.Output<string[] | undefined>;
+    /**
+     * Keep IP addresses out of events of all projects.
+     */
+    public readonly scrubIPAddresses!: pulumi.Output<boolean | undefined>;
+    /**
+     * Additional field names to scrub in all projects.
+     */
+    public readonly sensitiveFields!: pulumi.Output<string[] | undefined>;
+
+    /**
+     * Create a OrganizationSettings resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: OrganizationSettingsArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            inputs["allowSharedIssues"] = args ? args.allowSharedIssues : undefined;
+            inputs["dataScrubber"] = args ? args.dataScrubber : undefined;
+            inputs["dataScrubberDefaults"] = args ? args.dataScrubberDefaults : undefined;
+            inputs["defaultRole"] = args ? args.defaultRole : undefined;
+            inputs["enhancedPrivacy"] = args ? args.enhancedPrivacy : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["openMembership"] = args ? args.openMembership : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["require2FA"] = args ? args.require2FA : undefined;
+            inputs["safeFields"] = args ? args.safeFields : undefined;
+            inputs["scrubIPAddresses"] = args ? args.scrubIPAddresses : undefined;
+            inputs["sensitiveFields"] = args ? args.sensitiveFields : undefined;
+        } else {
+            inputs["allowSharedIssues"] = undefined /*out*/;
+            inputs["dataScrubber"] = undefined /*out*/;
+            inputs["dataScrubberDefaults"] = undefined /*out*/;
+            inputs["defaultRole"] = undefined /*out*/;
+            inputs["enhancedPrivacy"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["openMembership"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["require2FA"] = undefined /*out*/;
+            inputs["safeFields"] = undefined /*out*/;
+            inputs["scrubIPAddresses"] = undefined /*out*/;
+            inputs["sensitiveFields"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(OrganizationSettings.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a OrganizationSettings resource.
+ */
+export interface OrganizationSettingsArgs {
+    /**
+     * Allow sharing of limited details on issues to anonymous users.
+     */
+    readonly allowSharedIssues?: pulumi.Input<boolean>;
+    /**
+     * Require server-side data scrubbing in all projects.
+     */
+    readonly dataScrubber?: pulumi.Input<boolean>;
+    /**
+     * Require the default scrubbers in all projects.
+     */
+    readonly dataScrubberDefaults?: pulumi.Input<boolean>;
+    /**
+     * The role of new members: member, admin, manager, owner or billing.
+     */
+    readonly defaultRole?: pulumi.Input<string>;
+    /**
+     * Keep source code and other sensitive data out of notifications.
+     */
+    readonly enhancedPrivacy?: pulumi.Input<boolean>;
+    readonly name?: pulumi.Input<string>;
+    /**
+     * Let members join any team of the organization.
+     */
+    readonly openMembership?: pulumi.Input<boolean>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    /**
+     * Require members to enable two-factor authentication.
+     */
+    readonly require2FA?: pulumi.Input<boolean>;
+    /**
+     * Field names data scrubbers ignore in all projects.
+     */
+    readonly safeFields?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Keep IP addresses out of events of all projects.
+     */
+    readonly scrubIPAddresses?: pulumi.Input<boolean>;
+    /**
+     * Additional field names to scrub in all projects.
+     */
+    readonly sensitiveFields?: pulumi.Input<pulumi.Input<string>[]>;
+}
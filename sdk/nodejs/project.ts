@@ -31,14 +31,62 @@ export class Project extends pulumi.CustomResource {
         return obj['__pulumiType'] === Project.__pulumiType;
     }
 
+    /**
+     * Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+     */
+    public readonly allowedDomains!: pulumi.Output<string[] | undefined>;
+    /**
+     * Whether Sentry removes sensitive data from events server-side.
+     */
+    public readonly dataScrubber!: pulumi.Output<boolean | undefined>;
+    /**
+     * Whether the data scrubber applies its default list of sensitive fields.
+     */
+    public readonly dataScrubberDefaults!: pulumi.Output<boolean | undefined>;
     public /*out*/ readonly defaultClientKeyDSNPublic!: pulumi.Output<string | undefined>;
+    /**
+     * The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+     */
+    public readonly defaultClientKeyRateLimitCount!: pulumi.Output<number | undefined>;
+    /**
+     * The window of the default client key's rate limit, in seconds.
+     */
+    public readonly defaultClientKeyRateLimitWindow!: pulumi.Output<number | undefined>;
     public readonly defaultEnvironment!: pulumi.Output<string | undefined>;
     public readonly name!: pulumi.Output<string>;
     public readonly organizationSlug!: pulumi.Output<string | undefined>;
+    /**
+     * Hours of inactivity after which issues are resolved automatically, 0 disables it.
+     */
+    public readonly resolveAge!: pulumi.Output<number | undefined>;
+    /**
+     * Field names the data scrubber must leave as they are.
+     */
+    public readonly safeFields!: pulumi.Output<string[] | undefined>;
+    /**
+     * Whether Sentry fetches JavaScript source files and source maps.
+     */
+    public readonly scrapeJavaScript!: pulumi.Output<boolean | undefined>;
+    /**
+     * Whether IP addresses are removed from events.
+     */
+    public readonly scrubIPAddresses!: pulumi.Output<boolean | undefined>;
+    /**
+     * Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+     */
+    public readonly securityToken!: pulumi.Output<string | undefined>;
+    /**
+     * Additional field names the data scrubber removes.
+     */
+    public readonly sensitiveFields!: pulumi.Output<string[] | undefined>;
     public readonly slug!: pulumi.Output<string>;
     public readonly subjectPrefix!: pulumi.Output<string | undefined>;
     public readonly subjectTemplate!: pulumi.Output<string | undefined>;
+    /**
+     * @deprecated Use teamSlugs instead.
+     */
     public readonly teamSlug!: pulumi.Output<string | undefined>;
+    public readonly teamSlugs!: pulumi.Output<string[] | undefined>;
 
     /**
      * Create a Project resource with the given unique name, arguments, and options.
@@ -53,32 +101,50 @@ export class Project extends pulumi.CustomResource {
             if (!args || args.name === undefined) {
                 throw new Error("Missing required property 'name'");
             }
-            if (!args || args.organizationSlug === undefined) {
-                throw new Error("Missing required property 'organizationSlug'");
-            }
             if (!args || args.slug === undefined) {
                 throw new Error("Missing required property 'slug'");
             }
-            if (!args || args.teamSlug === undefined) {
-                throw new Error("Missing required property 'teamSlug'");
-            }
+            inputs["allowedDomains"] = args ? args.allowedDomains : undefined;
+            inputs["dataScrubber"] = args ? args.dataScrubber : undefined;
+            inputs["dataScrubberDefaults"] = args ? args.dataScrubberDefaults : undefined;
+            inputs["defaultClientKeyRateLimitCount"] = args ? args.defaultClientKeyRateLimitCount : undefined;
+            inputs["defaultClientKeyRateLimitWindow"] = args ? args.defaultClientKeyRateLimitWindow : undefined;
             inputs["defaultEnvironment"] = args ? args.defaultEnvironment : undefined;
             inputs["name"] = args ? args.name : undefined;
             inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["resolveAge"] = args ? args.resolveAge : undefined;
+            inputs["safeFields"] = args ? args.safeFields : undefined;
+            inputs["scrapeJavaScript"] = args ? args.scrapeJavaScript : undefined;
+            inputs["scrubIPAddresses"] = args ? args.scrubIPAddresses : undefined;
+            inputs["securityToken"] = args ? args.securityToken : undefined;
+            inputs["sensitiveFields"] = args ? args.sensitiveFields : undefined;
             inputs["slug"] = args ? args.slug : undefined;
             inputs["subjectPrefix"] = args ? args.subjectPrefix : undefined;
             inputs["subjectTemplate"] = args ? args.subjectTemplate : undefined;
             inputs["teamSlug"] = args ? args.teamSlug : undefined;
+            inputs["teamSlugs"] = args ? args.teamSlugs : undefined;
             inputs["defaultClientKeyDSNPublic"] = undefined /*out*/;
         } else {
+            inputs["allowedDomains"] = undefined /*out*/;
+            inputs["dataScrubber"] = undefined /*out*/;
+            inputs["dataScrubberDefaults"] = undefined /*out*/;
             inputs["defaultClientKeyDSNPublic"] = undefined /*out*/;
+            inputs["defaultClientKeyRateLimitCount"] = undefined /*out*/;
+            inputs["defaultClientKeyRateLimitWindow"] = undefined /*out*/;
             inputs["defaultEnvironment"] = undefined /*out*/;
             inputs["name"] = undefined /*out*/;
             inputs["organizationSlug"] = undefined /*out*/;
+            inputs["resolveAge"] = undefined /*out*/;
+            inputs["safeFields"] = undefined /*out*/;
+            inputs["scrapeJavaScript"] = undefined /*out*/;
+            inputs["scrubIPAddresses"] = undefined /*out*/;
+            inputs["securityToken"] = undefined /*out*/;
+            inputs["sensitiveFields"] = undefined /*out*/;
             inputs["slug"] = undefined /*out*/;
             inputs["subjectPrefix"] = undefined /*out*/;
             inputs["subjectTemplate"] = undefined /*out*/;
             inputs["teamSlug"] = undefined /*out*/;
+            inputs["teamSlugs"] = undefined /*out*/;
         }
         if (!opts) {
             opts = {}
@@ -87,6 +153,8 @@ export class Project extends pulumi.CustomResource {
         if (!opts.version) {
             opts.version = utilities.getVersion();
         }
+        const secretOpts = { additionalSecretOutputs: ["securityToken"] };
+        opts = opts ? pulumi.mergeOptions(opts, secretOpts) : secretOpts;
         super(Project.__pulumiType, name, inputs, opts);
     }
 }
@@ -95,11 +163,62 @@ export class Project extends pulumi.CustomResource {
  * The set of arguments for constructing a Project resource.
  */
 export interface ProjectArgs {
+    /**
+     * Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+     */
+    readonly allowedDomains?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Whether Sentry removes sensitive data from events server-side.
+     */
+    readonly dataScrubber?: pulumi.Input<boolean>;
+    /**
+     * Whether the data scrubber applies its default list of sensitive fields.
+     */
+    readonly dataScrubberDefaults?: pulumi.Input<boolean>;
+    /**
+     * The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+     */
+    readonly defaultClientKeyRateLimitCount?: pulumi.Input<number>;
+    /**
+     * The window of the default client key's rate limit, in seconds.
+     */
+    readonly defaultClientKeyRateLimitWindow?: pulumi.Input<number>;
     readonly defaultEnvironment?: pulumi.Input<string>;
     readonly name: pulumi.Input<string>;
-    readonly organizationSlug: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    /**
+     * Hours of inactivity after which issues are resolved automatically, 0 disables it.
+     */
+    readonly resolveAge?: pulumi.Input<number>;
+    /**
+     * Field names the data scrubber must leave as they are.
+     */
+    readonly safeFields?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Whether Sentry fetches JavaScript source files and source maps.
+     */
+    readonly scrapeJavaScript?: pulumi.Input<boolean>;
+    /**
+     * Whether IP addresses are removed from events.
+     */
+    readonly scrubIPAddresses?: pulumi.Input<boolean>;
+    /**
+     * Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+     */
+    readonly securityToken?: pulumi.Input<string>;
+    /**
+     * Additional field names the data scrubber removes.
+     */
+    readonly sensitiveFields?: pulumi.Input<pulumi.Input<string>[]>;
     readonly slug: pulumi.Input<string>;
     readonly subjectPrefix?: pulumi.Input<string>;
     readonly subjectTemplate?: pulumi.Input<string>;
-    readonly teamSlug: pulumi.Input<string>;
+    /**
+     * @deprecated Use teamSlugs instead.
+     */
+    readonly teamSlug?: pulumi.Input<string>;
+    readonly teamSlugs?: pulumi.Input<pulumi.Input<string>[]>;
 }
@@ -0,0 +1,105 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The visibility of an environment of a project. Deleting the resource shows the environment again, as Sentry can't delete environments.
+ */
+export class ProjectEnvironment extends pulumi.CustomResource {
+    /**
+     * Get an existing ProjectEnvironment resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ProjectEnvironment {
+        return new ProjectEnvironment(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:ProjectEnvironment';
+
+    /**
+     * Returns true if the given object is an instance of ProjectEnvironment.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ProjectEnvironment {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ProjectEnvironment.__pulumiType;
+    }
+
+    /**
+     * Hide the environment from the environment selectors of Sentry. Defaults to false.
+     */
+    public readonly hidden!: pulumi.Output<boolean>;
+    /**
+     * The name of the environment, which Sentry adds to the project with the first event sent from it.
+     */
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly projectSlug!: pulumi.Output<string>;
+
+    /**
+     * Create a ProjectEnvironment resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ProjectEnvironmentArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.name === undefined) {
+                throw new Error("Missing required property 'name'");
+            }
+            if (!args || args.projectSlug === undefined) {
+                throw new Error("Missing required property 'projectSlug'");
+            }
+            inputs["hidden"] = args ? args.hidden : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["projectSlug"] = args ? args.projectSlug : undefined;
+        } else {
+            inputs["hidden"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["projectSlug"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(ProjectEnvironment.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ProjectEnvironment resource.
+ */
+export interface ProjectEnvironmentArgs {
+    /**
+     * Hide the environment from the environment selectors of Sentry. Defaults to false.
+     */
+    readonly hidden?: pulumi.Input<boolean>;
+    /**
+     * The name of the environment, which Sentry adds to the project with the first event sent from it.
+     */
+    readonly name: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly projectSlug: pulumi.Input<string>;
+}
@@ -0,0 +1,149 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The inbound data filters of a project. Filters left out are turned off, and deleting the resource turns them all off.
+ */
+export class ProjectInboundFilters extends pulumi.CustomResource {
+    /**
+     * Get an existing ProjectInboundFilters resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ProjectInboundFilters {
+        return new ProjectInboundFilters(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:ProjectInboundFilters';
+
+    /**
+     * Returns true if the given object is an instance of ProjectInboundFilters.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ProjectInboundFilters {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ProjectInboundFilters.__pulumiType;
+    }
+
+    /**
+     * Filter out errors caused by known browser extensions.
+     */
+    public readonly browserExtensions!: pulumi.Output<boolean>;
+    /**
+     * Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+     */
+    public readonly errorMessages!: pulumi.Output<string[]>;
+    /**
+     * Filter out events from these IP addresses or CIDR ranges.
+     */
+    public readonly ipAddresses!: pulumi.Output<string[]>;
+    /**
+     * Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+     */
+    public readonly legacyBrowsers!: pulumi.Output<string[]>;
+    /**
+     * Filter out events coming from localhost.
+     */
+    public readonly localhost!: pulumi.Output<boolean>;
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly projectSlug!: pulumi.Output<string>;
+    /**
+     * Filter out events from releases matching one of these patterns, e.g. *-dev.
+     */
+    public readonly releases!: pulumi.Output<string[]>;
+    /**
+     * Filter out events from known web crawlers.
+     */
+    public readonly webCrawlers!: pulumi.Output<boolean>;
+
+    /**
+     * Create a ProjectInboundFilters resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ProjectInboundFiltersArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.projectSlug === undefined) {
+                throw new Error("Missing required property 'projectSlug'");
+            }
+            inputs["browserExtensions"] = args ? args.browserExtensions : undefined;
+            inputs["errorMessages"] = args ? args.errorMessages : undefined;
+            inputs["ipAddresses"] = args ? args.ipAddresses : undefined;
+            inputs["legacyBrowsers"] = args ? args.legacyBrowsers : undefined;
+            inputs["localhost"] = args ? args.localhost : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["projectSlug"] = args ? args.projectSlug : undefined;
+            inputs["releases"] = args ? args.releases : undefined;
+            inputs["webCrawlers"] = args ? args.webCrawlers : undefined;
+        } else {
+            inputs["browserExtensions"] = undefined /*out*/;
+            inputs["errorMessages"] = undefined /*out*/;
+            inputs["ipAddresses"] = undefined /*out*/;
+            inputs["legacyBrowsers"] = undefined /*out*/;
+            inputs["localhost"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["projectSlug"] = undefined /*out*/;
+            inputs["releases"] = undefined /*out*/;
+            inputs["webCrawlers"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(ProjectInboundFilters.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ProjectInboundFilters resource.
+ */
+export interface ProjectInboundFiltersArgs {
+    /**
+     * Filter out errors caused by known browser extensions.
+     */
+    readonly browserExtensions?: pulumi.Input<boolean>;
+    /**
+     * Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+     */
+    readonly errorMessages?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Filter out events from these IP addresses or CIDR ranges.
+     */
+    readonly ipAddresses?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+     */
+    readonly legacyBrowsers?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Filter out events coming from localhost.
+     */
+    readonly localhost?: pulumi.Input<boolean>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly projectSlug: pulumi.Input<string>;
+    /**
+     * Filter out events from releases matching one of these patterns, e.g. *-dev.
+     */
+    readonly releases?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Filter out events from known web crawlers.
+     */
+    readonly webCrawlers?: pulumi.Input<boolean>;
+}
@@ -0,0 +1,115 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The ownership rules of a project, used to route alerts and assign issues. Deleting the resource removes all the rules.
+ */
+export class ProjectOwnership extends pulumi.CustomResource {
+    /**
+     * Get an existing ProjectOwnership resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ProjectOwnership {
+        return new ProjectOwnership(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:ProjectOwnership';
+
+    /**
+     * Returns true if the given object is an instance of ProjectOwnership.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ProjectOwnership {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ProjectOwnership.__pulumiType;
+    }
+
+    /**
+     * Assign new issues to their owners automatically. Defaults to false.
+     */
+    public readonly autoAssignment!: pulumi.Output<boolean>;
+    /**
+     * Send alerts to all project members when no rule matches an issue. Defaults to true.
+     */
+    public readonly fallthrough!: pulumi.Output<boolean>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly projectSlug!: pulumi.Output<string>;
+    /**
+     * The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+     */
+    public readonly raw!: pulumi.Output<string>;
+
+    /**
+     * Create a ProjectOwnership resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ProjectOwnershipArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.projectSlug === undefined) {
+                throw new Error("Missing required property 'projectSlug'");
+            }
+            if (!args || args.raw === undefined) {
+                throw new Error("Missing required property 'raw'");
+            }
+            inputs["autoAssignment"] = args ? args.autoAssignment : undefined;
+            inputs["fallthrough"] = args ? args.fallthrough : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["projectSlug"] = args ? args.projectSlug : undefined;
+            inputs["raw"] = args ? args.raw : undefined;
+        } else {
+            inputs["autoAssignment"] = undefined /*out*/;
+            inputs["fallthrough"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["projectSlug"] = undefined /*out*/;
+            inputs["raw"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(ProjectOwnership.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ProjectOwnership resource.
+ */
+export interface ProjectOwnershipArgs {
+    /**
+     * Assign new issues to their owners automatically. Defaults to false.
+     */
+    readonly autoAssignment?: pulumi.Input<boolean>;
+    /**
+     * Send alerts to all project members when no rule matches an issue. Defaults to true.
+     */
+    readonly fallthrough?: pulumi.Input<boolean>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly projectSlug: pulumi.Input<string>;
+    /**
+     * The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+     */
+    readonly raw: pulumi.Input<string>;
+}
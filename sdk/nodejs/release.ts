@@ -0,0 +1,142 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * A version of the code deployed in projects of an organization. Sentry refuses to delete releases which have events.
+ */
+export class Release extends pulumi.CustomResource {
+    /**
+     * Get an existing Release resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Release {
+        return new Release(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:Release';
+
+    /**
+     * Returns true if the given object is an instance of Release.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Release {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Release.__pulumiType;
+    }
+
+    /**
+     * When the release was created, in RFC 3339 format.
+     */
+    public /*out*/ readonly dateCreated!: pulumi.Output<string>;
+    /**
+     * When the release went live, in RFC 3339 format. Defaults to unreleased.
+     */
+    public readonly dateReleased!: pulumi.Output<string | undefined>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly projectSlugs!: pulumi.Output<string[]>;
+    /**
+     * A commit or tag of the release, for display.
+     */
+    public readonly ref!: pulumi.Output<string | undefined>;
+    /**
+     * The commits of the release, to associate them with it.
+     */
+    public readonly refs!: pulumi.Output<outputs.ReleaseRef[] | undefined>;
+    /**
+     * A link to the release, e.g. to its build.
+     */
+    public readonly url!: pulumi.Output<string | undefined>;
+    /**
+     * The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+     */
+    public readonly version!: pulumi.Output<string>;
+
+    /**
+     * Create a Release resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ReleaseArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.projectSlugs === undefined) {
+                throw new Error("Missing required property 'projectSlugs'");
+            }
+            if (!args || args.version === undefined) {
+                throw new Error("Missing required property 'version'");
+            }
+            inputs["dateReleased"] = args ? args.dateReleased : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["projectSlugs"] = args ? args.projectSlugs : undefined;
+            inputs["ref"] = args ? args.ref : undefined;
+            inputs["refs"] = args ? args.refs : undefined;
+            inputs["url"] = args ? args.url : undefined;
+            inputs["version"] = args ? args.version : undefined;
+            inputs["dateCreated"] = undefined /*out*/;
+        } else {
+            inputs["dateCreated"] = undefined /*out*/;
+            inputs["dateReleased"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["projectSlugs"] = undefined /*out*/;
+            inputs["ref"] = undefined /*out*/;
+            inputs["refs"] = undefined /*out*/;
+            inputs["url"] = undefined /*out*/;
+            inputs["version"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(Release.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Release resource.
+ */
+export interface ReleaseArgs {
+    /**
+     * When the release went live, in RFC 3339 format. Defaults to unreleased.
+     */
+    readonly dateReleased?: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly projectSlugs: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * A commit or tag of the release, for display.
+     */
+    readonly ref?: pulumi.Input<string>;
+    /**
+     * The commits of the release, to associate them with it.
+     */
+    readonly refs?: pulumi.Input<pulumi.Input<inputs.ReleaseRef>[]>;
+    /**
+     * A link to the release, e.g. to its build.
+     */
+    readonly url?: pulumi.Input<string>;
+    /**
+     * The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+     */
+    readonly version: pulumi.Input<string>;
+}
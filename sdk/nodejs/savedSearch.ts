@@ -0,0 +1,125 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A search of issues shared in an organization.
+ */
+export class SavedSearch extends pulumi.CustomResource {
+    /**
+     * Get an existing SavedSearch resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): SavedSearch {
+        return new SavedSearch(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:SavedSearch';
+
+    /**
+     * Returns true if the given object is an instance of SavedSearch.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SavedSearch {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SavedSearch.__pulumiType;
+    }
+
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    /**
+     * Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+     */
+    public readonly pinned!: pulumi.Output<boolean>;
+    /**
+     * The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+     */
+    public readonly query!: pulumi.Output<string>;
+    /**
+     * The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+     */
+    public readonly sort!: pulumi.Output<string>;
+    /**
+     * Who the search is listed for: organization or owner. Defaults to organization.
+     */
+    public readonly visibility!: pulumi.Output<string>;
+
+    /**
+     * Create a SavedSearch resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: SavedSearchArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.name === undefined) {
+                throw new Error("Missing required property 'name'");
+            }
+            if (!args || args.query === undefined) {
+                throw new Error("Missing required property 'query'");
+            }
+            inputs["name"] = args ? args.name : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["pinned"] = args ? args.pinned : undefined;
+            inputs["query"] = args ? args.query : undefined;
+            inputs["sort"] = args ? args.sort : undefined;
+            inputs["visibility"] = args ? args.visibility : undefined;
+        } else {
+            inputs["name"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["pinned"] = undefined /*out*/;
+            inputs["query"] = undefined /*out*/;
+            inputs["sort"] = undefined /*out*/;
+            inputs["visibility"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(SavedSearch.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SavedSearch resource.
+ */
+export interface SavedSearchArgs {
+    readonly name: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    /**
+     * Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+     */
+    readonly pinned?: pulumi.Input<boolean>;
+    /**
+     * The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+     */
+    readonly query: pulumi.Input<string>;
+    /**
+     * The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+     */
+    readonly sort?: pulumi.Input<string>;
+    /**
+     * Who the search is listed for: organization or owner. Defaults to organization.
+     */
+    readonly visibility?: pulumi.Input<string>;
+}
@@ -0,0 +1,110 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A webhook of a project, posting events to a URL.
+ */
+export class ServiceHook extends pulumi.CustomResource {
+    /**
+     * Get an existing ServiceHook resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ServiceHook {
+        return new ServiceHook(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:ServiceHook';
+
+    /**
+     * Returns true if the given object is an instance of ServiceHook.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ServiceHook {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ServiceHook.__pulumiType;
+    }
+
+    /**
+     * The events posted to the URL: event.alert and/or event.created.
+     */
+    public readonly events!: pulumi.Output<string[]>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly projectSlug!: pulumi.Output<string>;
+    /**
+     * The secret Sentry signs the requests to the URL with, in the X-ServiceHook-Signature header.
+     */
+    public /*out*/ readonly secret!: pulumi.Output<string>;
+    public readonly url!: pulumi.Output<string>;
+
+    /**
+     * Create a ServiceHook resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ServiceHookArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.events === undefined) {
+                throw new Error("Missing required property 'events'");
+            }
+            if (!args || args.projectSlug === undefined) {
+                throw new Error("Missing required property 'projectSlug'");
+            }
+            if (!args || args.url === undefined) {
+                throw new Error("Missing required property 'url'");
+            }
+            inputs["events"] = args ? args.events : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["projectSlug"] = args ? args.projectSlug : undefined;
+            inputs["url"] = args ? args.url : undefined;
+            inputs["secret"] = undefined /*out*/;
+        } else {
+            inputs["events"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["projectSlug"] = undefined /*out*/;
+            inputs["secret"] = undefined /*out*/;
+            inputs["url"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        const secretOpts = { additionalSecretOutputs: ["secret"] };
+        opts = opts ? pulumi.mergeOptions(opts, secretOpts) : secretOpts;
+        super(ServiceHook.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ServiceHook resource.
+ */
+export interface ServiceHookArgs {
+    /**
+     * The events posted to the URL: event.alert and/or event.created.
+     */
+    readonly events: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly projectSlug: pulumi.Input<string>;
+    readonly url: pulumi.Input<string>;
+}
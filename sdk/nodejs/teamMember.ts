@@ -0,0 +1,95 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The membership of an organization member in a team.
+ */
+export class TeamMember extends pulumi.CustomResource {
+    /**
+     * Get an existing TeamMember resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): TeamMember {
+        return new TeamMember(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'sentry:index:TeamMember';
+
+    /**
+     * Returns true if the given object is an instance of TeamMember.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is TeamMember {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === TeamMember.__pulumiType;
+    }
+
+    /**
+     * The ID of an organization member, e.g. the memberId of an OrganizationMember.
+     */
+    public readonly memberId!: pulumi.Output<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    public readonly organizationSlug!: pulumi.Output<string>;
+    public readonly teamSlug!: pulumi.Output<string>;
+
+    /**
+     * Create a TeamMember resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: TeamMemberArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if (!args || args.memberId === undefined) {
+                throw new Error("Missing required property 'memberId'");
+            }
+            if (!args || args.teamSlug === undefined) {
+                throw new Error("Missing required property 'teamSlug'");
+            }
+            inputs["memberId"] = args ? args.memberId : undefined;
+            inputs["organizationSlug"] = args ? args.organizationSlug : undefined;
+            inputs["teamSlug"] = args ? args.teamSlug : undefined;
+        } else {
+            inputs["memberId"] = undefined /*out*/;
+            inputs["organizationSlug"] = undefined /*out*/;
+            inputs["teamSlug"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(TeamMember.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a TeamMember resource.
+ */
+export interface TeamMemberArgs {
+    /**
+     * The ID of an organization member, e.g. the memberId of an OrganizationMember.
+     */
+    readonly memberId: pulumi.Input<string>;
+    /**
+     * Defaults to the sentry:organization provider config.
+     */
+    readonly organizationSlug?: pulumi.Input<string>;
+    readonly teamSlug: pulumi.Input<string>;
+}
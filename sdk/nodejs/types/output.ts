@@ -0,0 +1,121 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+
+/**
+ * A chart, table or number of a dashboard.
+ */
+export interface DashboardWidget {
+    /**
+     * line, area, stacked_area, bar, table, big_number, top_n or world_map.
+     */
+    displayType: string;
+    /**
+     * Assigned by Sentry.
+     */
+    id?: string;
+    layout?: outputs.DashboardWidgetLayout;
+    queries: outputs.DashboardWidgetQuery[];
+    title: string;
+}
+
+/**
+ * The position and size of a widget in the grid of a dashboard, in columns and rows.
+ */
+export interface DashboardWidgetLayout {
+    h: number;
+    w: number;
+    x: number;
+    y: number;
+}
+
+/**
+ * A query of events drawn by a widget of a dashboard.
+ */
+export interface DashboardWidgetQuery {
+    /**
+     * The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+     */
+    conditions?: string;
+    /**
+     * The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+     */
+    fields: string[];
+    /**
+     * Assigned by Sentry.
+     */
+    id?: string;
+    /**
+     * The legend of the query.
+     */
+    name?: string;
+    /**
+     * The field to sort by, prefixed with - for descending order.
+     */
+    orderby?: string;
+}
+
+/**
+ * A threshold of a metric alert rule, with the actions run when it's crossed.
+ */
+export interface MetricAlertRuleTrigger {
+    actions: outputs.MetricAlertRuleTriggerAction[];
+    /**
+     * The value of the metric firing the trigger.
+     */
+    alertThreshold: number;
+    /**
+     * Assigned by Sentry.
+     */
+    id?: string;
+    /**
+     * critical or warning.
+     */
+    label: string;
+}
+
+/**
+ * An action run when a trigger of a metric alert rule fires.
+ */
+export interface MetricAlertRuleTriggerAction {
+    /**
+     * Assigned by Sentry.
+     */
+    id?: string;
+    /**
+     * The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+     */
+    integrationId?: number;
+    /**
+     * The user or team ID, or the channel name for specific targets.
+     */
+    targetIdentifier?: string;
+    /**
+     * user, team, specific or sentry_app.
+     */
+    targetType: string;
+    /**
+     * email, slack, pagerduty, msteams or sentry_app.
+     */
+    type: string;
+}
+
+/**
+ * A commit of a repository of the organization in a release.
+ */
+export interface ReleaseRef {
+    /**
+     * The SHA of the last commit of the release.
+     */
+    commit: string;
+    /**
+     * The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+     */
+    previousCommit?: string;
+    /**
+     * The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+     */
+    repository: string;
+}
@@ -3,5 +3,33 @@
 # *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 # Export this package's modules as members:
+from .client_key import *
+from .dashboard import *
+from .deploy import *
+from .get_organization import *
+from .get_organization_integration import *
+from .get_project import *
+from .get_team import *
+from .issue_alert_rule import *
+from .metric_alert_rule import *
+from .monitor import *
+from .organization_member import *
+from .organization_repository import *
+from .organization_settings import *
 from .project import *
+from .project_environment import *
+from .project_inbound_filters import *
+from .project_ownership import *
 from .provider import *
+from .release import *
+from .saved_search import *
+from .service_hook import *
+from .team import *
+from .team_member import *
+from ._inputs import *
+from . import outputs
+
+# Make subpackages available:
+from . import (
+    config,
+)
@@ -0,0 +1,436 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = [
+    'DashboardWidgetArgs',
+    'DashboardWidgetLayoutArgs',
+    'DashboardWidgetQueryArgs',
+    'MetricAlertRuleTriggerArgs',
+    'MetricAlertRuleTriggerActionArgs',
+    'ReleaseRefArgs',
+]
+
+@pulumi.input_type
+class DashboardWidgetArgs:
+    def __init__(__self__, *,
+                 display_type: pulumi.Input[str],
+                 queries: pulumi.Input[Sequence[pulumi.Input['DashboardWidgetQueryArgs']]],
+                 title: pulumi.Input[str],
+                 id: Optional[pulumi.Input[str]] = None,
+                 layout: Optional[pulumi.Input['DashboardWidgetLayoutArgs']] = None):
+        """
+        A chart, table or number of a dashboard.
+        :param pulumi.Input[str] display_type: line, area, stacked_area, bar, table, big_number, top_n or world_map.
+        :param pulumi.Input[str] id: Assigned by Sentry.
+        """
+        pulumi.set(__self__, "display_type", display_type)
+        pulumi.set(__self__, "queries", queries)
+        pulumi.set(__self__, "title", title)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if layout is not None:
+            pulumi.set(__self__, "layout", layout)
+
+    @property
+    @pulumi.getter(name="displayType")
+    def display_type(self) -> pulumi.Input[str]:
+        """
+        line, area, stacked_area, bar, table, big_number, top_n or world_map.
+        """
+        return pulumi.get(self, "display_type")
+
+    @display_type.setter
+    def display_type(self, value: pulumi.Input[str]):
+        pulumi.set(self, "display_type", value)
+
+    @property
+    @pulumi.getter
+    def queries(self) -> pulumi.Input[Sequence[pulumi.Input['DashboardWidgetQueryArgs']]]:
+        return pulumi.get(self, "queries")
+
+    @queries.setter
+    def queries(self, value: pulumi.Input[Sequence[pulumi.Input['DashboardWidgetQueryArgs']]]):
+        pulumi.set(self, "queries", value)
+
+    @property
+    @pulumi.getter
+    def title(self) -> pulumi.Input[str]:
+        return pulumi.get(self, "title")
+
+    @title.setter
+    def title(self, value: pulumi.Input[str]):
+        pulumi.set(self, "title", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[pulumi.Input[str]]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter
+    def layout(self) -> Optional[pulumi.Input['DashboardWidgetLayoutArgs']]:
+        return pulumi.get(self, "layout")
+
+    @layout.setter
+    def layout(self, value: Optional[pulumi.Input['DashboardWidgetLayoutArgs']]):
+        pulumi.set(self, "layout", value)
+
+
+@pulumi.input_type
+class DashboardWidgetLayoutArgs:
+    def __init__(__self__, *,
+                 h: pulumi.Input[int],
+                 w: pulumi.Input[int],
+                 x: pulumi.Input[int],
+                 y: pulumi.Input[int]):
+        """
+        The position and size of a widget in the grid of a dashboard, in columns and rows.
+        """
+        pulumi.set(__self__, "h", h)
+        pulumi.set(__self__, "w", w)
+        pulumi.set(__self__, "x", x)
+        pulumi.set(__self__, "y", y)
+
+    @property
+    @pulumi.getter
+    def h(self) -> pulumi.Input[int]:
+        return pulumi.get(self, "h")
+
+    @h.setter
+    def h(self, value: pulumi.Input[int]):
+        pulumi.set(self, "h", value)
+
+    @property
+    @pulumi.getter
+    def w(self) -> pulumi.Input[int]:
+        return pulumi.get(self, "w")
+
+    @w.setter
+    def w(self, value: pulumi.Input[int]):
+        pulumi.set(self, "w", value)
+
+    @property
+    @pulumi.getter
+    def x(self) -> pulumi.Input[int]:
+        return pulumi.get(self, "x")
+
+    @x.setter
+    def x(self, value: pulumi.Input[int]):
+        pulumi.set(self, "x", value)
+
+    @property
+    @pulumi.getter
+    def y(self) -> pulumi.Input[int]:
+        return pulumi.get(self, "y")
+
+    @y.setter
+    def y(self, value: pulumi.Input[int]):
+        pulumi.set(self, "y", value)
+
+
+@pulumi.input_type
+class DashboardWidgetQueryArgs:
+    def __init__(__self__, *,
+                 fields: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 conditions: Optional[pulumi.Input[str]] = None,
+                 id: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 orderby: Optional[pulumi.Input[str]] = None):
+        """
+        A query of events drawn by a widget of a dashboard.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] fields: The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+        :param pulumi.Input[str] conditions: The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+        :param pulumi.Input[str] id: Assigned by Sentry.
+        :param pulumi.Input[str] name: The legend of the query.
+        :param pulumi.Input[str] orderby: The field to sort by, prefixed with - for descending order.
+        """
+        pulumi.set(__self__, "fields", fields)
+        if conditions is not None:
+            pulumi.set(__self__, "conditions", conditions)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if orderby is not None:
+            pulumi.set(__self__, "orderby", orderby)
+
+    @property
+    @pulumi.getter
+    def fields(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+        """
+        return pulumi.get(self, "fields")
+
+    @fields.setter
+    def fields(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "fields", value)
+
+    @property
+    @pulumi.getter
+    def conditions(self) -> Optional[pulumi.Input[str]]:
+        """
+        The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+        """
+        return pulumi.get(self, "conditions")
+
+    @conditions.setter
+    def conditions(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "conditions", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[pulumi.Input[str]]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The legend of the query.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def orderby(self) -> Optional[pulumi.Input[str]]:
+        """
+        The field to sort by, prefixed with - for descending order.
+        """
+        return pulumi.get(self, "orderby")
+
+    @orderby.setter
+    def orderby(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "orderby", value)
+
+
+@pulumi.input_type
+class MetricAlertRuleTriggerArgs:
+    def __init__(__self__, *,
+                 actions: pulumi.Input[Sequence[pulumi.Input['MetricAlertRuleTriggerActionArgs']]],
+                 alert_threshold: pulumi.Input[float],
+                 label: pulumi.Input[str],
+                 id: Optional[pulumi.Input[str]] = None):
+        """
+        A threshold of a metric alert rule, with the actions run when it's crossed.
+        :param pulumi.Input[float] alert_threshold: The value of the metric firing the trigger.
+        :param pulumi.Input[str] label: critical or warning.
+        :param pulumi.Input[str] id: Assigned by Sentry.
+        """
+        pulumi.set(__self__, "actions", actions)
+        pulumi.set(__self__, "alert_threshold", alert_threshold)
+        pulumi.set(__self__, "label", label)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+
+    @property
+    @pulumi.getter
+    def actions(self) -> pulumi.Input[Sequence[pulumi.Input['MetricAlertRuleTriggerActionArgs']]]:
+        return pulumi.get(self, "actions")
+
+    @actions.setter
+    def actions(self, value: pulumi.Input[Sequence[pulumi.Input['MetricAlertRuleTriggerActionArgs']]]):
+        pulumi.set(self, "actions", value)
+
+    @property
+    @pulumi.getter(name="alertThreshold")
+    def alert_threshold(self) -> pulumi.Input[float]:
+        """
+        The value of the metric firing the trigger.
+        """
+        return pulumi.get(self, "alert_threshold")
+
+    @alert_threshold.setter
+    def alert_threshold(self, value: pulumi.Input[float]):
+        pulumi.set(self, "alert_threshold", value)
+
+    @property
+    @pulumi.getter
+    def label(self) -> pulumi.Input[str]:
+        """
+        critical or warning.
+        """
+        return pulumi.get(self, "label")
+
+    @label.setter
+    def label(self, value: pulumi.Input[str]):
+        pulumi.set(self, "label", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[pulumi.Input[str]]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "id", value)
+
+
+@pulumi.input_type
+class MetricAlertRuleTriggerActionArgs:
+    def __init__(__self__, *,
+                 target_type: pulumi.Input[str],
+                 type: pulumi.Input[str],
+                 id: Optional[pulumi.Input[str]] = None,
+                 integration_id: Optional[pulumi.Input[int]] = None,
+                 target_identifier: Optional[pulumi.Input[str]] = None):
+        """
+        An action run when a trigger of a metric alert rule fires.
+        :param pulumi.Input[str] target_type: user, team, specific or sentry_app.
+        :param pulumi.Input[str] type: email, slack, pagerduty, msteams or sentry_app.
+        :param pulumi.Input[str] id: Assigned by Sentry.
+        :param pulumi.Input[int] integration_id: The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+        :param pulumi.Input[str] target_identifier: The user or team ID, or the channel name for specific targets.
+        """
+        pulumi.set(__self__, "target_type", target_type)
+        pulumi.set(__self__, "type", type)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if integration_id is not None:
+            pulumi.set(__self__, "integration_id", integration_id)
+        if target_identifier is not None:
+            pulumi.set(__self__, "target_identifier", target_identifier)
+
+    @property
+    @pulumi.getter(name="targetType")
+    def target_type(self) -> pulumi.Input[str]:
+        """
+        user, team, specific or sentry_app.
+        """
+        return pulumi.get(self, "target_type")
+
+    @target_type.setter
+    def target_type(self, value: pulumi.Input[str]):
+        pulumi.set(self, "target_type", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Input[str]:
+        """
+        email, slack, pagerduty, msteams or sentry_app.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: pulumi.Input[str]):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[pulumi.Input[str]]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter(name="integrationId")
+    def integration_id(self) -> Optional[pulumi.Input[int]]:
+        """
+        The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+        """
+        return pulumi.get(self, "integration_id")
+
+    @integration_id.setter
+    def integration_id(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "integration_id", value)
+
+    @property
+    @pulumi.getter(name="targetIdentifier")
+    def target_identifier(self) -> Optional[pulumi.Input[str]]:
+        """
+        The user or team ID, or the channel name for specific targets.
+        """
+        return pulumi.get(self, "target_identifier")
+
+    @target_identifier.setter
+    def target_identifier(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "target_identifier", value)
+
+
+@pulumi.input_type
+class ReleaseRefArgs:
+    def __init__(__self__, *,
+                 commit: pulumi.Input[str],
+                 repository: pulumi.Input[str],
+                 previous_commit: Optional[pulumi.Input[str]] = None):
+        """
+        A commit of a repository of the organization in a release.
+        :param pulumi.Input[str] commit: The SHA of the last commit of the release.
+        :param pulumi.Input[str] repository: The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+        :param pulumi.Input[str] previous_commit: The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+        """
+        pulumi.set(__self__, "commit", commit)
+        pulumi.set(__self__, "repository", repository)
+        if previous_commit is not None:
+            pulumi.set(__self__, "previous_commit", previous_commit)
+
+    @property
+    @pulumi.getter
+    def commit(self) -> pulumi.Input[str]:
+        """
+        The SHA of the last commit of the release.
+        """
+        return pulumi.get(self, "commit")
+
+    @commit.setter
+    def commit(self, value: pulumi.Input[str]):
+        pulumi.set(self, "commit", value)
+
+    @property
+    @pulumi.getter
+    def repository(self) -> pulumi.Input[str]:
+        """
+        The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+        """
+        return pulumi.get(self, "repository")
+
+    @repository.setter
+    def repository(self, value: pulumi.Input[str]):
+        pulumi.set(self, "repository", value)
+
+    @property
+    @pulumi.getter(name="previousCommit")
+    def previous_commit(self) -> Optional[pulumi.Input[str]]:
+        """
+        The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+        """
+        return pulumi.get(self, "previous_commit")
+
+    @previous_commit.setter
+    def previous_commit(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "previous_commit", value)
+
+
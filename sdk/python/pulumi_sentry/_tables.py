@@ -3,19 +3,123 @@
 # *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 SNAKE_TO_CAMEL_CASE_TABLE = {
+    "action_match": "actionMatch",
+    "alert_threshold": "alertThreshold",
+    "allow_shared_issues": "allowSharedIssues",
+    "allowed_domains": "allowedDomains",
+    "auto_assignment": "autoAssignment",
+    "browser_extensions": "browserExtensions",
+    "checkin_margin": "checkinMargin",
+    "data_scrubber": "dataScrubber",
+    "data_scrubber_defaults": "dataScrubberDefaults",
+    "date_created": "dateCreated",
+    "date_finished": "dateFinished",
+    "date_released": "dateReleased",
+    "date_started": "dateStarted",
     "default_client_key_dsn_public": "defaultClientKeyDSNPublic",
+    "default_client_key_rate_limit_count": "defaultClientKeyRateLimitCount",
+    "default_client_key_rate_limit_window": "defaultClientKeyRateLimitWindow",
     "default_environment": "defaultEnvironment",
+    "default_role": "defaultRole",
+    "display_type": "displayType",
+    "dsn_csp": "dsnCSP",
+    "dsn_public": "dsnPublic",
+    "dsn_secret": "dsnSecret",
+    "dsn_security": "dsnSecurity",
+    "enhanced_privacy": "enhancedPrivacy",
+    "error_messages": "errorMessages",
+    "filter_match": "filterMatch",
+    "integration_id": "integrationId",
+    "interval_unit": "intervalUnit",
+    "interval_value": "intervalValue",
+    "ip_addresses": "ipAddresses",
+    "is_active": "isActive",
+    "legacy_browsers": "legacyBrowsers",
+    "max_runtime": "maxRuntime",
+    "member_id": "memberId",
+    "open_membership": "openMembership",
     "organization_slug": "organizationSlug",
+    "previous_commit": "previousCommit",
+    "project_slug": "projectSlug",
+    "project_slugs": "projectSlugs",
+    "rate_limit_count": "rateLimitCount",
+    "rate_limit_window": "rateLimitWindow",
+    "require2_fa": "require2FA",
+    "resolve_age": "resolveAge",
+    "resolve_threshold": "resolveThreshold",
+    "safe_fields": "safeFields",
+    "scrape_java_script": "scrapeJavaScript",
+    "scrub_ip_addresses": "scrubIPAddresses",
+    "security_token": "securityToken",
+    "sensitive_fields": "sensitiveFields",
     "subject_prefix": "subjectPrefix",
     "subject_template": "subjectTemplate",
+    "target_identifier": "targetIdentifier",
+    "target_type": "targetType",
     "team_slug": "teamSlug",
+    "team_slugs": "teamSlugs",
+    "threshold_type": "thresholdType",
+    "time_window": "timeWindow",
+    "web_crawlers": "webCrawlers",
 }
 
 CAMEL_TO_SNAKE_CASE_TABLE = {
+    "actionMatch": "action_match",
+    "alertThreshold": "alert_threshold",
+    "allowSharedIssues": "allow_shared_issues",
+    "allowedDomains": "allowed_domains",
+    "autoAssignment": "auto_assignment",
+    "browserExtensions": "browser_extensions",
+    "checkinMargin": "checkin_margin",
+    "dataScrubber": "data_scrubber",
+    "dataScrubberDefaults": "data_scrubber_defaults",
+    "dateCreated": "date_created",
+    "dateFinished": "date_finished",
+    "dateReleased": "date_released",
+    "dateStarted": "date_started",
     "defaultClientKeyDSNPublic": "default_client_key_dsn_public",
+    "defaultClientKeyRateLimitCount": "default_client_key_rate_limit_count",
+    "defaultClientKeyRateLimitWindow": "default_client_key_rate_limit_window",
     "defaultEnvironment": "default_environment",
+    "defaultRole": "default_role",
+    "displayType": "display_type",
+    "dsnCSP": "dsn_csp",
+    "dsnPublic": "dsn_public",
+    "dsnSecret": "dsn_secret",
+    "dsnSecurity": "dsn_security",
+    "enhancedPrivacy": "enhanced_privacy",
+    "errorMessages": "error_messages",
+    "filterMatch": "filter_match",
+    "integrationId": "integration_id",
+    "intervalUnit": "interval_unit",
+    "intervalValue": "interval_value",
+    "ipAddresses": "ip_addresses",
+    "isActive": "is_active",
+    "legacyBrowsers": "legacy_browsers",
+    "maxRuntime": "max_runtime",
+    "memberId": "member_id",
+    "openMembership": "open_membership",
     "organizationSlug": "organization_slug",
+    "previousCommit": "previous_commit",
+    "projectSlug": "project_slug",
+    "projectSlugs": "project_slugs",
+    "rateLimitCount": "rate_limit_count",
+    "rateLimitWindow": "rate_limit_window",
+    "require2FA": "require2_fa",
+    "resolveAge": "resolve_age",
+    "resolveThreshold": "resolve_threshold",
+    "safeFields": "safe_fields",
+    "scrapeJavaScript": "scrape_java_script",
+    "scrubIPAddresses": "scrub_ip_addresses",
+    "securityToken": "security_token",
+    "sensitiveFields": "sensitive_fields",
     "subjectPrefix": "subject_prefix",
     "subjectTemplate": "subject_template",
+    "targetIdentifier": "target_identifier",
+    "targetType": "target_type",
     "teamSlug": "team_slug",
+    "teamSlugs": "team_slugs",
+    "thresholdType": "threshold_type",
+    "timeWindow": "time_window",
+    "webCrawlers": "web_crawlers",
 }
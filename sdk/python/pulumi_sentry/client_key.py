@@ -0,0 +1,145 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ClientKey']
+
+
+class ClientKey(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 is_active: Optional[pulumi.Input[bool]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 project_slug: Optional[pulumi.Input[str]] = None,
+                 rate_limit_count: Optional[pulumi.Input[int]] = None,
+                 rate_limit_window: Optional[pulumi.Input[int]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Create a ClientKey resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['is_active'] = is_active
+            if name is None:
+                raise TypeError("Missing required property 'name'")
+            __props__['name'] = name
+            __props__['organization_slug'] = organization_slug
+            if project_slug is None:
+                raise TypeError("Missing required property 'project_slug'")
+            __props__['project_slug'] = project_slug
+            __props__['rate_limit_count'] = rate_limit_count
+            __props__['rate_limit_window'] = rate_limit_window
+            __props__['dsn_csp'] = None
+            __props__['dsn_public'] = None
+            __props__['dsn_secret'] = None
+            __props__['dsn_security'] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["dsnSecret"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(ClientKey, __self__).__init__(
+            'sentry:index:ClientKey',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ClientKey':
+        """
+        Get an existing ClientKey resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ClientKey(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="dsnCSP")
+    def dsn_csp(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "dsn_csp")
+
+    @property
+    @pulumi.getter(name="dsnPublic")
+    def dsn_public(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "dsn_public")
+
+    @property
+    @pulumi.getter(name="dsnSecret")
+    def dsn_secret(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "dsn_secret")
+
+    @property
+    @pulumi.getter(name="dsnSecurity")
+    def dsn_security(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "dsn_security")
+
+    @property
+    @pulumi.getter(name="isActive")
+    def is_active(self) -> pulumi.Output[bool]:
+        return pulumi.get(self, "is_active")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="projectSlug")
+    def project_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "project_slug")
+
+    @property
+    @pulumi.getter(name="rateLimitCount")
+    def rate_limit_count(self) -> pulumi.Output[Optional[int]]:
+        return pulumi.get(self, "rate_limit_count")
+
+    @property
+    @pulumi.getter(name="rateLimitWindow")
+    def rate_limit_window(self) -> pulumi.Output[Optional[int]]:
+        return pulumi.get(self, "rate_limit_window")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
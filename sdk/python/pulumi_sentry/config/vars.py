@@ -0,0 +1,39 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = [
+    'api_url',
+    'organization',
+    'skip_credentials_validation',
+    'token',
+]
+
+__config__ = pulumi.Config('sentry')
+
+api_url = __config__.get('apiURL') or _utilities.get_env('SENTRY_URL')
+"""
+The URL of the Sentry API, e.g. https://sentry.io/api/0/. Falls back to the server URL in SENTRY_URL.
+"""
+
+organization = __config__.get('organization') or _utilities.get_env('SENTRY_ORG')
+"""
+The default organization slug for resources that don't set one.
+"""
+
+skip_credentials_validation = __config__.get('skipCredentialsValidation')
+"""
+Skip checking the token against the Sentry API when the provider starts.
+"""
+
+token = __config__.get('token') or _utilities.get_env('SENTRY_AUTH_TOKEN')
+"""
+The Sentry auth token.
+"""
+
@@ -0,0 +1,106 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._inputs import *
+
+__all__ = ['Dashboard']
+
+
+class Dashboard(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 title: Optional[pulumi.Input[str]] = None,
+                 widgets: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['DashboardWidgetArgs']]]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A dashboard of an organization: a grid of widgets charting the results of queries of events.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['DashboardWidgetArgs']]]] widgets: The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['organization_slug'] = organization_slug
+            if title is None:
+                raise TypeError("Missing required property 'title'")
+            __props__['title'] = title
+            __props__['widgets'] = widgets
+        super(Dashboard, __self__).__init__(
+            'sentry:index:Dashboard',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Dashboard':
+        """
+        Get an existing Dashboard resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return Dashboard(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter
+    def title(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "title")
+
+    @property
+    @pulumi.getter
+    def widgets(self) -> pulumi.Output[Optional[Sequence['outputs.DashboardWidget']]]:
+        """
+        The widgets of the dashboard. Changes are applied to widgets by their position in the list.
+        """
+        return pulumi.get(self, "widgets")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
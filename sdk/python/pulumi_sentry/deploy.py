@@ -0,0 +1,146 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['Deploy']
+
+
+class Deploy(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 date_finished: Optional[pulumi.Input[str]] = None,
+                 date_started: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 url: Optional[pulumi.Input[str]] = None,
+                 version: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A deploy of a release to an environment. Sentry keeps deploys as a log: any change records a new deploy, and deleting the resource leaves the deploy in Sentry.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] date_finished: When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+        :param pulumi.Input[str] date_started: When the deploy started, in RFC 3339 format.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[str] url: A link to the deploy, e.g. to its pipeline.
+        :param pulumi.Input[str] version: The version of the release deployed.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['date_finished'] = date_finished
+            __props__['date_started'] = date_started
+            if environment is None:
+                raise TypeError("Missing required property 'environment'")
+            __props__['environment'] = environment
+            __props__['name'] = name
+            __props__['organization_slug'] = organization_slug
+            __props__['url'] = url
+            if version is None:
+                raise TypeError("Missing required property 'version'")
+            __props__['version'] = version
+        super(Deploy, __self__).__init__(
+            'sentry:index:Deploy',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Deploy':
+        """
+        Get an existing Deploy resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return Deploy(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="dateFinished")
+    def date_finished(self) -> pulumi.Output[str]:
+        """
+        When the deploy finished, in RFC 3339 format. Defaults to the time of creation.
+        """
+        return pulumi.get(self, "date_finished")
+
+    @property
+    @pulumi.getter(name="dateStarted")
+    def date_started(self) -> pulumi.Output[Optional[str]]:
+        """
+        When the deploy started, in RFC 3339 format.
+        """
+        return pulumi.get(self, "date_started")
+
+    @property
+    @pulumi.getter
+    def environment(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "environment")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter
+    def url(self) -> pulumi.Output[Optional[str]]:
+        """
+        A link to the deploy, e.g. to its pipeline.
+        """
+        return pulumi.get(self, "url")
+
+    @property
+    @pulumi.getter
+    def version(self) -> pulumi.Output[str]:
+        """
+        The version of the release deployed.
+        """
+        return pulumi.get(self, "version")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,138 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = [
+    'GetOrganizationIntegrationResult',
+    'AwaitableGetOrganizationIntegrationResult',
+    'get_organization_integration',
+]
+
+@pulumi.output_type
+class GetOrganizationIntegrationResult:
+    def __init__(__self__, domain_name=None, external_id=None, id=None, name=None, organization_slug=None, provider_key=None, provider_name=None, status=None):
+        if domain_name and not isinstance(domain_name, str):
+            raise TypeError("Expected argument 'domain_name' to be a str")
+        pulumi.set(__self__, "domain_name", domain_name)
+        if external_id and not isinstance(external_id, str):
+            raise TypeError("Expected argument 'external_id' to be a str")
+        pulumi.set(__self__, "external_id", external_id)
+        if id and not isinstance(id, int):
+            raise TypeError("Expected argument 'id' to be a int")
+        pulumi.set(__self__, "id", id)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if organization_slug and not isinstance(organization_slug, str):
+            raise TypeError("Expected argument 'organization_slug' to be a str")
+        pulumi.set(__self__, "organization_slug", organization_slug)
+        if provider_key and not isinstance(provider_key, str):
+            raise TypeError("Expected argument 'provider_key' to be a str")
+        pulumi.set(__self__, "provider_key", provider_key)
+        if provider_name and not isinstance(provider_name, str):
+            raise TypeError("Expected argument 'provider_name' to be a str")
+        pulumi.set(__self__, "provider_name", provider_name)
+        if status and not isinstance(status, str):
+            raise TypeError("Expected argument 'status' to be a str")
+        pulumi.set(__self__, "status", status)
+
+    @property
+    @pulumi.getter(name="domainName")
+    def domain_name(self) -> str:
+        return pulumi.get(self, "domain_name")
+
+    @property
+    @pulumi.getter(name="externalId")
+    def external_id(self) -> str:
+        """
+        The ID of the integration in the service, e.g. the Slack workspace ID.
+        """
+        return pulumi.get(self, "external_id")
+
+    @property
+    @pulumi.getter
+    def id(self) -> int:
+        """
+        The integration ID used by alert rule actions and repositories.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> str:
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="providerKey")
+    def provider_key(self) -> str:
+        return pulumi.get(self, "provider_key")
+
+    @property
+    @pulumi.getter(name="providerName")
+    def provider_name(self) -> str:
+        return pulumi.get(self, "provider_name")
+
+    @property
+    @pulumi.getter
+    def status(self) -> str:
+        return pulumi.get(self, "status")
+
+
+class AwaitableGetOrganizationIntegrationResult(GetOrganizationIntegrationResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetOrganizationIntegrationResult(
+            domain_name=self.domain_name,
+            external_id=self.external_id,
+            id=self.id,
+            name=self.name,
+            organization_slug=self.organization_slug,
+            provider_key=self.provider_key,
+            provider_name=self.provider_name,
+            status=self.status)
+
+
+def get_organization_integration(name: Optional[str] = None,
+                                 organization_slug: Optional[str] = None,
+                                 provider_key: Optional[str] = None,
+                                 opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetOrganizationIntegrationResult:
+    """
+    Finds an integration installed in an organization, e.g. a Slack workspace, by its provider and name.
+
+
+    :param str name: The name of the integration as shown in Sentry, e.g. the Slack workspace or GitHub owner.
+    :param str organization_slug: Defaults to the sentry:organization provider config.
+    :param str provider_key: The service of the integration, e.g. slack, github or jira.
+    """
+    __args__ = dict()
+    __args__['name'] = name
+    __args__['organizationSlug'] = organization_slug
+    __args__['providerKey'] = provider_key
+    if opts is None:
+        opts = pulumi.InvokeOptions()
+    if opts.version is None:
+        opts.version = _utilities.get_version()
+    __ret__ = pulumi.runtime.invoke('sentry:index:getOrganizationIntegration', __args__, opts=opts, typ=GetOrganizationIntegrationResult).value
+
+    return AwaitableGetOrganizationIntegrationResult(
+        domain_name=__ret__.domain_name,
+        external_id=__ret__.external_id,
+        id=__ret__.id,
+        name=__ret__.name,
+        organization_slug=__ret__.organization_slug,
+        provider_key=__ret__.provider_key,
+        provider_name=__ret__.provider_name,
+        status=__ret__.status)
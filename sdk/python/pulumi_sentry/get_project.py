@@ -0,0 +1,277 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = [
+    'GetProjectResult',
+    'AwaitableGetProjectResult',
+    'get_project',
+]
+
+@pulumi.output_type
+class GetProjectResult:
+    def __init__(__self__, allowed_domains=None, data_scrubber=None, data_scrubber_defaults=None, default_client_key_dsn_public=None, default_client_key_rate_limit_count=None, default_client_key_rate_limit_window=None, default_environment=None, id=None, name=None, organization_slug=None, resolve_age=None, safe_fields=None, scrape_java_script=None, scrub_ip_addresses=None, sensitive_fields=None, slug=None, subject_prefix=None, subject_template=None, team_slug=None, team_slugs=None):
+        if allowed_domains and not isinstance(allowed_domains, list):
+            raise TypeError("Expected argument 'allowed_domains' to be a list")
+        pulumi.set(__self__, "allowed_domains", allowed_domains)
+        if data_scrubber and not isinstance(data_scrubber, bool):
+            raise TypeError("Expected argument 'data_scrubber' to be a bool")
+        pulumi.set(__self__, "data_scrubber", data_scrubber)
+        if data_scrubber_defaults and not isinstance(data_scrubber_defaults, bool):
+            raise TypeError("Expected argument 'data_scrubber_defaults' to be a bool")
+        pulumi.set(__self__, "data_scrubber_defaults", data_scrubber_defaults)
+        if default_client_key_dsn_public and not isinstance(default_client_key_dsn_public, str):
+            raise TypeError("Expected argument 'default_client_key_dsn_public' to be a str")
+        pulumi.set(__self__, "default_client_key_dsn_public", default_client_key_dsn_public)
+        if default_client_key_rate_limit_count and not isinstance(default_client_key_rate_limit_count, int):
+            raise TypeError("Expected argument 'default_client_key_rate_limit_count' to be a int")
+        pulumi.set(__self__, "default_client_key_rate_limit_count", default_client_key_rate_limit_count)
+        if default_client_key_rate_limit_window and not isinstance(default_client_key_rate_limit_window, int):
+            raise TypeError("Expected argument 'default_client_key_rate_limit_window' to be a int")
+        pulumi.set(__self__, "default_client_key_rate_limit_window", default_client_key_rate_limit_window)
+        if default_environment and not isinstance(default_environment, str):
+            raise TypeError("Expected argument 'default_environment' to be a str")
+        pulumi.set(__self__, "default_environment", default_environment)
+        if id and not isinstance(id, str):
+            raise TypeError("Expected argument 'id' to be a str")
+        pulumi.set(__self__, "id", id)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if organization_slug and not isinstance(organization_slug, str):
+            raise TypeError("Expected argument 'organization_slug' to be a str")
+        pulumi.set(__self__, "organization_slug", organization_slug)
+        if resolve_age and not isinstance(resolve_age, int):
+            raise TypeError("Expected argument 'resolve_age' to be a int")
+        pulumi.set(__self__, "resolve_age", resolve_age)
+        if safe_fields and not isinstance(safe_fields, list):
+            raise TypeError("Expected argument 'safe_fields' to be a list")
+        pulumi.set(__self__, "safe_fields", safe_fields)
+        if scrape_java_script and not isinstance(scrape_java_script, bool):
+            raise TypeError("Expected argument 'scrape_java_script' to be a bool")
+        pulumi.set(__self__, "scrape_java_script", scrape_java_script)
+        if scrub_ip_addresses and not isinstance(scrub_ip_addresses, bool):
+            raise TypeError("Expected argument 'scrub_ip_addresses' to be a bool")
+        pulumi.set(__self__, "scrub_ip_addresses", scrub_ip_addresses)
+        if sensitive_fields and not isinstance(sensitive_fields, list):
+            raise TypeError("Expected argument 'sensitive_fields' to be a list")
+        pulumi.set(__self__, "sensitive_fields", sensitive_fields)
+        if slug and not isinstance(slug, str):
+            raise TypeError("Expected argument 'slug' to be a str")
+        pulumi.set(__self__, "slug", slug)
+        if subject_prefix and not isinstance(subject_prefix, str):
+            raise TypeError("Expected argument 'subject_prefix' to be a str")
+        pulumi.set(__self__, "subject_prefix", subject_prefix)
+        if subject_template and not isinstance(subject_template, str):
+            raise TypeError("Expected argument 'subject_template' to be a str")
+        pulumi.set(__self__, "subject_template", subject_template)
+        if team_slug and not isinstance(team_slug, str):
+            raise TypeError("Expected argument 'team_slug' to be a str")
+        pulumi.set(__self__, "team_slug", team_slug)
+        if team_slugs and not isinstance(team_slugs, list):
+            raise TypeError("Expected argument 'team_slugs' to be a list")
+        pulumi.set(__self__, "team_slugs", team_slugs)
+
+    @property
+    @pulumi.getter(name="allowedDomains")
+    def allowed_domains(self) -> Optional[Sequence[str]]:
+        """
+        Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+        """
+        return pulumi.get(self, "allowed_domains")
+
+    @property
+    @pulumi.getter(name="dataScrubber")
+    def data_scrubber(self) -> Optional[bool]:
+        """
+        Whether Sentry removes sensitive data from events server-side.
+        """
+        return pulumi.get(self, "data_scrubber")
+
+    @property
+    @pulumi.getter(name="dataScrubberDefaults")
+    def data_scrubber_defaults(self) -> Optional[bool]:
+        """
+        Whether the data scrubber applies its default list of sensitive fields.
+        """
+        return pulumi.get(self, "data_scrubber_defaults")
+
+    @property
+    @pulumi.getter(name="defaultClientKeyDSNPublic")
+    def default_client_key_dsn_public(self) -> str:
+        return pulumi.get(self, "default_client_key_dsn_public")
+
+    @property
+    @pulumi.getter(name="defaultClientKeyRateLimitCount")
+    def default_client_key_rate_limit_count(self) -> Optional[int]:
+        """
+        The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+        """
+        return pulumi.get(self, "default_client_key_rate_limit_count")
+
+    @property
+    @pulumi.getter(name="defaultClientKeyRateLimitWindow")
+    def default_client_key_rate_limit_window(self) -> Optional[int]:
+        """
+        The window of the default client key's rate limit, in seconds.
+        """
+        return pulumi.get(self, "default_client_key_rate_limit_window")
+
+    @property
+    @pulumi.getter(name="defaultEnvironment")
+    def default_environment(self) -> Optional[str]:
+        return pulumi.get(self, "default_environment")
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> str:
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="resolveAge")
+    def resolve_age(self) -> Optional[int]:
+        """
+        Hours of inactivity after which issues are resolved automatically, 0 disables it.
+        """
+        return pulumi.get(self, "resolve_age")
+
+    @property
+    @pulumi.getter(name="safeFields")
+    def safe_fields(self) -> Optional[Sequence[str]]:
+        """
+        Field names the data scrubber must leave as they are.
+        """
+        return pulumi.get(self, "safe_fields")
+
+    @property
+    @pulumi.getter(name="scrapeJavaScript")
+    def scrape_java_script(self) -> Optional[bool]:
+        """
+        Whether Sentry fetches JavaScript source files and source maps.
+        """
+        return pulumi.get(self, "scrape_java_script")
+
+    @property
+    @pulumi.getter(name="scrubIPAddresses")
+    def scrub_ip_addresses(self) -> Optional[bool]:
+        """
+        Whether IP addresses are removed from events.
+        """
+        return pulumi.get(self, "scrub_ip_addresses")
+
+    @property
+    @pulumi.getter(name="sensitiveFields")
+    def sensitive_fields(self) -> Optional[Sequence[str]]:
+        """
+        Additional field names the data scrubber removes.
+        """
+        return pulumi.get(self, "sensitive_fields")
+
+    @property
+    @pulumi.getter
+    def slug(self) -> str:
+        return pulumi.get(self, "slug")
+
+    @property
+    @pulumi.getter(name="subjectPrefix")
+    def subject_prefix(self) -> Optional[str]:
+        return pulumi.get(self, "subject_prefix")
+
+    @property
+    @pulumi.getter(name="subjectTemplate")
+    def subject_template(self) -> Optional[str]:
+        return pulumi.get(self, "subject_template")
+
+    @property
+    @pulumi.getter(name="teamSlug")
+    def team_slug(self) -> Optional[str]:
+        return pulumi.get(self, "team_slug")
+
+    @property
+    @pulumi.getter(name="teamSlugs")
+    def team_slugs(self) -> Sequence[str]:
+        return pulumi.get(self, "team_slugs")
+
+
+class AwaitableGetProjectResult(GetProjectResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetProjectResult(
+            allowed_domains=self.allowed_domains,
+            data_scrubber=self.data_scrubber,
+            data_scrubber_defaults=self.data_scrubber_defaults,
+            default_client_key_dsn_public=self.default_client_key_dsn_public,
+            default_client_key_rate_limit_count=self.default_client_key_rate_limit_count,
+            default_client_key_rate_limit_window=self.default_client_key_rate_limit_window,
+            default_environment=self.default_environment,
+            id=self.id,
+            name=self.name,
+            organization_slug=self.organization_slug,
+            resolve_age=self.resolve_age,
+            safe_fields=self.safe_fields,
+            scrape_java_script=self.scrape_java_script,
+            scrub_ip_addresses=self.scrub_ip_addresses,
+            sensitive_fields=self.sensitive_fields,
+            slug=self.slug,
+            subject_prefix=self.subject_prefix,
+            subject_template=self.subject_template,
+            team_slug=self.team_slug,
+            team_slugs=self.team_slugs)
+
+
+def get_project(organization_slug: Optional[str] = None,
+                slug: Optional[str] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetProjectResult:
+    """
+    Use this data source to access information about an existing resource.
+
+    :param str organization_slug: Defaults to the sentry:organization provider config.
+    """
+    __args__ = dict()
+    __args__['organizationSlug'] = organization_slug
+    __args__['slug'] = slug
+    if opts is None:
+        opts = pulumi.InvokeOptions()
+    if opts.version is None:
+        opts.version = _utilities.get_version()
+    __ret__ = pulumi.runtime.invoke('sentry:index:getProject', __args__, opts=opts, typ=GetProjectResult).value
+
+    return AwaitableGetProjectResult(
+        allowed_domains=__ret__.allowed_domains,
+        data_scrubber=__ret__.data_scrubber,
+        data_scrubber_defaults=__ret__.data_scrubber_defaults,
+        default_client_key_dsn_public=__ret__.default_client_key_dsn_public,
+        default_client_key_rate_limit_count=__ret__.default_client_key_rate_limit_count,
+        default_client_key_rate_limit_window=__ret__.default_client_key_rate_limit_window,
+        default_environment=__ret__.default_environment,
+        id=__ret__.id,
+        name=__ret__.name,
+        organization_slug=__ret__.organization_slug,
+        resolve_age=__ret__.resolve_age,
+        safe_fields=__ret__.safe_fields,
+        scrape_java_script=__ret__.scrape_java_script,
+        scrub_ip_addresses=__ret__.scrub_ip_addresses,
+        sensitive_fields=__ret__.sensitive_fields,
+        slug=__ret__.slug,
+        subject_prefix=__ret__.subject_prefix,
+        subject_template=__ret__.subject_template,
+        team_slug=__ret__.team_slug,
+        team_slugs=__ret__.team_slugs)
@@ -0,0 +1,87 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = [
+    'GetTeamResult',
+    'AwaitableGetTeamResult',
+    'get_team',
+]
+
+@pulumi.output_type
+class GetTeamResult:
+    def __init__(__self__, id=None, name=None, organization_slug=None, slug=None):
+        if id and not isinstance(id, str):
+            raise TypeError("Expected argument 'id' to be a str")
+        pulumi.set(__self__, "id", id)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if organization_slug and not isinstance(organization_slug, str):
+            raise TypeError("Expected argument 'organization_slug' to be a str")
+        pulumi.set(__self__, "organization_slug", organization_slug)
+        if slug and not isinstance(slug, str):
+            raise TypeError("Expected argument 'slug' to be a str")
+        pulumi.set(__self__, "slug", slug)
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> str:
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter
+    def slug(self) -> str:
+        return pulumi.get(self, "slug")
+
+
+class AwaitableGetTeamResult(GetTeamResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetTeamResult(
+            id=self.id,
+            name=self.name,
+            organization_slug=self.organization_slug,
+            slug=self.slug)
+
+
+def get_team(organization_slug: Optional[str] = None,
+             slug: Optional[str] = None,
+             opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetTeamResult:
+    """
+    Use this data source to access information about an existing resource.
+
+    :param str organization_slug: Defaults to the sentry:organization provider config.
+    """
+    __args__ = dict()
+    __args__['organizationSlug'] = organization_slug
+    __args__['slug'] = slug
+    if opts is None:
+        opts = pulumi.InvokeOptions()
+    if opts.version is None:
+        opts.version = _utilities.get_version()
+    __ret__ = pulumi.runtime.invoke('sentry:index:getTeam', __args__, opts=opts, typ=GetTeamResult).value
+
+    return AwaitableGetTeamResult(
+        id=__ret__.id,
+        name=__ret__.name,
+        organization_slug=__ret__.organization_slug,
+        slug=__ret__.slug)
@@ -0,0 +1,177 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['IssueAlertRule']
+
+
+class IssueAlertRule(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 action_match: Optional[pulumi.Input[str]] = None,
+                 actions: Optional[pulumi.Input[Sequence[pulumi.Input[Mapping[str, Any]]]]] = None,
+                 conditions: Optional[pulumi.Input[Sequence[pulumi.Input[Mapping[str, Any]]]]] = None,
+                 environment: Optional[pulumi.Input[str]] = None,
+                 filter_match: Optional[pulumi.Input[str]] = None,
+                 filters: Optional[pulumi.Input[Sequence[pulumi.Input[Mapping[str, Any]]]]] = None,
+                 frequency: Optional[pulumi.Input[int]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 project_slug: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Create a IssueAlertRule resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] action_match: When to run the actions: all, any or none of the conditions match. Defaults to all.
+        :param pulumi.Input[Sequence[pulumi.Input[Mapping[str, Any]]]] actions: Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+        :param pulumi.Input[Sequence[pulumi.Input[Mapping[str, Any]]]] conditions: Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+        :param pulumi.Input[str] environment: Only trigger the rule for events from this environment.
+        :param pulumi.Input[str] filter_match: How filters are combined: all, any or none. Defaults to all.
+        :param pulumi.Input[Sequence[pulumi.Input[Mapping[str, Any]]]] filters: Filters events must pass for the rule to fire.
+        :param pulumi.Input[int] frequency: Minimum number of minutes between actions for the same issue. Defaults to 30.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['action_match'] = action_match
+            if actions is None:
+                raise TypeError("Missing required property 'actions'")
+            __props__['actions'] = actions
+            __props__['conditions'] = conditions
+            __props__['environment'] = environment
+            __props__['filter_match'] = filter_match
+            __props__['filters'] = filters
+            __props__['frequency'] = frequency
+            if name is None:
+                raise TypeError("Missing required property 'name'")
+            __props__['name'] = name
+            __props__['organization_slug'] = organization_slug
+            if project_slug is None:
+                raise TypeError("Missing required property 'project_slug'")
+            __props__['project_slug'] = project_slug
+        super(IssueAlertRule, __self__).__init__(
+            'sentry:index:IssueAlertRule',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'IssueAlertRule':
+        """
+        Get an existing IssueAlertRule resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return IssueAlertRule(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="actionMatch")
+    def action_match(self) -> pulumi.Output[str]:
+        """
+        When to run the actions: all, any or none of the conditions match. Defaults to all.
+        """
+        return pulumi.get(self, "action_match")
+
+    @property
+    @pulumi.getter
+    def actions(self) -> pulumi.Output[Sequence[Mapping[str, Any]]]:
+        """
+        Actions run when the rule fires, e.g. {"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}.
+        """
+        return pulumi.get(self, "actions")
+
+    @property
+    @pulumi.getter
+    def conditions(self) -> pulumi.Output[Sequence[Mapping[str, Any]]]:
+        """
+        Conditions triggering the rule, e.g. {"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}.
+        """
+        return pulumi.get(self, "conditions")
+
+    @property
+    @pulumi.getter
+    def environment(self) -> pulumi.Output[Optional[str]]:
+        """
+        Only trigger the rule for events from this environment.
+        """
+        return pulumi.get(self, "environment")
+
+    @property
+    @pulumi.getter(name="filterMatch")
+    def filter_match(self) -> pulumi.Output[str]:
+        """
+        How filters are combined: all, any or none. Defaults to all.
+        """
+        return pulumi.get(self, "filter_match")
+
+    @property
+    @pulumi.getter
+    def filters(self) -> pulumi.Output[Sequence[Mapping[str, Any]]]:
+        """
+        Filters events must pass for the rule to fire.
+        """
+        return pulumi.get(self, "filters")
+
+    @property
+    @pulumi.getter
+    def frequency(self) -> pulumi.Output[int]:
+        """
+        Minimum number of minutes between actions for the same issue. Defaults to 30.
+        """
+        return pulumi.get(self, "frequency")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="projectSlug")
+    def project_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "project_slug")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
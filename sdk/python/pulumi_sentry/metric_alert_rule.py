@@ -0,0 +1,190 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._inputs import *
+
+__all__ = ['MetricAlertRule']
+
+
+class MetricAlertRule(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 aggregate: Optional[pulumi.Input[str]] = None,
+                 dataset: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 project_slug: Optional[pulumi.Input[str]] = None,
+                 query: Optional[pulumi.Input[str]] = None,
+                 resolve_threshold: Optional[pulumi.Input[float]] = None,
+                 threshold_type: Optional[pulumi.Input[str]] = None,
+                 time_window: Optional[pulumi.Input[int]] = None,
+                 triggers: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['MetricAlertRuleTriggerArgs']]]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Create a MetricAlertRule resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] aggregate: The metric, e.g. count() or p95(transaction.duration).
+        :param pulumi.Input[str] dataset: events or transactions. Defaults to events.
+        :param pulumi.Input[str] environment: Only count events from this environment.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[str] query: Only count events matching this search query.
+        :param pulumi.Input[float] resolve_threshold: The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+        :param pulumi.Input[str] threshold_type: Whether the alert fires above or below the thresholds. Defaults to above.
+        :param pulumi.Input[int] time_window: The period the metric is aggregated over, in minutes.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if aggregate is None:
+                raise TypeError("Missing required property 'aggregate'")
+            __props__['aggregate'] = aggregate
+            __props__['dataset'] = dataset
+            __props__['environment'] = environment
+            if name is None:
+                raise TypeError("Missing required property 'name'")
+            __props__['name'] = name
+            __props__['organization_slug'] = organization_slug
+            if project_slug is None:
+                raise TypeError("Missing required property 'project_slug'")
+            __props__['project_slug'] = project_slug
+            __props__['query'] = query
+            __props__['resolve_threshold'] = resolve_threshold
+            __props__['threshold_type'] = threshold_type
+            if time_window is None:
+                raise TypeError("Missing required property 'time_window'")
+            __props__['time_window'] = time_window
+            if triggers is None:
+                raise TypeError("Missing required property 'triggers'")
+            __props__['triggers'] = triggers
+        super(MetricAlertRule, __self__).__init__(
+            'sentry:index:MetricAlertRule',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'MetricAlertRule':
+        """
+        Get an existing MetricAlertRule resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return MetricAlertRule(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def aggregate(self) -> pulumi.Output[str]:
+        """
+        The metric, e.g. count() or p95(transaction.duration).
+        """
+        return pulumi.get(self, "aggregate")
+
+    @property
+    @pulumi.getter
+    def dataset(self) -> pulumi.Output[str]:
+        """
+        events or transactions. Defaults to events.
+        """
+        return pulumi.get(self, "dataset")
+
+    @property
+    @pulumi.getter
+    def environment(self) -> pulumi.Output[Optional[str]]:
+        """
+        Only count events from this environment.
+        """
+        return pulumi.get(self, "environment")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="projectSlug")
+    def project_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "project_slug")
+
+    @property
+    @pulumi.getter
+    def query(self) -> pulumi.Output[str]:
+        """
+        Only count events matching this search query.
+        """
+        return pulumi.get(self, "query")
+
+    @property
+    @pulumi.getter(name="resolveThreshold")
+    def resolve_threshold(self) -> pulumi.Output[Optional[float]]:
+        """
+        The value of the metric resolving the alert. Defaults to the thresholds of the triggers.
+        """
+        return pulumi.get(self, "resolve_threshold")
+
+    @property
+    @pulumi.getter(name="thresholdType")
+    def threshold_type(self) -> pulumi.Output[str]:
+        """
+        Whether the alert fires above or below the thresholds. Defaults to above.
+        """
+        return pulumi.get(self, "threshold_type")
+
+    @property
+    @pulumi.getter(name="timeWindow")
+    def time_window(self) -> pulumi.Output[int]:
+        """
+        The period the metric is aggregated over, in minutes.
+        """
+        return pulumi.get(self, "time_window")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Sequence['outputs.MetricAlertRuleTrigger']]:
+        return pulumi.get(self, "triggers")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,181 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['Monitor']
+
+
+class Monitor(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 checkin_margin: Optional[pulumi.Input[int]] = None,
+                 interval_unit: Optional[pulumi.Input[str]] = None,
+                 interval_value: Optional[pulumi.Input[int]] = None,
+                 max_runtime: Optional[pulumi.Input[int]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 project_slug: Optional[pulumi.Input[str]] = None,
+                 schedule: Optional[pulumi.Input[str]] = None,
+                 slug: Optional[pulumi.Input[str]] = None,
+                 timezone: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A cron monitor of a project, alerting when a scheduled job misses a check-in or runs for too long.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[int] checkin_margin: How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+        :param pulumi.Input[str] interval_unit: minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+        :param pulumi.Input[int] interval_value: How many intervalUnits there are between runs of the job.
+        :param pulumi.Input[int] max_runtime: How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[str] schedule: The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+        :param pulumi.Input[str] slug: The slug jobs check in with.
+        :param pulumi.Input[str] timezone: The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['checkin_margin'] = checkin_margin
+            __props__['interval_unit'] = interval_unit
+            __props__['interval_value'] = interval_value
+            __props__['max_runtime'] = max_runtime
+            if name is None:
+                raise TypeError("Missing required property 'name'")
+            __props__['name'] = name
+            __props__['organization_slug'] = organization_slug
+            if project_slug is None:
+                raise TypeError("Missing required property 'project_slug'")
+            __props__['project_slug'] = project_slug
+            __props__['schedule'] = schedule
+            if slug is None:
+                raise TypeError("Missing required property 'slug'")
+            __props__['slug'] = slug
+            __props__['timezone'] = timezone
+        super(Monitor, __self__).__init__(
+            'sentry:index:Monitor',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Monitor':
+        """
+        Get an existing Monitor resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return Monitor(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="checkinMargin")
+    def checkin_margin(self) -> pulumi.Output[Optional[int]]:
+        """
+        How many minutes late a check-in can be before the job is reported as missed. Defaults to what Sentry has.
+        """
+        return pulumi.get(self, "checkin_margin")
+
+    @property
+    @pulumi.getter(name="intervalUnit")
+    def interval_unit(self) -> pulumi.Output[Optional[str]]:
+        """
+        minute, hour, day, week, month or year. Set together with intervalValue instead of schedule.
+        """
+        return pulumi.get(self, "interval_unit")
+
+    @property
+    @pulumi.getter(name="intervalValue")
+    def interval_value(self) -> pulumi.Output[Optional[int]]:
+        """
+        How many intervalUnits there are between runs of the job.
+        """
+        return pulumi.get(self, "interval_value")
+
+    @property
+    @pulumi.getter(name="maxRuntime")
+    def max_runtime(self) -> pulumi.Output[Optional[int]]:
+        """
+        How many minutes the job can run before it's reported as failed. Defaults to what Sentry has.
+        """
+        return pulumi.get(self, "max_runtime")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="projectSlug")
+    def project_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "project_slug")
+
+    @property
+    @pulumi.getter
+    def schedule(self) -> pulumi.Output[Optional[str]]:
+        """
+        The crontab the job runs on, e.g. "0 * * * *" or "@daily". Set either this or intervalValue and intervalUnit.
+        """
+        return pulumi.get(self, "schedule")
+
+    @property
+    @pulumi.getter
+    def slug(self) -> pulumi.Output[str]:
+        """
+        The slug jobs check in with.
+        """
+        return pulumi.get(self, "slug")
+
+    @property
+    @pulumi.getter
+    def timezone(self) -> pulumi.Output[str]:
+        """
+        The timezone of the crontab, e.g. Europe/Warsaw. Defaults to UTC.
+        """
+        return pulumi.get(self, "timezone")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,137 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['OrganizationMember']
+
+
+class OrganizationMember(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 email: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 role: Optional[pulumi.Input[str]] = None,
+                 team_slugs: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A member of an organization, invited by email on creation and removed from the organization on deletion.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] email: The email the invitation is sent to.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[str] role: One of member, admin, manager, owner or billing. Defaults to member.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] team_slugs: The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if email is None:
+                raise TypeError("Missing required property 'email'")
+            __props__['email'] = email
+            __props__['organization_slug'] = organization_slug
+            __props__['role'] = role
+            __props__['team_slugs'] = team_slugs
+            __props__['member_id'] = None
+            __props__['pending'] = None
+        super(OrganizationMember, __self__).__init__(
+            'sentry:index:OrganizationMember',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'OrganizationMember':
+        """
+        Get an existing OrganizationMember resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return OrganizationMember(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def email(self) -> pulumi.Output[str]:
+        """
+        The email the invitation is sent to.
+        """
+        return pulumi.get(self, "email")
+
+    @property
+    @pulumi.getter(name="memberId")
+    def member_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the member, e.g. for TeamMember resources.
+        """
+        return pulumi.get(self, "member_id")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter
+    def pending(self) -> pulumi.Output[bool]:
+        """
+        Whether the invitation has not been accepted yet.
+        """
+        return pulumi.get(self, "pending")
+
+    @property
+    @pulumi.getter
+    def role(self) -> pulumi.Output[str]:
+        """
+        One of member, admin, manager, owner or billing. Defaults to member.
+        """
+        return pulumi.get(self, "role")
+
+    @property
+    @pulumi.getter(name="teamSlugs")
+    def team_slugs(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The teams of the member. Left out, teams are not managed, e.g. to use TeamMember resources instead.
+        """
+        return pulumi.get(self, "team_slugs")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,128 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['OrganizationRepository']
+
+
+class OrganizationRepository(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 integration_id: Optional[pulumi.Input[int]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A code repository linked to an organization through an integration such as GitHub, so that releases can reference its commits.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[int] integration_id: The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+        :param pulumi.Input[str] name: The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if integration_id is None:
+                raise TypeError("Missing required property 'integration_id'")
+            __props__['integration_id'] = integration_id
+            if name is None:
+                raise TypeError("Missing required property 'name'")
+            __props__['name'] = name
+            __props__['organization_slug'] = organization_slug
+            __props__['provider'] = None
+            __props__['url'] = None
+        super(OrganizationRepository, __self__).__init__(
+            'sentry:index:OrganizationRepository',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'OrganizationRepository':
+        """
+        Get an existing OrganizationRepository resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return OrganizationRepository(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="integrationId")
+    def integration_id(self) -> pulumi.Output[int]:
+        """
+        The integration hosting the repository, e.g. the `id` returned by getOrganizationIntegration.
+        """
+        return pulumi.get(self, "integration_id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the repository in the service of the integration, e.g. `owner/repo` on GitHub.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter
+    def provider(self) -> pulumi.Output[str]:
+        """
+        Assigned by Sentry, e.g. `integrations:github`.
+        """
+        return pulumi.get(self, "provider")
+
+    @property
+    @pulumi.getter
+    def url(self) -> pulumi.Output[str]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "url")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
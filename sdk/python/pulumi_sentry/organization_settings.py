@@ -0,0 +1,201 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['OrganizationSettings']
+
+
+class OrganizationSettings(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_shared_issues: Optional[pulumi.Input[bool]] = None,
+                 data_scrubber: Optional[pulumi.Input[bool]] = None,
+                 data_scrubber_defaults: Optional[pulumi.Input[bool]] = None,
+                 default_role: Optional[pulumi.Input[str]] = None,
+                 enhanced_privacy: Optional[pulumi.Input[bool]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 open_membership: Optional[pulumi.Input[bool]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 require2_fa: Optional[pulumi.Input[bool]] = None,
+                 safe_fields: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 scrub_ip_addresses: Optional[pulumi.Input[bool]] = None,
+                 sensitive_fields: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        The settings of an existing organization. Settings left out keep what they have in Sentry. Creating the resource adopts the organization, and deleting it leaves the organization and its settings as they are.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] allow_shared_issues: Allow sharing of limited details on issues to anonymous users.
+        :param pulumi.Input[bool] data_scrubber: Require server-side data scrubbing in all projects.
+        :param pulumi.Input[bool] data_scrubber_defaults: Require the default scrubbers in all projects.
+        :param pulumi.Input[str] default_role: The role of new members: member, admin, manager, owner or billing.
+        :param pulumi.Input[bool] enhanced_privacy: Keep source code and other sensitive data out of notifications.
+        :param pulumi.Input[bool] open_membership: Let members join any team of the organization.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[bool] require2_fa: Require members to enable two-factor authentication.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] safe_fields: Field names data scrubbers ignore in all projects.
+        :param pulumi.Input[bool] scrub_ip_addresses: Keep IP addresses out of events of all projects.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] sensitive_fields: Additional field names to scrub in all projects.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['allow_shared_issues'] = allow_shared_issues
+            __props__['data_scrubber'] = data_scrubber
+            __props__['data_scrubber_defaults'] = data_scrubber_defaults
+            __props__['default_role'] = default_role
+            __props__['enhanced_privacy'] = enhanced_privacy
+            __props__['name'] = name
+            __props__['open_membership'] = open_membership
+            __props__['organization_slug'] = organization_slug
+            __props__['require2_fa'] = require2_fa
+            __props__['safe_fields'] = safe_fields
+            __props__['scrub_ip_addresses'] = scrub_ip_addresses
+            __props__['sensitive_fields'] = sensitive_fields
+        super(OrganizationSettings, __self__).__init__(
+            'sentry:index:OrganizationSettings',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'OrganizationSettings':
+        """
+        Get an existing OrganizationSettings resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return OrganizationSettings(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="allowSharedIssues")
+    def allow_shared_issues(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Allow sharing of limited details on issues to anonymous users.
+        """
+        return pulumi.get(self, "allow_shared_issues")
+
+    @property
+    @pulumi.getter(name="dataScrubber")
+    def data_scrubber(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Require server-side data scrubbing in all projects.
+        """
+        return pulumi.get(self, "data_scrubber")
+
+    @property
+    @pulumi.getter(name="dataScrubberDefaults")
+    def data_scrubber_defaults(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Require the default scrubbers in all projects.
+        """
+        return pulumi.get(self, "data_scrubber_defaults")
+
+    @property
+    @pulumi.getter(name="defaultRole")
+    def default_role(self) -> pulumi.Output[Optional[str]]:
+        """
+        The role of new members: member, admin, manager, owner or billing.
+        """
+        return pulumi.get(self, "default_role")
+
+    @property
+    @pulumi.getter(name="enhancedPrivacy")
+    def enhanced_privacy(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Keep source code and other sensitive data out of notifications.
+        """
+        return pulumi.get(self, "enhanced_privacy")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="openMembership")
+    def open_membership(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Let members join any team of the organization.
+        """
+        return pulumi.get(self, "open_membership")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="require2FA")
+    def require2_fa(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Require members to enable two-factor authentication.
+        """
+        return pulumi.get(self, "require2_fa")
+
+    @property
+    @pulumi.getter(name="safeFields")
+    def safe_fields(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Field names data scrubbers ignore in all projects.
+        """
+        return pulumi.get(self, "safe_fields")
+
+    @property
+    @pulumi.getter(name="scrubIPAddresses")
+    def scrub_ip_addresses(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Keep IP addresses out of events of all projects.
+        """
+        return pulumi.get(self, "scrub_ip_addresses")
+
+    @property
+    @pulumi.getter(name="sensitiveFields")
+    def sensitive_fields(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Additional field names to scrub in all projects.
+        """
+        return pulumi.get(self, "sensitive_fields")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,369 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+
+__all__ = [
+    'DashboardWidget',
+    'DashboardWidgetLayout',
+    'DashboardWidgetQuery',
+    'MetricAlertRuleTrigger',
+    'MetricAlertRuleTriggerAction',
+    'ReleaseRef',
+]
+
+@pulumi.output_type
+class DashboardWidget(dict):
+    """
+    A chart, table or number of a dashboard.
+    """
+    def __init__(__self__, *,
+                 display_type: str,
+                 queries: Sequence['outputs.DashboardWidgetQuery'],
+                 title: str,
+                 id: Optional[str] = None,
+                 layout: Optional['outputs.DashboardWidgetLayout'] = None):
+        """
+        A chart, table or number of a dashboard.
+        :param str display_type: line, area, stacked_area, bar, table, big_number, top_n or world_map.
+        :param str id: Assigned by Sentry.
+        """
+        pulumi.set(__self__, "display_type", display_type)
+        pulumi.set(__self__, "queries", queries)
+        pulumi.set(__self__, "title", title)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if layout is not None:
+            pulumi.set(__self__, "layout", layout)
+
+    @property
+    @pulumi.getter(name="displayType")
+    def display_type(self) -> str:
+        """
+        line, area, stacked_area, bar, table, big_number, top_n or world_map.
+        """
+        return pulumi.get(self, "display_type")
+
+    @property
+    @pulumi.getter
+    def queries(self) -> Sequence['outputs.DashboardWidgetQuery']:
+        return pulumi.get(self, "queries")
+
+    @property
+    @pulumi.getter
+    def title(self) -> str:
+        return pulumi.get(self, "title")
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[str]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def layout(self) -> Optional['outputs.DashboardWidgetLayout']:
+        return pulumi.get(self, "layout")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class DashboardWidgetLayout(dict):
+    """
+    The position and size of a widget in the grid of a dashboard, in columns and rows.
+    """
+    def __init__(__self__, *,
+                 h: int,
+                 w: int,
+                 x: int,
+                 y: int):
+        """
+        The position and size of a widget in the grid of a dashboard, in columns and rows.
+        """
+        pulumi.set(__self__, "h", h)
+        pulumi.set(__self__, "w", w)
+        pulumi.set(__self__, "x", x)
+        pulumi.set(__self__, "y", y)
+
+    @property
+    @pulumi.getter
+    def h(self) -> int:
+        return pulumi.get(self, "h")
+
+    @property
+    @pulumi.getter
+    def w(self) -> int:
+        return pulumi.get(self, "w")
+
+    @property
+    @pulumi.getter
+    def x(self) -> int:
+        return pulumi.get(self, "x")
+
+    @property
+    @pulumi.getter
+    def y(self) -> int:
+        return pulumi.get(self, "y")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class DashboardWidgetQuery(dict):
+    """
+    A query of events drawn by a widget of a dashboard.
+    """
+    def __init__(__self__, *,
+                 fields: Sequence[str],
+                 conditions: Optional[str] = None,
+                 id: Optional[str] = None,
+                 name: Optional[str] = None,
+                 orderby: Optional[str] = None):
+        """
+        A query of events drawn by a widget of a dashboard.
+        :param Sequence[str] fields: The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+        :param str conditions: The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+        :param str id: Assigned by Sentry.
+        :param str name: The legend of the query.
+        :param str orderby: The field to sort by, prefixed with - for descending order.
+        """
+        pulumi.set(__self__, "fields", fields)
+        if conditions is not None:
+            pulumi.set(__self__, "conditions", conditions)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if orderby is not None:
+            pulumi.set(__self__, "orderby", orderby)
+
+    @property
+    @pulumi.getter
+    def fields(self) -> Sequence[str]:
+        """
+        The fields of the events, e.g. `count()` or `p95(transaction.duration)`.
+        """
+        return pulumi.get(self, "fields")
+
+    @property
+    @pulumi.getter
+    def conditions(self) -> Optional[str]:
+        """
+        The search query the events must match, e.g. `transaction:/checkout`. Defaults to all events.
+        """
+        return pulumi.get(self, "conditions")
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[str]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[str]:
+        """
+        The legend of the query.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def orderby(self) -> Optional[str]:
+        """
+        The field to sort by, prefixed with - for descending order.
+        """
+        return pulumi.get(self, "orderby")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class MetricAlertRuleTrigger(dict):
+    """
+    A threshold of a metric alert rule, with the actions run when it's crossed.
+    """
+    def __init__(__self__, *,
+                 actions: Sequence['outputs.MetricAlertRuleTriggerAction'],
+                 alert_threshold: float,
+                 label: str,
+                 id: Optional[str] = None):
+        """
+        A threshold of a metric alert rule, with the actions run when it's crossed.
+        :param float alert_threshold: The value of the metric firing the trigger.
+        :param str label: critical or warning.
+        :param str id: Assigned by Sentry.
+        """
+        pulumi.set(__self__, "actions", actions)
+        pulumi.set(__self__, "alert_threshold", alert_threshold)
+        pulumi.set(__self__, "label", label)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+
+    @property
+    @pulumi.getter
+    def actions(self) -> Sequence['outputs.MetricAlertRuleTriggerAction']:
+        return pulumi.get(self, "actions")
+
+    @property
+    @pulumi.getter(name="alertThreshold")
+    def alert_threshold(self) -> float:
+        """
+        The value of the metric firing the trigger.
+        """
+        return pulumi.get(self, "alert_threshold")
+
+    @property
+    @pulumi.getter
+    def label(self) -> str:
+        """
+        critical or warning.
+        """
+        return pulumi.get(self, "label")
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[str]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "id")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class MetricAlertRuleTriggerAction(dict):
+    """
+    An action run when a trigger of a metric alert rule fires.
+    """
+    def __init__(__self__, *,
+                 target_type: str,
+                 type: str,
+                 id: Optional[str] = None,
+                 integration_id: Optional[int] = None,
+                 target_identifier: Optional[str] = None):
+        """
+        An action run when a trigger of a metric alert rule fires.
+        :param str target_type: user, team, specific or sentry_app.
+        :param str type: email, slack, pagerduty, msteams or sentry_app.
+        :param str id: Assigned by Sentry.
+        :param int integration_id: The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+        :param str target_identifier: The user or team ID, or the channel name for specific targets.
+        """
+        pulumi.set(__self__, "target_type", target_type)
+        pulumi.set(__self__, "type", type)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if integration_id is not None:
+            pulumi.set(__self__, "integration_id", integration_id)
+        if target_identifier is not None:
+            pulumi.set(__self__, "target_identifier", target_identifier)
+
+    @property
+    @pulumi.getter(name="targetType")
+    def target_type(self) -> str:
+        """
+        user, team, specific or sentry_app.
+        """
+        return pulumi.get(self, "target_type")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        """
+        email, slack, pagerduty, msteams or sentry_app.
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[str]:
+        """
+        Assigned by Sentry.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter(name="integrationId")
+    def integration_id(self) -> Optional[int]:
+        """
+        The integration sending the notification, for types other than email, e.g. the `id` returned by getOrganizationIntegration.
+        """
+        return pulumi.get(self, "integration_id")
+
+    @property
+    @pulumi.getter(name="targetIdentifier")
+    def target_identifier(self) -> Optional[str]:
+        """
+        The user or team ID, or the channel name for specific targets.
+        """
+        return pulumi.get(self, "target_identifier")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ReleaseRef(dict):
+    """
+    A commit of a repository of the organization in a release.
+    """
+    def __init__(__self__, *,
+                 commit: str,
+                 repository: str,
+                 previous_commit: Optional[str] = None):
+        """
+        A commit of a repository of the organization in a release.
+        :param str commit: The SHA of the last commit of the release.
+        :param str repository: The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+        :param str previous_commit: The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+        """
+        pulumi.set(__self__, "commit", commit)
+        pulumi.set(__self__, "repository", repository)
+        if previous_commit is not None:
+            pulumi.set(__self__, "previous_commit", previous_commit)
+
+    @property
+    @pulumi.getter
+    def commit(self) -> str:
+        """
+        The SHA of the last commit of the release.
+        """
+        return pulumi.get(self, "commit")
+
+    @property
+    @pulumi.getter
+    def repository(self) -> str:
+        """
+        The name of the repository, as connected to the organization, e.g. HealthByRo/pulumi-sentry.
+        """
+        return pulumi.get(self, "repository")
+
+    @property
+    @pulumi.getter(name="previousCommit")
+    def previous_commit(self) -> Optional[str]:
+        """
+        The SHA of the last commit of the previous release. Defaults to the commits of the last release.
+        """
+        return pulumi.get(self, "previous_commit")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
@@ -15,13 +15,25 @@ class Project(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allowed_domains: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 data_scrubber: Optional[pulumi.Input[bool]] = None,
+                 data_scrubber_defaults: Optional[pulumi.Input[bool]] = None,
+                 default_client_key_rate_limit_count: Optional[pulumi.Input[int]] = None,
+                 default_client_key_rate_limit_window: Optional[pulumi.Input[int]] = None,
                  default_environment: Optional[pulumi.Input[str]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  organization_slug: Optional[pulumi.Input[str]] = None,
+                 resolve_age: Optional[pulumi.Input[int]] = None,
+                 safe_fields: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 scrape_java_script: Optional[pulumi.Input[bool]] = None,
+                 scrub_ip_addresses: Optional[pulumi.Input[bool]] = None,
+                 security_token: Optional[pulumi.Input[str]] = None,
+                 sensitive_fields: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  slug: Optional[pulumi.Input[str]] = None,
                  subject_prefix: Optional[pulumi.Input[str]] = None,
                  subject_template: Optional[pulumi.Input[str]] = None,
                  team_slug: Optional[pulumi.Input[str]] = None,
+                 team_slugs: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  __props__=None,
                  __name__=None,
                  __opts__=None):
@@ -29,6 +41,18 @@ class Project(pulumi.CustomResource):
         Create a Project resource with the given unique name, props, and options.
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_domains: Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+        :param pulumi.Input[bool] data_scrubber: Whether Sentry removes sensitive data from events server-side.
+        :param pulumi.Input[bool] data_scrubber_defaults: Whether the data scrubber applies its default list of sensitive fields.
+        :param pulumi.Input[int] default_client_key_rate_limit_count: The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+        :param pulumi.Input[int] default_client_key_rate_limit_window: The window of the default client key's rate limit, in seconds.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[int] resolve_age: Hours of inactivity after which issues are resolved automatically, 0 disables it.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] safe_fields: Field names the data scrubber must leave as they are.
+        :param pulumi.Input[bool] scrape_java_script: Whether Sentry fetches JavaScript source files and source maps.
+        :param pulumi.Input[bool] scrub_ip_addresses: Whether IP addresses are removed from events.
+        :param pulumi.Input[str] security_token: Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] sensitive_fields: Additional field names the data scrubber removes.
         """
         if __name__ is not None:
             warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
@@ -47,22 +71,35 @@ class Project(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = dict()
 
+            __props__['allowed_domains'] = allowed_domains
+            __props__['data_scrubber'] = data_scrubber
+            __props__['data_scrubber_defaults'] = data_scrubber_defaults
+            __props__['default_client_key_rate_limit_count'] = default_client_key_rate_limit_count
+            __props__['default_client_key_rate_limit_window'] = default_client_key_rate_limit_window
             __props__['default_environment'] = default_environment
             if name is None:
                 raise TypeError("Missing required property 'name'")
             __props__['name'] = name
-            if organization_slug is None:
-                raise TypeError("Missing required property 'organization_slug'")
             __props__['organization_slug'] = organization_slug
+            __props__['resolve_age'] = resolve_age
+            __props__['safe_fields'] = safe_fields
+            __props__['scrape_java_script'] = scrape_java_script
+            __props__['scrub_ip_addresses'] = scrub_ip_addresses
+            __props__['security_token'] = security_token
+            __props__['sensitive_fields'] = sensitive_fields
             if slug is None:
                 raise TypeError("Missing required property 'slug'")
             __props__['slug'] = slug
             __props__['subject_prefix'] = subject_prefix
             __props__['subject_template'] = subject_template
-            if team_slug is None:
-                raise TypeError("Missing required property 'team_slug'")
+            if team_slug is not None:
+                warnings.warn("""Use teamSlugs instead.""", DeprecationWarning)
+                pulumi.log.warn("team_slug is deprecated: Use teamSlugs instead.")
             __props__['team_slug'] = team_slug
+            __props__['team_slugs'] = team_slugs
             __props__['default_client_key_dsn_public'] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["securityToken"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(Project, __self__).__init__(
             'sentry:index:Project',
             resource_name,
@@ -87,11 +124,51 @@ class Project(pulumi.CustomResource):
 
         return Project(resource_name, opts=opts, __props__=__props__)
 
+    @property
+    @pulumi.getter(name="allowedDomains")
+    def allowed_domains(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Origins allowed to submit events, e.g. for the JavaScript SDK. Use "*" to allow any origin.
+        """
+        return pulumi.get(self, "allowed_domains")
+
+    @property
+    @pulumi.getter(name="dataScrubber")
+    def data_scrubber(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether Sentry removes sensitive data from events server-side.
+        """
+        return pulumi.get(self, "data_scrubber")
+
+    @property
+    @pulumi.getter(name="dataScrubberDefaults")
+    def data_scrubber_defaults(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the data scrubber applies its default list of sensitive fields.
+        """
+        return pulumi.get(self, "data_scrubber_defaults")
+
     @property
     @pulumi.getter(name="defaultClientKeyDSNPublic")
     def default_client_key_dsn_public(self) -> pulumi.Output[Optional[str]]:
         return pulumi.get(self, "default_client_key_dsn_public")
 
+    @property
+    @pulumi.getter(name="defaultClientKeyRateLimitCount")
+    def default_client_key_rate_limit_count(self) -> pulumi.Output[Optional[int]]:
+        """
+        The number of events the default client key can send in defaultClientKeyRateLimitWindow seconds.
+        """
+        return pulumi.get(self, "default_client_key_rate_limit_count")
+
+    @property
+    @pulumi.getter(name="defaultClientKeyRateLimitWindow")
+    def default_client_key_rate_limit_window(self) -> pulumi.Output[Optional[int]]:
+        """
+        The window of the default client key's rate limit, in seconds.
+        """
+        return pulumi.get(self, "default_client_key_rate_limit_window")
+
     @property
     @pulumi.getter(name="defaultEnvironment")
     def default_environment(self) -> pulumi.Output[Optional[str]]:
@@ -107,6 +184,54 @@ class Project(pulumi.CustomResource):
     def organization_slug(self) -> pulumi.Output[Optional[str]]:
         return pulumi.get(self, "organization_slug")
 
+    @property
+    @pulumi.getter(name="resolveAge")
+    def resolve_age(self) -> pulumi.Output[Optional[int]]:
+        """
+        Hours of inactivity after which issues are resolved automatically, 0 disables it.
+        """
+        return pulumi.get(self, "resolve_age")
+
+    @property
+    @pulumi.getter(name="safeFields")
+    def safe_fields(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Field names the data scrubber must leave as they are.
+        """
+        return pulumi.get(self, "safe_fields")
+
+    @property
+    @pulumi.getter(name="scrapeJavaScript")
+    def scrape_java_script(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether Sentry fetches JavaScript source files and source maps.
+        """
+        return pulumi.get(self, "scrape_java_script")
+
+    @property
+    @pulumi.getter(name="scrubIPAddresses")
+    def scrub_ip_addresses(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether IP addresses are removed from events.
+        """
+        return pulumi.get(self, "scrub_ip_addresses")
+
+    @property
+    @pulumi.getter(name="securityToken")
+    def security_token(self) -> pulumi.Output[Optional[str]]:
+        """
+        Token sent in the X-Sentry-Token header when scraping JavaScript sources.
+        """
+        return pulumi.get(self, "security_token")
+
+    @property
+    @pulumi.getter(name="sensitiveFields")
+    def sensitive_fields(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Additional field names the data scrubber removes.
+        """
+        return pulumi.get(self, "sensitive_fields")
+
     @property
     @pulumi.getter
     def slug(self) -> pulumi.Output[str]:
@@ -127,6 +252,11 @@ class Project(pulumi.CustomResource):
     def team_slug(self) -> pulumi.Output[Optional[str]]:
         return pulumi.get(self, "team_slug")
 
+    @property
+    @pulumi.getter(name="teamSlugs")
+    def team_slugs(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        return pulumi.get(self, "team_slugs")
+
     def translate_output_property(self, prop):
         return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
 
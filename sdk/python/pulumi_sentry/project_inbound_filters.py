@@ -0,0 +1,167 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ProjectInboundFilters']
+
+
+class ProjectInboundFilters(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 browser_extensions: Optional[pulumi.Input[bool]] = None,
+                 error_messages: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ip_addresses: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 legacy_browsers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 localhost: Optional[pulumi.Input[bool]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 project_slug: Optional[pulumi.Input[str]] = None,
+                 releases: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 web_crawlers: Optional[pulumi.Input[bool]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        The inbound data filters of a project. Filters left out are turned off, and deleting the resource turns them all off.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] browser_extensions: Filter out errors caused by known browser extensions.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] error_messages: Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] ip_addresses: Filter out events from these IP addresses or CIDR ranges.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] legacy_browsers: Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+        :param pulumi.Input[bool] localhost: Filter out events coming from localhost.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] releases: Filter out events from releases matching one of these patterns, e.g. *-dev.
+        :param pulumi.Input[bool] web_crawlers: Filter out events from known web crawlers.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['browser_extensions'] = browser_extensions
+            __props__['error_messages'] = error_messages
+            __props__['ip_addresses'] = ip_addresses
+            __props__['legacy_browsers'] = legacy_browsers
+            __props__['localhost'] = localhost
+            __props__['organization_slug'] = organization_slug
+            if project_slug is None:
+                raise TypeError("Missing required property 'project_slug'")
+            __props__['project_slug'] = project_slug
+            __props__['releases'] = releases
+            __props__['web_crawlers'] = web_crawlers
+        super(ProjectInboundFilters, __self__).__init__(
+            'sentry:index:ProjectInboundFilters',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ProjectInboundFilters':
+        """
+        Get an existing ProjectInboundFilters resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ProjectInboundFilters(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="browserExtensions")
+    def browser_extensions(self) -> pulumi.Output[bool]:
+        """
+        Filter out errors caused by known browser extensions.
+        """
+        return pulumi.get(self, "browser_extensions")
+
+    @property
+    @pulumi.getter(name="errorMessages")
+    def error_messages(self) -> pulumi.Output[Sequence[str]]:
+        """
+        Filter out errors whose message matches one of these patterns, e.g. *TypeError*.
+        """
+        return pulumi.get(self, "error_messages")
+
+    @property
+    @pulumi.getter(name="ipAddresses")
+    def ip_addresses(self) -> pulumi.Output[Sequence[str]]:
+        """
+        Filter out events from these IP addresses or CIDR ranges.
+        """
+        return pulumi.get(self, "ip_addresses")
+
+    @property
+    @pulumi.getter(name="legacyBrowsers")
+    def legacy_browsers(self) -> pulumi.Output[Sequence[str]]:
+        """
+        Filter out errors from these legacy browsers, e.g. ie_pre_9 or safari_pre_6.
+        """
+        return pulumi.get(self, "legacy_browsers")
+
+    @property
+    @pulumi.getter
+    def localhost(self) -> pulumi.Output[bool]:
+        """
+        Filter out events coming from localhost.
+        """
+        return pulumi.get(self, "localhost")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="projectSlug")
+    def project_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "project_slug")
+
+    @property
+    @pulumi.getter
+    def releases(self) -> pulumi.Output[Sequence[str]]:
+        """
+        Filter out events from releases matching one of these patterns, e.g. *-dev.
+        """
+        return pulumi.get(self, "releases")
+
+    @property
+    @pulumi.getter(name="webCrawlers")
+    def web_crawlers(self) -> pulumi.Output[bool]:
+        """
+        Filter out events from known web crawlers.
+        """
+        return pulumi.get(self, "web_crawlers")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
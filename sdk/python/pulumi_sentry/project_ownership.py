@@ -0,0 +1,128 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ProjectOwnership']
+
+
+class ProjectOwnership(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 auto_assignment: Optional[pulumi.Input[bool]] = None,
+                 fallthrough: Optional[pulumi.Input[bool]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 project_slug: Optional[pulumi.Input[str]] = None,
+                 raw: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        The ownership rules of a project, used to route alerts and assign issues. Deleting the resource removes all the rules.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] auto_assignment: Assign new issues to their owners automatically. Defaults to false.
+        :param pulumi.Input[bool] fallthrough: Send alerts to all project members when no rule matches an issue. Defaults to true.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[str] raw: The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['auto_assignment'] = auto_assignment
+            __props__['fallthrough'] = fallthrough
+            __props__['organization_slug'] = organization_slug
+            if project_slug is None:
+                raise TypeError("Missing required property 'project_slug'")
+            __props__['project_slug'] = project_slug
+            if raw is None:
+                raise TypeError("Missing required property 'raw'")
+            __props__['raw'] = raw
+        super(ProjectOwnership, __self__).__init__(
+            'sentry:index:ProjectOwnership',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ProjectOwnership':
+        """
+        Get an existing ProjectOwnership resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ProjectOwnership(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="autoAssignment")
+    def auto_assignment(self) -> pulumi.Output[bool]:
+        """
+        Assign new issues to their owners automatically. Defaults to false.
+        """
+        return pulumi.get(self, "auto_assignment")
+
+    @property
+    @pulumi.getter
+    def fallthrough(self) -> pulumi.Output[bool]:
+        """
+        Send alerts to all project members when no rule matches an issue. Defaults to true.
+        """
+        return pulumi.get(self, "fallthrough")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="projectSlug")
+    def project_slug(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "project_slug")
+
+    @property
+    @pulumi.getter
+    def raw(self) -> pulumi.Output[str]:
+        """
+        The ownership rules, one per line, e.g. "path:src/billing/* #billing alice@example.com". Rules can match a path, url, module, codeowners or tags.<name>, and default to path.
+        """
+        return pulumi.get(self, "raw")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,161 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._inputs import *
+
+__all__ = ['Release']
+
+
+class Release(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 date_released: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 project_slugs: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ref: Optional[pulumi.Input[str]] = None,
+                 refs: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ReleaseRefArgs']]]]] = None,
+                 url: Optional[pulumi.Input[str]] = None,
+                 version: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A version of the code deployed in projects of an organization. Sentry refuses to delete releases which have events.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] date_released: When the release went live, in RFC 3339 format. Defaults to unreleased.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[str] ref: A commit or tag of the release, for display.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ReleaseRefArgs']]]] refs: The commits of the release, to associate them with it.
+        :param pulumi.Input[str] url: A link to the release, e.g. to its build.
+        :param pulumi.Input[str] version: The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['date_released'] = date_released
+            __props__['organization_slug'] = organization_slug
+            if project_slugs is None:
+                raise TypeError("Missing required property 'project_slugs'")
+            __props__['project_slugs'] = project_slugs
+            __props__['ref'] = ref
+            __props__['refs'] = refs
+            __props__['url'] = url
+            if version is None:
+                raise TypeError("Missing required property 'version'")
+            __props__['version'] = version
+            __props__['date_created'] = None
+        super(Release, __self__).__init__(
+            'sentry:index:Release',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Release':
+        """
+        Get an existing Release resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return Release(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="dateCreated")
+    def date_created(self) -> pulumi.Output[str]:
+        """
+        When the release was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "date_created")
+
+    @property
+    @pulumi.getter(name="dateReleased")
+    def date_released(self) -> pulumi.Output[Optional[str]]:
+        """
+        When the release went live, in RFC 3339 format. Defaults to unreleased.
+        """
+        return pulumi.get(self, "date_released")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter(name="projectSlugs")
+    def project_slugs(self) -> pulumi.Output[Sequence[str]]:
+        return pulumi.get(self, "project_slugs")
+
+    @property
+    @pulumi.getter
+    def ref(self) -> pulumi.Output[Optional[str]]:
+        """
+        A commit or tag of the release, for display.
+        """
+        return pulumi.get(self, "ref")
+
+    @property
+    @pulumi.getter
+    def refs(self) -> pulumi.Output[Optional[Sequence['outputs.ReleaseRef']]]:
+        """
+        The commits of the release, to associate them with it.
+        """
+        return pulumi.get(self, "refs")
+
+    @property
+    @pulumi.getter
+    def url(self) -> pulumi.Output[Optional[str]]:
+        """
+        A link to the release, e.g. to its build.
+        """
+        return pulumi.get(self, "url")
+
+    @property
+    @pulumi.getter
+    def version(self) -> pulumi.Output[str]:
+        """
+        The version identifying the release, e.g. a commit SHA or my-app@1.2.3.
+        """
+        return pulumi.get(self, "version")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,139 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['SavedSearch']
+
+
+class SavedSearch(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 organization_slug: Optional[pulumi.Input[str]] = None,
+                 pinned: Optional[pulumi.Input[bool]] = None,
+                 query: Optional[pulumi.Input[str]] = None,
+                 sort: Optional[pulumi.Input[str]] = None,
+                 visibility: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A search of issues shared in an organization.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] organization_slug: Defaults to the sentry:organization provider config.
+        :param pulumi.Input[bool] pinned: Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+        :param pulumi.Input[str] query: The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+        :param pulumi.Input[str] sort: The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+        :param pulumi.Input[str] visibility: Who the search is listed for: organization or owner. Defaults to organization.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if name is None:
+                raise TypeError("Missing required property 'name'")
+            __props__['name'] = name
+            __props__['organization_slug'] = organization_slug
+            __props__['pinned'] = pinned
+            if query is None:
+                raise TypeError("Missing required property 'query'")
+            __props__['query'] = query
+            __props__['sort'] = sort
+            __props__['visibility'] = visibility
+        super(SavedSearch, __self__).__init__(
+            'sentry:index:SavedSearch',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'SavedSearch':
+        """
+        Get an existing SavedSearch resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return SavedSearch(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="organizationSlug")
+    def organization_slug(self) -> pulumi.Output[str]:
+        """
+        Defaults to the sentry:organization provider config.
+        """
+        return pulumi.get(self, "organization_slug")
+
+    @property
+    @pulumi.getter
+    def pinned(self) -> pulumi.Output[bool]:
+        """
+        Whether the search is the pinned issue search of the user of the API token. Defaults to false.
+        """
+        return pulumi.get(self, "pinned")
+
+    @property
+    @pulumi.getter
+    def query(self) -> pulumi.Output[str]:
+        """
+        The issue search query, e.g. `is:unresolved !has:assignee level:fatal`.
+        """
+        return pulumi.get(self, "query")
+
+    @property
+    @pulumi.getter
+    def sort(self) -> pulumi.Output[str]:
+        """
+        The order of the issues found: date, new, priority, trends, freq, user or inbox. Defaults to date.
+        """
+        return pulumi.get(self, "sort")
+
+    @property
+    @pulumi.getter
+    def visibility(self) -> pulumi.Output[str]:
+        """
+        Who the search is listed for: organization or owner. Defaults to organization.
+        """
+        return pulumi.get(self, "visibility")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+